@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 )
@@ -30,6 +31,9 @@ type ExtraArgs struct {
 	GoEnv           *string // Portable Go environment directory
 	IxgoGen         *bool   // Use xgobuild library for code generation (new method)
 	Verbose         *bool   // Verbose mode - print verbose information
+	Record          *string // Record the run to a .spxrec file
+	Replay          *string // Replay the run recorded in a .spxrec file
+	Watch           *bool   // Reload the game when the project changes
 }
 
 func (e *ExtraArgs) String() []string {
@@ -61,6 +65,15 @@ func (e *ExtraArgs) String() []string {
 	if *e.Verbose {
 		args = append(args, "-v")
 	}
+	if *e.Record != "" {
+		args = append(args, "--record", *e.Record)
+	}
+	if *e.Replay != "" {
+		args = append(args, "--replay", *e.Replay)
+	}
+	if *e.Watch {
+		args = append(args, "--watch")
+	}
 	return args
 }
 
@@ -68,7 +81,7 @@ func (e *ExtraArgs) String() []string {
 func (pself *CmdTool) CheckCmd(ext ...string) bool {
 	cmds := []string{
 		"help", "version", "editor",
-		"init", "clear", "clearbuild",
+		"init", "clear", "clearbuild", "check",
 		"build", "buildtinygo", "rune", "export",
 		"runweb", "buildweb", "exportweb", "stopweb", "runwebworker",
 		"runm", "exportbot", "exportapk", "exportios",
@@ -125,6 +138,9 @@ func (cmd *CmdTool) initializeFlags() *bool {
 	cmd.Args.GoEnv = f.String("goenv", "", "portable Go environment directory (e.g., ./cmd/portable-go)")
 	cmd.Args.IxgoGen = f.Bool("ixgogen", false, "use xgobuild library for code generation (default: use xgo CLI)")
 	cmd.Args.Verbose = f.Bool("v", false, "print verbose information")
+	cmd.Args.Record = f.String("record", "", "record the run to a .spxrec file")
+	cmd.Args.Replay = f.String("replay", "", "replay the run recorded in a .spxrec file")
+	cmd.Args.Watch = f.Bool("watch", false, "reload the game when the project changes (interpreted mode)")
 	return help
 }
 
@@ -146,6 +162,15 @@ func (cmd *CmdTool) parseCommandLineArgs(help *bool, ext ...string) error {
 		return nil
 	}
 
+	// The game runs in another directory, resolve recordings from here
+	for _, file := range []*string{cmd.Args.Record, cmd.Args.Replay} {
+		if *file != "" {
+			if abs, err := filepath.Abs(*file); err == nil {
+				*file = abs
+			}
+		}
+	}
+
 	// Validate command
 	if !cmd.CheckCmd(ext...) {
 		return fmt.Errorf("unknown command: %s", cmd.Args.CmdName)
@@ -179,6 +204,7 @@ Available commands:
     - init            # Create a #CMDNAME project in the current directory
     - clear           # Clear the project
     - clearbuild      # Clear build artifacts
+    - check           # Check the project files for typos and missing files
 
     Development & Building:
     - build           # Build the dynamic library
@@ -212,6 +238,7 @@ Examples:
     #CMDNAME init                         # Create a project in current path
     #CMDNAME init ./test/demo01           # Create a project at path ./test/demo01
     #CMDNAME run --path ./myproject       # Run project at specified path
+    #CMDNAME check --path ./myproject     # Check the project files of a project
     #CMDNAME runi --path ./myproject      # Run in interpreted mode (requires pre-installed dll)
     #CMDNAME run --ixgogen --goenv=./cmd/portable-go  # Run with xgobuild and portable Go
     #CMDNAME build --servermode           # Build in server mode
@@ -219,6 +246,9 @@ Examples:
     #CMDNAME buildtinygo                  # Build TinyGo static library for ESP32
     #CMDNAME exportminigame -build=fast   # Export minigame without compression (faster)
     #CMDNAME run -tags=pure_engine        # Run in pure engine mode
+    #CMDNAME run --record out.spxrec      # Record the run for a later replay
+    #CMDNAME run --replay out.spxrec      # Replay a recorded run
+    #CMDNAME run --watch                  # Reload scripts and assets on change (interpreted mode)
     #CMDNAME export --fullscreen          # Export with fullscreen mode
 	`
 	fmt.Println(cmdName + " Version = " + version + "\n" + strings.ReplaceAll(msg, "#CMDNAME", cmdName))
@@ -0,0 +1,191 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/goplus/spx/v2/internal/jsonschema"
+	"github.com/goplus/spx/v2/schema"
+)
+
+// Check validates the project files in the assets directory against their
+// schema: it reports the keys the game would ignore, invalid values, missing
+// costume, sound and tilemap files, and undefined animations.
+func (pself *CmdTool) Check() error {
+	c, err := newProjectChecker(filepath.Join(pself.TargetDir, "assets"))
+	if err != nil {
+		return err
+	}
+	c.checkAll()
+	for _, problem := range c.problems {
+		fmt.Println(problem)
+	}
+	if n := len(c.problems); n > 0 {
+		return fmt.Errorf("%d problem(s) found", n)
+	}
+	fmt.Println("No problems found")
+	return nil
+}
+
+type projectChecker struct {
+	root     string // the assets directory
+	schemas  map[string]*jsonschema.Schema
+	problems []string
+}
+
+func newProjectChecker(root string) (*projectChecker, error) {
+	c := &projectChecker{root: root, schemas: make(map[string]*jsonschema.Schema)}
+	for kind, data := range map[string][]byte{"index": schema.Index, "sprite": schema.Sprite, "sound": schema.Sound} {
+		s := new(jsonschema.Schema)
+		if err := json.Unmarshal(data, s); err != nil {
+			return nil, fmt.Errorf("invalid %s schema: %w", kind, err)
+		}
+		c.schemas[kind] = s
+	}
+	return c, nil
+}
+
+func (c *projectChecker) report(file string, line int, format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	file = filepath.Join("assets", filepath.FromSlash(file))
+	if line > 0 {
+		c.problems = append(c.problems, fmt.Sprintf("%s:%d: %s", file, line, msg))
+	} else {
+		c.problems = append(c.problems, fmt.Sprintf("%s: %s", file, msg))
+	}
+}
+
+func (c *projectChecker) exists(file string) bool {
+	_, err := os.Stat(filepath.Join(c.root, filepath.FromSlash(file)))
+	return err == nil
+}
+
+// glob returns the files of the assets directory matching pattern, sorted
+func (c *projectChecker) glob(pattern string) []string {
+	matches, _ := filepath.Glob(filepath.Join(c.root, filepath.FromSlash(pattern)))
+	files := make([]string, 0, len(matches))
+	for _, match := range matches {
+		if rel, err := filepath.Rel(c.root, match); err == nil {
+			files = append(files, filepath.ToSlash(rel))
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+// load parses file and validates it against the schema of kind
+func (c *projectChecker) load(file, kind string) *jsonschema.Node {
+	data, err := os.ReadFile(filepath.Join(c.root, filepath.FromSlash(file)))
+	if err != nil {
+		c.report(file, 0, "%v", err)
+		return nil
+	}
+	doc, err := jsonschema.Parse(data)
+	if err != nil {
+		c.report(file, 0, "%v", err)
+		return nil
+	}
+	for _, issue := range jsonschema.Validate(doc, c.schemas[kind]) {
+		msg := issue.Msg
+		if issue.Path != "" {
+			msg = issue.Path + ": " + msg
+		}
+		c.report(file, issue.Line, "%s", msg)
+	}
+	return doc
+}
+
+func (c *projectChecker) checkAll() {
+	if !c.exists("index.json") {
+		c.report("index.json", 0, "not found")
+	} else {
+		c.checkIndex("index.json")
+	}
+	for _, file := range c.glob("scenes/*.json") {
+		c.checkIndex(file)
+	}
+	for _, file := range c.glob("sprites/*/index.json") {
+		c.checkSprite(file)
+	}
+	for _, file := range c.glob("sounds/*/index.json") {
+		c.checkSound(file)
+	}
+}
+
+// checkIndex checks index.json or a scene file
+func (c *projectChecker) checkIndex(file string) {
+	doc := c.load(file, "index")
+	if doc == nil || doc.Kind != "object" {
+		return
+	}
+	for _, backdrop := range doc.Get("backdrops").Array() {
+		c.checkPath(file, backdrop.Get("path"), "", "backdrop")
+	}
+	if bgm := doc.Get("bgm"); bgm.String() != "" && !c.exists("sounds/"+bgm.Str+"/index.json") {
+		c.report(file, bgm.Line, "sound %q not found", bgm.Str)
+	}
+	c.checkPath(file, doc.Get("tilemapPath"), "", "tilemap")
+	for _, item := range doc.Get("zorder").Array() {
+		if item.Kind == "string" && !c.exists("sprites/"+item.Str+"/index.json") {
+			c.report(file, item.Line, "sprite %q not found", item.Str)
+		}
+	}
+}
+
+// checkSprite checks sprites/<name>/index.json
+func (c *projectChecker) checkSprite(file string) {
+	doc := c.load(file, "sprite")
+	if doc == nil || doc.Kind != "object" {
+		return
+	}
+	dir := path.Dir(file)
+	for _, costume := range doc.Get("costumes").Array() {
+		c.checkPath(file, costume.Get("path"), dir, "costume")
+	}
+	c.checkPath(file, doc.Get("costumeSet").Get("path"), dir, "costume set")
+	c.checkPath(file, doc.Get("costumeMPSet").Get("path"), dir, "costume set")
+
+	anims := make(map[string]bool)
+	for _, key := range []string{"fAnimations", "mAnimations", "tAnimations"} {
+		for _, m := range doc.Get(key).Object() {
+			anims[m.Key] = true
+			for _, action := range []string{"onStart", "onPlay"} {
+				if play := m.Value.Get(action).Get("play"); play.String() != "" && !c.exists("sounds/"+play.Str+"/index.json") {
+					c.report(file, play.Line, "sound %q not found", play.Str)
+				}
+			}
+		}
+	}
+	for _, m := range doc.Get("animBindings").Object() {
+		if name := m.Value.String(); name != "" && !anims[name] {
+			c.report(file, m.Line, "animation %q bound to %q is not defined", name, m.Key)
+		}
+	}
+	if def := doc.Get("defaultAnimation"); def.String() != "" && !anims[def.Str] {
+		c.report(file, def.Line, "default animation %q is not defined", def.Str)
+	}
+}
+
+// checkSound checks sounds/<name>/index.json
+func (c *projectChecker) checkSound(file string) {
+	doc := c.load(file, "sound")
+	if doc == nil || doc.Kind != "object" {
+		return
+	}
+	c.checkPath(file, doc.Get("path"), path.Dir(file), "sound file")
+}
+
+// checkPath reports the file named by the path value of file if it doesn't
+// exist. The path is relative to dir.
+func (c *projectChecker) checkPath(file string, val *jsonschema.Node, dir, what string) {
+	if val.String() == "" {
+		return
+	}
+	if !c.exists(path.Join(dir, val.Str)) {
+		c.report(file, val.Line, "%s %q not found", what, val.Str)
+	}
+}
@@ -113,8 +113,9 @@ func (cmd *CmdTool) RunCmd(projectName, fileSuffix, version string, fs embed.FS,
 		return nil
 	}
 
-	// Handle runi command - interpreted mode with minimal setup
-	if cmd.Args.CmdName == "runi" {
+	// Handle runi command - interpreted mode with minimal setup. Watch mode
+	// runs interpreted too, scripts are reloaded without a Go build.
+	if cmd.Args.CmdName == "runi" || (cmd.Args.CmdName == "run" && *cmd.Args.Watch) {
 		return cmd.handleRuniCommand()
 	}
 
@@ -177,6 +178,12 @@ func (cmd *CmdTool) handleSpecialCommands() bool {
 			fmt.Fprintf(os.Stderr, "Failed to stop web server: %v\n", err)
 		}
 		return true
+	case "check":
+		if err := cmd.Check(); err != nil {
+			fmt.Fprintf(os.Stderr, "Check failed: %v\n", err)
+			os.Exit(1)
+		}
+		return true
 	}
 	return false
 }
@@ -61,11 +61,15 @@ func GenGoFromFS(fsys parser.FileSystem, outputPath string) error {
 
 // registerPackagePatches registers necessary package patches for spx and ai packages
 func registerPackagePatches(ctx *ixgo.Context) error {
-	// Patch for spx package - supports generic GetWidget function
+	// Patch for spx package - supports generic GetWidget function and Signal type
 	if err := xgobuild.RegisterPackagePatch(ctx, "github.com/goplus/spx/v2", `
 package spx
 
-import . "github.com/goplus/spx/v2"
+import (
+	"reflect"
+
+	. "github.com/goplus/spx/v2"
+)
 
 func Gopt_Game_Gopx_GetWidget[T any](sg ShapeGetter, name string) *T {
 	widget := GetWidget_(sg, name)
@@ -75,6 +79,33 @@ func Gopt_Game_Gopx_GetWidget[T any](sg ShapeGetter, name string) *T {
 		panic("GetWidget: type mismatch")
 	}
 }
+
+type Signal[T any] struct {
+	sig *Signal_
+}
+
+func NewSignal[T any]() *Signal[T] {
+	return &Signal[T]{sig: NewSignal_(reflect.TypeOf((*T)(nil)).Elem().String())}
+}
+
+func (s *Signal[T]) String() string {
+	return s.sig.String()
+}
+
+func (s *Signal[T]) On(onEmit func(v T)) {
+	s.sig.On(func(v any) {
+		t, _ := v.(T)
+		onEmit(t)
+	})
+}
+
+func (s *Signal[T]) Emit(v T) {
+	s.sig.Emit(v)
+}
+
+func (s *Signal[T]) EmitAndWait(v T) {
+	s.sig.EmitAndWait(v)
+}
 `); err != nil {
 		return fmt.Errorf("failed to register package patch for github.com/goplus/spx: %w", err)
 	}
@@ -7,6 +7,7 @@ import (
 	"os"
 	_ "unsafe"
 
+	"github.com/goplus/spx/v2/cmd/igox/memfs"
 	"github.com/goplus/spx/v2/cmd/igox/plugin"
 
 	"github.com/goplus/ixgo"
@@ -26,6 +27,7 @@ var defaultRunner *SpxRunner = NewSpxRunner()
 // interpCacheEntry stores the build result.
 type interpCacheEntry struct {
 	interp *ixgo.Interp
+	files  *memfs.MemFs // the files of the project, nil on the web
 	closer func() error
 }
 
@@ -57,12 +59,16 @@ func NewSpxRunner() *SpxRunner {
 		PkgPaths: []string{"github.com/goplus/spx/v2", "math"},
 	})
 
-	// Register patch for spx to support functions with generic type like `Gopt_Game_Gopx_GetWidget`.
+	// Register patch for spx to support functions and types with generic type like `Gopt_Game_Gopx_GetWidget` and `Signal`.
 	// See details in https://github.com/goplus/builder/issues/765#issuecomment-2313915805
 	if err := ctx.RegisterPatch("github.com/goplus/spx/v2", `
 package spx
 
-import . "github.com/goplus/spx/v2"
+import (
+	"reflect"
+
+	. "github.com/goplus/spx/v2"
+)
 
 func Gopt_Game_Gopx_GetWidget[T any](sg ShapeGetter, name string) *T {
 	widget := GetWidget_(sg, name)
@@ -72,6 +78,33 @@ func Gopt_Game_Gopx_GetWidget[T any](sg ShapeGetter, name string) *T {
 		panic("GetWidget: type mismatch")
 	}
 }
+
+type Signal[T any] struct {
+	sig *Signal_
+}
+
+func NewSignal[T any]() *Signal[T] {
+	return &Signal[T]{sig: NewSignal_(reflect.TypeOf((*T)(nil)).Elem().String())}
+}
+
+func (s *Signal[T]) String() string {
+	return s.sig.String()
+}
+
+func (s *Signal[T]) On(onEmit func(v T)) {
+	s.sig.On(func(v any) {
+		t, _ := v.(T)
+		onEmit(t)
+	})
+}
+
+func (s *Signal[T]) Emit(v T) {
+	s.sig.Emit(v)
+}
+
+func (s *Signal[T]) EmitAndWait(v T) {
+	s.sig.EmitAndWait(v)
+}
 `); err != nil {
 		return nil
 	}
@@ -146,16 +179,24 @@ func (r *SpxRunner) Release() {
 	// Clear context
 	r.ctx.RunContext = nil
 	if r.entry != nil {
-		if r.entry.interp != nil {
-			r.entry.interp.UnsafeRelease()
-		}
-		if r.entry.closer != nil {
-			r.entry.closer()
-		}
+		r.entry.release()
 		r.entry = nil
 	}
 }
 
+// release releases the interpreter and the files of the entry.
+func (e *interpCacheEntry) release() {
+	if e == nil {
+		return
+	}
+	if e.interp != nil {
+		e.interp.UnsafeRelease()
+	}
+	if e.closer != nil {
+		e.closer()
+	}
+}
+
 func logWithCallerInfo(msg string, frame *ixgo.Frame) {
 	if frs := frame.CallerFrames(); len(frs) > 0 {
 		fr := frs[0]
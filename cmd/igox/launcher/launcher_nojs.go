@@ -5,11 +5,16 @@ package launcher
 import (
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/goplus/spx/v2/cmd/igox/memfs"
 	"github.com/goplus/spx/v2/cmd/igox/plugin"
 	goxfs "github.com/goplus/spx/v2/fs"
+	"github.com/goplus/spx/v2/internal/hotreload"
+	"github.com/goplus/spx/v2/internal/watch"
 
 	"github.com/goplus/ixgo"
 	"github.com/goplus/ixgo/xgobuild"
@@ -37,6 +42,9 @@ func Run(plugins ...Plugin) {
 			return
 		}
 	}
+	if slices.Contains(os.Args[1:], "--watch") || slices.Contains(os.Args[1:], "-watch") {
+		defaultRunner.watch(projDir)
+	}
 	// Unlike the web wasm mode, there is no need to block the main process here
 }
 
@@ -50,11 +58,20 @@ func (r *SpxRunner) build(projectPath string) error {
 	if r.entry != nil && r.entry.interp != nil {
 		r.Release()
 	}
+	entry, err := r.load(projectPath)
+	if err != nil {
+		return err
+	}
+	r.entry = entry
+	return nil
+}
 
+// load builds SPX project from a directory path into a new interpreter.
+func (r *SpxRunner) load(projectPath string) (*interpCacheEntry, error) {
 	// Read all files from directory into memory
 	filesMap, err := readDirToMap(projectPath)
 	if err != nil {
-		return fmt.Errorf("failed to read directory: %w", err)
+		return nil, fmt.Errorf("failed to read directory: %w", err)
 	}
 
 	fs := memfs.NewMemFs(filesMap)
@@ -65,17 +82,17 @@ func (r *SpxRunner) build(projectPath string) error {
 	ctx := r.ctx
 	source, err := xgobuild.BuildFSDir(ctx, fs, "")
 	if err != nil {
-		return fmt.Errorf("failed to build XGo source: %w", err)
+		return nil, fmt.Errorf("failed to build XGo source: %w", err)
 	}
 
 	pkg, err := ctx.LoadFile("main.go", source)
 	if err != nil {
-		return fmt.Errorf("failed to load XGo source: %w", err)
+		return nil, fmt.Errorf("failed to load XGo source: %w", err)
 	}
 
 	interp, err := ctx.NewInterp(pkg)
 	if err != nil {
-		return fmt.Errorf("failed to create interp: %w", err)
+		return nil, fmt.Errorf("failed to create interp: %w", err)
 	}
 
 	if r.debug {
@@ -83,11 +100,69 @@ func (r *SpxRunner) build(projectPath string) error {
 		fmt.Printf("Icall Capacity: %d, Allocate: %d, Available: %d\n", capacity, allocate, available)
 	}
 
-	r.entry = &interpCacheEntry{
+	return &interpCacheEntry{
 		interp: interp,
+		files:  fs,
 		closer: func() error { return fs.Close() },
+	}, nil
+}
+
+// watch reloads the game when the files of the project change: changed
+// assets are reloaded by the running game, changed scripts are built again
+// and replace it.
+func (r *SpxRunner) watch(projectPath string) {
+	w := watch.New(projectPath, 0, nil)
+	w.Start(func(files []string) {
+		var assets []string
+		rebuild := false
+		for _, file := range files {
+			switch {
+			case path.Ext(file) == ".spx":
+				rebuild = true
+			case strings.HasPrefix(file, "assets/"):
+				assets = append(assets, strings.TrimPrefix(file, "assets/"))
+			}
+		}
+		if rebuild {
+			if err := r.reload(projectPath); err != nil {
+				logger.Error("failed to reload project", "error", err)
+			}
+			return
+		}
+		if len(assets) > 0 && r.entry != nil {
+			for _, file := range assets {
+				name := "assets/" + file
+				if data, err := os.ReadFile(filepath.Join(projectPath, filepath.FromSlash(name))); err == nil {
+					r.entry.files.AddFile(name, data)
+				} else {
+					r.entry.files.RemoveFile(name)
+				}
+			}
+			hotreload.ReloadAssets(assets)
+		}
+	})
+}
+
+// reload builds the project again and replaces the running game by the new
+// one. The running game is kept if the build fails.
+func (r *SpxRunner) reload(projectPath string) error {
+	entry, err := r.load(projectPath)
+	if err != nil {
+		return err
 	}
-	return nil
+	replaced := r.entry
+	return hotreload.ReloadGame(func() error {
+		r.entry = entry
+		if result := r.run(); result != nil {
+			if err, ok := result.(error); ok {
+				return err
+			}
+		}
+		return nil
+	}, func() {
+		// once the scripts it runs have ended
+		replaced.release()
+	})
 }
 
 // RunInterp executes the cached interpreter.
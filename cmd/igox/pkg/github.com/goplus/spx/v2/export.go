@@ -17,6 +17,7 @@ func init() {
 		Name: "spx",
 		Path: "github.com/goplus/spx/v2",
 		Deps: map[string]string{
+			"cmp":                               "cmp",
 			"context":                           "context",
 			"encoding/json":                     "json",
 			"errors":                            "errors",
@@ -33,7 +34,11 @@ func init() {
 			"github.com/goplus/spx/v2/internal/engine/platform": "platform",
 			"github.com/goplus/spx/v2/internal/engine/profiler": "profiler",
 			"github.com/goplus/spx/v2/internal/enginewrap":      "enginewrap",
+			"github.com/goplus/spx/v2/internal/hotreload":       "hotreload",
+			"github.com/goplus/spx/v2/internal/jsonschema":      "jsonschema",
 			"github.com/goplus/spx/v2/internal/log":             "log",
+			"github.com/goplus/spx/v2/internal/record":          "record",
+			"github.com/goplus/spx/v2/internal/storage":         "storage",
 			"github.com/goplus/spx/v2/internal/tilemap":         "tilemap",
 			"github.com/goplus/spx/v2/internal/time":            "time",
 			"github.com/goplus/spx/v2/internal/timer":           "timer",
@@ -46,7 +51,9 @@ func init() {
 			"log":           "log",
 			"maps":          "maps",
 			"math":          "math",
+			"math/bits":     "bits",
 			"math/rand":     "rand",
+			"net/http":      "http",
 			"os":            "os",
 			"path":          "path",
 			"path/filepath": "filepath",
@@ -56,6 +63,7 @@ func init() {
 			"strconv":       "strconv",
 			"strings":       "strings",
 			"sync":          "sync",
+			"sync/atomic":   "atomic",
 			"syscall":       "syscall",
 			"time":          "time",
 			"unsafe":        "unsafe",
@@ -71,16 +79,31 @@ func init() {
 		},
 		NamedTypes: map[string]reflect.Type{
 			"Color":           reflect.TypeOf((*q.Color)(nil)).Elem(),
+			"ColliderPart":    reflect.TypeOf((*q.ColliderPart)(nil)).Elem(),
 			"Config":          reflect.TypeOf((*q.Config)(nil)).Elem(),
+			"Contact":         reflect.TypeOf((*q.Contact)(nil)).Elem(),
 			"EffectKind":      reflect.TypeOf((*q.EffectKind)(nil)).Elem(),
+			"EventHandle":     reflect.TypeOf((*q.EventHandle)(nil)).Elem(),
 			"Game":            reflect.TypeOf((*q.Game)(nil)).Elem(),
+			"GamepadAxis":     reflect.TypeOf((*q.GamepadAxis)(nil)).Elem(),
+			"GamepadButton":   reflect.TypeOf((*q.GamepadButton)(nil)).Elem(),
+			"JointHandle":     reflect.TypeOf((*q.JointHandle)(nil)).Elem(),
+			"JointKind":       reflect.TypeOf((*q.JointKind)(nil)).Elem(),
+			"JointParams":     reflect.TypeOf((*q.JointParams)(nil)).Elem(),
 			"List":            reflect.TypeOf((*q.List)(nil)).Elem(),
+			"MessageStat":     reflect.TypeOf((*q.MessageStat)(nil)).Elem(),
 			"Monitor":         reflect.TypeOf((*q.Monitor)(nil)).Elem(),
 			"PenColorParam":   reflect.TypeOf((*q.PenColorParam)(nil)).Elem(),
+			"QueryFilter":     reflect.TypeOf((*q.QueryFilter)(nil)).Elem(),
+			"QueryHit":        reflect.TypeOf((*q.QueryHit)(nil)).Elem(),
 			"RotationStyle":   reflect.TypeOf((*q.RotationStyle)(nil)).Elem(),
+			"Signal_":         reflect.TypeOf((*q.Signal_)(nil)).Elem(),
 			"SoundEffectKind": reflect.TypeOf((*q.SoundEffectKind)(nil)).Elem(),
 			"SpriteImpl":      reflect.TypeOf((*q.SpriteImpl)(nil)).Elem(),
 			"StopKind":        reflect.TypeOf((*q.StopKind)(nil)).Elem(),
+			"Thread":          reflect.TypeOf((*q.Thread)(nil)).Elem(),
+			"ThreadState":     reflect.TypeOf((*q.ThreadState)(nil)).Elem(),
+			"TimerHandle":     reflect.TypeOf((*q.TimerHandle)(nil)).Elem(),
 			"Value":           reflect.TypeOf((*q.Value)(nil)).Elem(),
 		},
 		AliasTypes: map[string]reflect.Type{
@@ -111,13 +134,21 @@ func init() {
 			"HSBA":                     reflect.ValueOf(q.HSBA),
 			"Iround":                   reflect.ValueOf(q.Iround),
 			"KeyFromString":            reflect.ValueOf(q.KeyFromString),
+			"NewSignal_":               reflect.ValueOf(q.NewSignal_),
+			"Noise__0":                 reflect.ValueOf(q.Noise__0),
+			"Noise__1":                 reflect.ValueOf(q.Noise__1),
+			"Noise__2":                 reflect.ValueOf(q.Noise__2),
 			"Rand__0":                  reflect.ValueOf(q.Rand__0),
 			"Rand__1":                  reflect.ValueOf(q.Rand__1),
+			"RandChoice":               reflect.ValueOf(q.RandChoice),
+			"RandWeighted":             reflect.ValueOf(q.RandWeighted),
 			"Repeat":                   reflect.ValueOf(q.Repeat),
 			"RepeatUntil":              reflect.ValueOf(q.RepeatUntil),
 			"Sched":                    reflect.ValueOf(q.Sched),
 			"SchedNow":                 reflect.ValueOf(q.SchedNow),
 			"SetDebug":                 reflect.ValueOf(q.SetDebug),
+			"Shuffle":                  reflect.ValueOf(q.Shuffle),
+			"Threads":                  reflect.ValueOf(q.Threads),
 			"WaitUntil":                reflect.ValueOf(q.WaitUntil),
 		},
 		TypedConsts: map[string]ixgo.TypedConst{
@@ -134,11 +165,13 @@ func init() {
 			"CapsuleCollider":      {reflect.TypeOf(q.CapsuleCollider), constant.MakeInt64(int64(q.CapsuleCollider))},
 			"CircleCollider":       {reflect.TypeOf(q.CircleCollider), constant.MakeInt64(int64(q.CircleCollider))},
 			"ColorEffect":          {reflect.TypeOf(q.ColorEffect), constant.MakeInt64(int64(q.ColorEffect))},
+			"CompoundCollider":     {reflect.TypeOf(q.CompoundCollider), constant.MakeInt64(int64(q.CompoundCollider))},
 			"DbgFlagAll":           {reflect.TypeOf(q.DbgFlagAll), constant.MakeInt64(int64(q.DbgFlagAll))},
 			"DbgFlagEvent":         {reflect.TypeOf(q.DbgFlagEvent), constant.MakeInt64(int64(q.DbgFlagEvent))},
 			"DbgFlagInstr":         {reflect.TypeOf(q.DbgFlagInstr), constant.MakeInt64(int64(q.DbgFlagInstr))},
 			"DbgFlagLoad":          {reflect.TypeOf(q.DbgFlagLoad), constant.MakeInt64(int64(q.DbgFlagLoad))},
 			"DbgFlagPerf":          {reflect.TypeOf(q.DbgFlagPerf), constant.MakeInt64(int64(q.DbgFlagPerf))},
+			"DistanceJoint":        {reflect.TypeOf(q.DistanceJoint), constant.MakeInt64(int64(q.DistanceJoint))},
 			"Down":                 {reflect.TypeOf(q.Down), constant.MakeFromLiteral("1.8e+2", token.FLOAT, 0)},
 			"DynamicPhysics":       {reflect.TypeOf(q.DynamicPhysics), constant.MakeInt64(int64(q.DynamicPhysics))},
 			"Edge":                 {reflect.TypeOf(q.Edge), constant.MakeInt64(int64(q.Edge))},
@@ -149,7 +182,35 @@ func init() {
 			"FishEyeEffect":        {reflect.TypeOf(q.FishEyeEffect), constant.MakeInt64(int64(q.FishEyeEffect))},
 			"Forward":              {reflect.TypeOf(q.Forward), constant.MakeInt64(int64(q.Forward))},
 			"Front":                {reflect.TypeOf(q.Front), constant.MakeInt64(int64(q.Front))},
+			"GamepadA":             {reflect.TypeOf(q.GamepadA), constant.MakeInt64(int64(q.GamepadA))},
+			"GamepadB":             {reflect.TypeOf(q.GamepadB), constant.MakeInt64(int64(q.GamepadB))},
+			"GamepadBack":          {reflect.TypeOf(q.GamepadBack), constant.MakeInt64(int64(q.GamepadBack))},
+			"GamepadDpadDown":      {reflect.TypeOf(q.GamepadDpadDown), constant.MakeInt64(int64(q.GamepadDpadDown))},
+			"GamepadDpadLeft":      {reflect.TypeOf(q.GamepadDpadLeft), constant.MakeInt64(int64(q.GamepadDpadLeft))},
+			"GamepadDpadRight":     {reflect.TypeOf(q.GamepadDpadRight), constant.MakeInt64(int64(q.GamepadDpadRight))},
+			"GamepadDpadUp":        {reflect.TypeOf(q.GamepadDpadUp), constant.MakeInt64(int64(q.GamepadDpadUp))},
+			"GamepadGuide":         {reflect.TypeOf(q.GamepadGuide), constant.MakeInt64(int64(q.GamepadGuide))},
+			"GamepadLeftShoulder":  {reflect.TypeOf(q.GamepadLeftShoulder), constant.MakeInt64(int64(q.GamepadLeftShoulder))},
+			"GamepadLeftStick":     {reflect.TypeOf(q.GamepadLeftStick), constant.MakeInt64(int64(q.GamepadLeftStick))},
+			"GamepadLeftX":         {reflect.TypeOf(q.GamepadLeftX), constant.MakeInt64(int64(q.GamepadLeftX))},
+			"GamepadLeftY":         {reflect.TypeOf(q.GamepadLeftY), constant.MakeInt64(int64(q.GamepadLeftY))},
+			"GamepadMisc":          {reflect.TypeOf(q.GamepadMisc), constant.MakeInt64(int64(q.GamepadMisc))},
+			"GamepadPaddle1":       {reflect.TypeOf(q.GamepadPaddle1), constant.MakeInt64(int64(q.GamepadPaddle1))},
+			"GamepadPaddle2":       {reflect.TypeOf(q.GamepadPaddle2), constant.MakeInt64(int64(q.GamepadPaddle2))},
+			"GamepadPaddle3":       {reflect.TypeOf(q.GamepadPaddle3), constant.MakeInt64(int64(q.GamepadPaddle3))},
+			"GamepadPaddle4":       {reflect.TypeOf(q.GamepadPaddle4), constant.MakeInt64(int64(q.GamepadPaddle4))},
+			"GamepadRightShoulder": {reflect.TypeOf(q.GamepadRightShoulder), constant.MakeInt64(int64(q.GamepadRightShoulder))},
+			"GamepadRightStick":    {reflect.TypeOf(q.GamepadRightStick), constant.MakeInt64(int64(q.GamepadRightStick))},
+			"GamepadRightX":        {reflect.TypeOf(q.GamepadRightX), constant.MakeInt64(int64(q.GamepadRightX))},
+			"GamepadRightY":        {reflect.TypeOf(q.GamepadRightY), constant.MakeInt64(int64(q.GamepadRightY))},
+			"GamepadStart":         {reflect.TypeOf(q.GamepadStart), constant.MakeInt64(int64(q.GamepadStart))},
+			"GamepadTouchpad":      {reflect.TypeOf(q.GamepadTouchpad), constant.MakeInt64(int64(q.GamepadTouchpad))},
+			"GamepadTriggerLeft":   {reflect.TypeOf(q.GamepadTriggerLeft), constant.MakeInt64(int64(q.GamepadTriggerLeft))},
+			"GamepadTriggerRight":  {reflect.TypeOf(q.GamepadTriggerRight), constant.MakeInt64(int64(q.GamepadTriggerRight))},
+			"GamepadX":             {reflect.TypeOf(q.GamepadX), constant.MakeInt64(int64(q.GamepadX))},
+			"GamepadY":             {reflect.TypeOf(q.GamepadY), constant.MakeInt64(int64(q.GamepadY))},
 			"GhostEffect":          {reflect.TypeOf(q.GhostEffect), constant.MakeInt64(int64(q.GhostEffect))},
+			"HingeJoint":           {reflect.TypeOf(q.HingeJoint), constant.MakeInt64(int64(q.HingeJoint))},
 			"Invalid":              {reflect.TypeOf(q.Invalid), constant.MakeInt64(int64(q.Invalid))},
 			"Key0":                 {reflect.TypeOf(q.Key0), constant.MakeInt64(int64(q.Key0))},
 			"Key1":                 {reflect.TypeOf(q.Key1), constant.MakeInt64(int64(q.Key1))},
@@ -266,18 +327,22 @@ func init() {
 			"None":                 {reflect.TypeOf(q.None), constant.MakeInt64(int64(q.None))},
 			"Normal":               {reflect.TypeOf(q.Normal), constant.MakeInt64(int64(q.Normal))},
 			"OtherScriptsInSprite": {reflect.TypeOf(q.OtherScriptsInSprite), constant.MakeInt64(int64(q.OtherScriptsInSprite))},
+			"OutlineCollider":      {reflect.TypeOf(q.OutlineCollider), constant.MakeInt64(int64(q.OutlineCollider))},
 			"PenBrightness":        {reflect.TypeOf(q.PenBrightness), constant.MakeInt64(int64(q.PenBrightness))},
 			"PenHue":               {reflect.TypeOf(q.PenHue), constant.MakeInt64(int64(q.PenHue))},
 			"PenSaturation":        {reflect.TypeOf(q.PenSaturation), constant.MakeInt64(int64(q.PenSaturation))},
 			"PenTransparency":      {reflect.TypeOf(q.PenTransparency), constant.MakeInt64(int64(q.PenTransparency))},
+			"PinJoint":             {reflect.TypeOf(q.PinJoint), constant.MakeInt64(int64(q.PinJoint))},
 			"PixelateEffect":       {reflect.TypeOf(q.PixelateEffect), constant.MakeInt64(int64(q.PixelateEffect))},
 			"PolygonCollider":      {reflect.TypeOf(q.PolygonCollider), constant.MakeInt64(int64(q.PolygonCollider))},
 			"Prev":                 {reflect.TypeOf(q.Prev), constant.MakeInt64(int64(q.Prev))},
 			"Random":               {reflect.TypeOf(q.Random), constant.MakeInt64(int64(q.Random))},
 			"RectCollider":         {reflect.TypeOf(q.RectCollider), constant.MakeInt64(int64(q.RectCollider))},
 			"Right":                {reflect.TypeOf(q.Right), constant.MakeFromLiteral("9.e+1", token.FLOAT, 0)},
+			"SliderJoint":          {reflect.TypeOf(q.SliderJoint), constant.MakeInt64(int64(q.SliderJoint))},
 			"SoundPanEffect":       {reflect.TypeOf(q.SoundPanEffect), constant.MakeInt64(int64(q.SoundPanEffect))},
 			"SoundPitchEffect":     {reflect.TypeOf(q.SoundPitchEffect), constant.MakeInt64(int64(q.SoundPitchEffect))},
+			"SpringJoint":          {reflect.TypeOf(q.SpringJoint), constant.MakeInt64(int64(q.SpringJoint))},
 			"StateDie":             {reflect.TypeOf(q.StateDie), constant.MakeString(string(q.StateDie))},
 			"StateGlide":           {reflect.TypeOf(q.StateGlide), constant.MakeString(string(q.StateGlide))},
 			"StateStep":            {reflect.TypeOf(q.StateStep), constant.MakeString(string(q.StateStep))},
@@ -285,14 +350,18 @@ func init() {
 			"StaticPhysics":        {reflect.TypeOf(q.StaticPhysics), constant.MakeInt64(int64(q.StaticPhysics))},
 			"ThisScript":           {reflect.TypeOf(q.ThisScript), constant.MakeInt64(int64(q.ThisScript))},
 			"ThisSprite":           {reflect.TypeOf(q.ThisSprite), constant.MakeInt64(int64(q.ThisSprite))},
+			"ThreadRunning":        {reflect.TypeOf(q.ThreadRunning), constant.MakeInt64(int64(q.ThreadRunning))},
+			"ThreadSuspended":      {reflect.TypeOf(q.ThreadSuspended), constant.MakeInt64(int64(q.ThreadSuspended))},
+			"ThreadWaiting":        {reflect.TypeOf(q.ThreadWaiting), constant.MakeInt64(int64(q.ThreadWaiting))},
 			"TriggerExtraPixel":    {reflect.TypeOf(q.TriggerExtraPixel), constant.MakeFromLiteral("2.e+0", token.FLOAT, 0)},
 			"Up":                   {reflect.TypeOf(q.Up), constant.MakeFromLiteral("0", token.FLOAT, 0)},
 			"WhirlEffect":          {reflect.TypeOf(q.WhirlEffect), constant.MakeInt64(int64(q.WhirlEffect))},
 		},
 		UntypedConsts: map[string]ixgo.UntypedConst{
-			"All":        {"untyped int", constant.MakeInt64(int64(q.All))},
-			"GopPackage": {"untyped bool", constant.MakeBool(bool(q.GopPackage))},
-			"Gop_sched":  {"untyped string", constant.MakeString(string(q.Gop_sched))},
+			"All":                    {"untyped int", constant.MakeInt64(int64(q.All))},
+			"DefaultGamepadDeadZone": {"untyped float", constant.MakeFromLiteral("0.2", token.FLOAT, 0)},
+			"GopPackage":             {"untyped bool", constant.MakeBool(bool(q.GopPackage))},
+			"Gop_sched":              {"untyped string", constant.MakeString(string(q.Gop_sched))},
 		},
 	})
 }
@@ -20,11 +20,13 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"reflect"
 	"syscall"
 
 	"github.com/goplus/spbase/mathf"
 	spxfs "github.com/goplus/spx/v2/fs"
 	"github.com/goplus/spx/v2/internal/engine"
+	"github.com/goplus/spx/v2/internal/jsonschema"
 	"github.com/goplus/spx/v2/internal/log"
 )
 
@@ -81,6 +83,8 @@ type Config struct {
 	DontParseFlags     bool   `json:"-"`
 	FullScreen         bool   `json:"fullScreen,omitempty"`
 	DontRunOnUnfocused bool   `json:"pauseOnUnfocused,omitempty"`
+	Record             string `json:"-"` // record the run to this .spxrec file
+	Replay             string `json:"-"` // replay the run recorded in this .spxrec file
 }
 
 type cameraConfig struct {
@@ -90,7 +94,7 @@ type cameraConfig struct {
 type mapConfig struct {
 	Width  int    `json:"width"`
 	Height int    `json:"height"`
-	Mode   string `json:"mode"`
+	Mode   string `json:"mode" enum:",fill,repeat,fillCut,fillRatio"`
 }
 
 const (
@@ -141,8 +145,21 @@ type projConfig struct {
 	AudioMaxDistance *float64 `json:"audioMaxDistance"` // default 2000
 	AudioAttenuation *float64 `json:"audioAttenuation"` // default 0 indicates no attenuation will occur
 
+	RandomSeed *int64 `json:"randomSeed"` // seed of the random source, default a random one
+
+	InputMap map[string][]string `json:"inputMap"` // inputs bound to each action, see BindAction
+
+	Joints []*jointConfig `json:"joints"` // joints between the sprites, see AddJoint
+
 	TilemapPath   string `json:"tilemapPath"`
-	LayerSortMode string `json:"layerSortMode"` // layer sort method, default "" , options: "vertical"
+	LayerSortMode string `json:"layerSortMode" enum:",none,vertical"` // layer sort method, default "" , options: "vertical"
+}
+
+type jointConfig struct {
+	Kind string `json:"kind" enum:"pin,spring,distance,slider,hinge"`
+	A    string `json:"a"` // name of the first sprite
+	B    string `json:"b"` // name of the second sprite
+	JointParams
 }
 
 func (p *projConfig) getBackdrops() []*backdropConfig {
@@ -280,7 +297,7 @@ type spriteConfig struct {
 	X                float64               `json:"x"`
 	Y                float64               `json:"y"`
 	Size             float64               `json:"size"`
-	RotationStyle    string                `json:"rotationStyle"`
+	RotationStyle    string                `json:"rotationStyle" enum:",normal,left-right,none"`
 	Costumes         []*costumeConfig      `json:"costumes"`
 	CostumeSet       *costumeSet           `json:"costumeSet"`
 	CostumeMPSet     *costumeMPSet         `json:"costumeMPSet"`
@@ -301,7 +318,7 @@ type spriteConfig struct {
 	CollisionShapeParams []float64  `json:"collisionShapeParams"`
 	CollisionMask        *int64     `json:"collisionMask"`
 	CollisionLayer       *int64     `json:"collisionLayer"`
-	CollisionShapeType   string     `json:"collisionShapeType"`
+	CollisionShapeType   string     `json:"collisionShapeType" enum:",none,auto,circle,rect,capsule,polygon,outline"`
 	CollisionPivot       mathf.Vec2 `json:"collisionPivot"`
 
 	// TriggerShapeParams defines the shape parameters based on TriggerShapeType:
@@ -312,17 +329,40 @@ type spriteConfig struct {
 	TriggerShapeParams []float64  `json:"triggerShapeParams"`
 	TriggerMask        *int64     `json:"triggerMask"`
 	TriggerLayer       *int64     `json:"triggerLayer"`
-	TriggerShapeType   string     `json:"triggerShapeType"`
+	TriggerShapeType   string     `json:"triggerShapeType" enum:",none,auto,circle,rect,capsule,polygon,outline"`
 	TriggerPivot       mathf.Vec2 `json:"triggerPivot"`
 
+	// CollisionShapes and TriggerShapes replace the shape type, params and
+	// pivot above. Several shapes make a compound collider.
+	CollisionShapes []shapeConfig `json:"collisionShapes"`
+	TriggerShapes   []shapeConfig `json:"triggerShapes"`
+
+	// CostumeShapes overrides the shapes while the costume of the given name
+	// is shown.
+	CostumeShapes map[string]*costumeShapeConfig `json:"costumeShapes"`
+
 	// physic
-	PhysicsMode string   `json:"physicsMode"`
+	PhysicsMode string   `json:"physicsMode" enum:",no,static,kinematic,dynamic"`
 	Mass        *float64 `json:"mass"`
 	Friction    *float64 `json:"friction"`
 	AirDrag     *float64 `json:"airDrag"`
 	Gravity     *float64 `json:"gravity"`
 }
 
+// shapeConfig is a collider or trigger shape, with the params of its type as
+// described in spriteConfig. "outline" follows the opaque pixels of the
+// costume, like "auto" follows their bounds.
+type shapeConfig struct {
+	Type   string     `json:"type" enum:"auto,circle,rect,capsule,polygon,outline"`
+	Params []float64  `json:"params"`
+	Pivot  mathf.Vec2 `json:"pivot"`
+}
+
+type costumeShapeConfig struct {
+	Collision []shapeConfig `json:"collision"`
+	Trigger   []shapeConfig `json:"trigger"`
+}
+
 func (p *spriteConfig) getCostumeIndex() int {
 	return p.CostumeIndex
 }
@@ -336,3 +376,13 @@ type soundConfig struct {
 }
 
 // -------------------------------------------------------------------------------------
+
+// The schemas of the project files are generated from their config types, see
+// the schema package.
+func init() {
+	jsonschema.Register("index", reflect.TypeOf(projConfig{}))
+	jsonschema.Register("sprite", reflect.TypeOf(spriteConfig{}))
+	jsonschema.Register("sound", reflect.TypeOf(soundConfig{}))
+}
+
+// -------------------------------------------------------------------------------------
@@ -17,7 +17,9 @@
 package spx
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 
 	"github.com/goplus/spx/v2/internal/engine"
 	"github.com/goplus/spx/v2/internal/engine/profiler"
@@ -61,5 +63,28 @@ func (p *Game) showDebugPanel() {
 	msg += fmt.Sprintf("coro: NextCount: %v\n", lastInfo.NextCount)
 	msg += fmt.Sprintf("coro: GCCount: %v\n", lastInfo.GCCount)
 	msg += fmt.Sprintf("coro: LoopIterations: %v\n", lastInfo.LoopIterations)
+	msg += debugThreads(maxDebugThreads)
 	p.debugPanel.Show(msg)
+	serveDebug()
+}
+
+// maxDebugThreads is the number of threads listed in the debug panel
+const maxDebugThreads = 20
+
+// debugThreads lists up to n threads alive, the running ones first as a
+// script hanging the game never yields
+func debugThreads(n int) string {
+	threads := Threads()
+	slices.SortStableFunc(threads, func(a, b *Thread) int {
+		return cmp.Compare(a.State, b.State)
+	})
+	msg := fmt.Sprintf("Threads: %v\n", len(threads))
+	for i, t := range threads {
+		if i == n {
+			msg += fmt.Sprintf("  ... %v more\n", len(threads)-n)
+			break
+		}
+		msg += "  " + t.String() + "\n"
+	}
+	return msg
 }
@@ -0,0 +1,75 @@
+//go:build debughttp && !js
+// +build debughttp,!js
+
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"sync"
+
+	spxlog "github.com/goplus/spx/v2/internal/log"
+)
+
+// defaultDebugAddr is where serveDebug listens if SPX_DEBUG_ADDR isn't set
+const defaultDebugAddr = "localhost:6061"
+
+var serveDebugOnce sync.Once
+
+// served is what the debug server serves, copied on the logic thread as the
+// threads change while they run
+var served struct {
+	sync.Mutex
+	threads []*Thread
+}
+
+// serveDebug serves the debug data as JSON in debug mode, on the address in
+// SPX_DEBUG_ADDR or on defaultDebugAddr:
+//
+//	GET /debug/spx/threads    the threads alive, see Threads
+//
+// It is called on the logic thread every frame, to update the data served.
+// The server is only built with the debughttp build tag.
+func serveDebug() {
+	threads := Threads()
+	served.Lock()
+	served.threads = threads
+	served.Unlock()
+
+	serveDebugOnce.Do(func() {
+		addr := os.Getenv("SPX_DEBUG_ADDR")
+		if addr == "" {
+			addr = defaultDebugAddr
+		}
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /debug/spx/threads", func(w http.ResponseWriter, r *http.Request) {
+			served.Lock()
+			threads := served.threads
+			served.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(threads)
+		})
+		go func() {
+			if err := http.ListenAndServe(addr, mux); err != nil {
+				spxlog.Warn("debug server: %v", err)
+			}
+		}()
+	})
+}
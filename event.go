@@ -17,6 +17,7 @@
 package spx
 
 import (
+	"slices"
 	"sync"
 
 	"github.com/goplus/spbase/mathf"
@@ -29,26 +30,135 @@ import (
 // -------------------------------------------------------------------------------------
 
 type eventSink struct {
-	pthis threadObj
-	cond  func(any) bool
-	sink  any
+	pthis  threadObj
+	cond   func(any) bool
+	sink   any
+	name   string       // the message or signal listened to, see MessageStats
+	handle *EventHandle // nil if the sink can't be unregistered
+}
+
+// EventHandle is returned by the registration of an event handler, such as
+// OnClick, to unregister it or change its priority.
+type EventHandle struct {
+	sinks    *[]eventSink
+	priority int
+	once     bool // unregister the handler when it's called
+	stop     bool // keep the click from the handlers called next, see SetStopPropagation
+	off      bool
+}
+
+// addSink registers ev and returns its handle
+func addSink(sinks *[]eventSink, ev eventSink) *EventHandle {
+	h := &EventHandle{sinks: sinks}
+	ev.handle = h
+	*sinks = insertSink(*sinks, ev)
+	return h
+}
+
+// insertSink inserts ev after the sinks of higher or the same priority. The
+// sinks being called, if any, are left as they are.
+func insertSink(sinks []eventSink, ev eventSink) []eventSink {
+	priority := ev.priority()
+	i := len(sinks)
+	for i > 0 && sinks[i-1].priority() < priority {
+		i--
+	}
+	if i == len(sinks) {
+		return append(sinks, ev)
+	}
+	return slices.Insert(slices.Clone(sinks), i, ev)
+}
+
+// Off unregisters the handler. The calls already started go on.
+func (h *EventHandle) Off() {
+	if h.off {
+		return
+	}
+	h.off = true
+	// a copy, so that the sinks being called are left as they are
+	*h.sinks = slices.DeleteFunc(slices.Clone(*h.sinks), func(ev eventSink) bool {
+		return ev.handle == h
+	})
+}
+
+// Priority returns the priority of the handler, 0 by default.
+func (h *EventHandle) Priority() int {
+	return h.priority
+}
+
+// SetPriority sets the priority of the handler. The handlers of an event are
+// called by decreasing priority, in the order they were registered for the
+// same priority. A click handler of higher priority can keep the click from
+// the others, see SetStopPropagation.
+func (h *EventHandle) SetPriority(priority int) {
+	if h.off || h.priority == priority {
+		return
+	}
+	i := slices.IndexFunc(*h.sinks, func(ev eventSink) bool { return ev.handle == h })
+	if i < 0 { // unregistered by the deletion of its clone
+		return
+	}
+	ev := (*h.sinks)[i]
+	sinks := slices.Delete(slices.Clone(*h.sinks), i, i+1)
+	h.priority = priority
+	*h.sinks = insertSink(sinks, ev)
+}
+
+// StopsPropagation reports whether the click handler keeps the clicks from the
+// handlers called after it.
+func (h *EventHandle) StopsPropagation() bool {
+	return h.stop
+}
+
+// SetStopPropagation makes the click handler keep the clicks from the handlers
+// of lower priority, and from the sprites below when its sprite lets the
+// clicks through, see SpriteImpl.SetClickThrough. It can be changed at any
+// time, e.g. by the handler itself, and applies to the next clicks.
+func (h *EventHandle) SetStopPropagation(stop bool) {
+	h.stop = stop
+}
+
+func (p *eventSink) priority() int {
+	if p.handle == nil {
+		return 0
+	}
+	return p.handle.priority
+}
+
+// accept reports whether the sink is called for data: it must be registered,
+// match data and its owner must not be paused. A sink called once is then
+// unregistered.
+func (p *eventSink) accept(data any) bool {
+	if p.cond != nil && !p.cond(data) || pausedObj(p.pthis) {
+		return false
+	}
+	h := p.handle
+	if h == nil {
+		return true
+	}
+	if h.off {
+		return false
+	}
+	if h.once {
+		h.Off()
+	}
+	return true
 }
 
+// doDeleteClone returns sinks without the ones of this. Like Off, it deletes
+// them from a copy, so that the sinks being called are left as they are.
 func doDeleteClone(sinks []eventSink, this any) []eventSink {
-	n := 0
-	for _, sink := range sinks {
-		if sink.pthis != this {
-			sinks[n] = sink
-			n++
-		}
+	if !slices.ContainsFunc(sinks, func(ev eventSink) bool { return ev.pthis == this }) {
+		return sinks
 	}
-	clear(sinks[n:])
-	return sinks[:n]
+	return slices.DeleteFunc(slices.Clone(sinks), func(ev eventSink) bool {
+		return ev.pthis == this
+	})
 }
 
 func asyncCall(sinks []eventSink, start bool, data any, doSth func(*eventSink)) {
 	for _, ev := range sinks {
-		if ev.cond == nil || ev.cond(data) {
+		if ev.accept(data) {
 			gco.CreateAndStart(start, ev.pthis, func(coroutine.Thread) int {
 				doSth(&ev)
 				return 0
@@ -60,7 +170,7 @@ func asyncCall(sinks []eventSink, start bool, data any, doSth func(*eventSink))
 func syncCall(sinks []eventSink, data any, doSth func(*eventSink)) {
 	var wg sync.WaitGroup
 	for _, ev := range sinks {
-		if ev.cond == nil || ev.cond(data) {
+		if ev.accept(data) {
 			wg.Add(1)
 			gco.CreateAndStart(false, ev.pthis, func(coroutine.Thread) int {
 				defer wg.Done()
@@ -83,19 +193,47 @@ func call(sinks []eventSink, wait bool, data any, doSth func(*eventSink)) {
 // -------------------------------------------------------------------------------------
 
 type eventSinkMgr struct {
-	allWhenStart           []eventSink
-	allWhenAwake           []eventSink
-	allWhenKeyPressed      []eventSink
-	allWhenSwipe           []eventSink
-	allWhenIReceive        []eventSink
-	allWhenBackdropChanged []eventSink
-	allWhenCloned          []eventSink
-	allWhenTouchStart      []eventSink
-	allWhenTouching        []eventSink
-	allWhenTouchEnd        []eventSink
-	allWhenClick           []eventSink
-	allWhenTimer           []eventSink
-	calledStart            bool
+	allWhenStart            []eventSink
+	allWhenAwake            []eventSink
+	allWhenKeyPressed       []eventSink
+	allWhenSwipe            []eventSink
+	allWhenIReceive         []eventSink
+	allWhenBackdropChanged  []eventSink
+	allWhenCloned           []eventSink
+	allWhenTouchStart       []eventSink
+	allWhenTouching         []eventSink
+	allWhenTouchEnd         []eventSink
+	allWhenClick            []eventSink
+	allWhenTimer            []eventSink
+	allWhenSceneLoaded      []eventSink
+	allWhenSceneUnloading   []eventSink
+	allWhenTouchDown        []eventSink
+	allWhenTouchMove        []eventSink
+	allWhenTouchUp          []eventSink
+	allWhenPinch            []eventSink
+	allWhenRotateGesture    []eventSink
+	allWhenLongPress        []eventSink
+	allWhenDoubleTap        []eventSink
+	allWhenDrag             []eventSink
+	allWhenGamepadButton    []eventSink
+	allWhenAction           []eventSink
+	allWhenKeyReleased      []eventSink
+	allWhenKeyHeld          []eventSink
+	allWhenRightClick       []eventSink
+	allWhenMiddleClick      []eventSink
+	allWhenMouseEnter       []eventSink
+	allWhenMouseLeave       []eventSink
+	allWhenMouseWheel       []eventSink
+	allWhenGamepadConnected []eventSink
+	allWhenSignal           []eventSink
+	allWhenPause            []eventSink
+	allWhenCollide          []eventSink
+	allWhenResume           []eventSink
+	sent                    map[messageKey]int // times each message or signal was sent
+	unheard                 map[messageKey]bool
+	timers                  []*TimerHandle
+	calledStart             bool
+	starting                sync.WaitGroup // the start handlers not started yet, see waitStarted
 }
 
 func (p *eventSinkMgr) reset() {
@@ -111,6 +249,33 @@ func (p *eventSinkMgr) reset() {
 	p.allWhenTouchEnd = nil
 	p.allWhenClick = nil
 	p.allWhenTimer = nil
+	p.allWhenSceneLoaded = nil
+	p.allWhenSceneUnloading = nil
+	p.allWhenTouchDown = nil
+	p.allWhenTouchMove = nil
+	p.allWhenTouchUp = nil
+	p.allWhenPinch = nil
+	p.allWhenRotateGesture = nil
+	p.allWhenLongPress = nil
+	p.allWhenDoubleTap = nil
+	p.allWhenDrag = nil
+	p.allWhenGamepadButton = nil
+	p.allWhenAction = nil
+	p.allWhenKeyReleased = nil
+	p.allWhenKeyHeld = nil
+	p.allWhenRightClick = nil
+	p.allWhenMiddleClick = nil
+	p.allWhenMouseEnter = nil
+	p.allWhenMouseLeave = nil
+	p.allWhenMouseWheel = nil
+	p.allWhenGamepadConnected = nil
+	p.allWhenSignal = nil
+	p.allWhenPause = nil
+	p.allWhenCollide = nil
+	p.allWhenResume = nil
+	p.timers = nil
+	p.sent = nil
+	p.unheard = nil
 	p.calledStart = false
 }
 
@@ -127,20 +292,76 @@ func (p *eventSinkMgr) doDeleteClone(this any) {
 	p.allWhenTouchEnd = doDeleteClone(p.allWhenTouchEnd, this)
 	p.allWhenClick = doDeleteClone(p.allWhenClick, this)
 	p.allWhenTimer = doDeleteClone(p.allWhenTimer, this)
+	p.allWhenSceneLoaded = doDeleteClone(p.allWhenSceneLoaded, this)
+	p.allWhenSceneUnloading = doDeleteClone(p.allWhenSceneUnloading, this)
+	p.allWhenTouchDown = doDeleteClone(p.allWhenTouchDown, this)
+	p.allWhenTouchMove = doDeleteClone(p.allWhenTouchMove, this)
+	p.allWhenTouchUp = doDeleteClone(p.allWhenTouchUp, this)
+	p.allWhenPinch = doDeleteClone(p.allWhenPinch, this)
+	p.allWhenRotateGesture = doDeleteClone(p.allWhenRotateGesture, this)
+	p.allWhenLongPress = doDeleteClone(p.allWhenLongPress, this)
+	p.allWhenDoubleTap = doDeleteClone(p.allWhenDoubleTap, this)
+	p.allWhenDrag = doDeleteClone(p.allWhenDrag, this)
+	p.allWhenGamepadButton = doDeleteClone(p.allWhenGamepadButton, this)
+	p.allWhenAction = doDeleteClone(p.allWhenAction, this)
+	p.allWhenKeyReleased = doDeleteClone(p.allWhenKeyReleased, this)
+	p.allWhenKeyHeld = doDeleteClone(p.allWhenKeyHeld, this)
+	p.allWhenRightClick = doDeleteClone(p.allWhenRightClick, this)
+	p.allWhenMiddleClick = doDeleteClone(p.allWhenMiddleClick, this)
+	p.allWhenMouseEnter = doDeleteClone(p.allWhenMouseEnter, this)
+	p.allWhenMouseLeave = doDeleteClone(p.allWhenMouseLeave, this)
+	p.allWhenMouseWheel = doDeleteClone(p.allWhenMouseWheel, this)
+	p.allWhenGamepadConnected = doDeleteClone(p.allWhenGamepadConnected, this)
+	p.allWhenSignal = doDeleteClone(p.allWhenSignal, this)
+	p.allWhenPause = doDeleteClone(p.allWhenPause, this)
+	p.allWhenCollide = doDeleteClone(p.allWhenCollide, this)
+	p.allWhenResume = doDeleteClone(p.allWhenResume, this)
+	p.deleteTimers(this)
 }
 
 func (p *eventSinkMgr) doWhenStart() {
 	if !p.calledStart {
 		p.calledStart = true
-		asyncCall(p.allWhenStart, false, nil, func(ev *eventSink) {
+		p.startAll(p.allWhenStart)
+	}
+}
+
+// startAll starts the given start handlers, see waitStarted
+func (p *eventSinkMgr) startAll(sinks []eventSink) {
+	for _, ev := range sinks {
+		if !ev.accept(nil) {
+			continue
+		}
+		p.starting.Add(1)
+		gco.CreateAndStart(false, ev.pthis, func(coroutine.Thread) int {
+			p.starting.Done()
 			if debugEvent {
 				spxlog.Debug("==> onStart: %s", nameOf(ev.pthis))
 			}
 			ev.sink.(func())()
+			return 0
 		})
 	}
 }
 
+// waitStarted waits until the start handlers, of the game or of a scene, have
+// run up to their first wait or have returned. Coroutines don't run
+// concurrently, so the last one started yields before the caller resumes.
+func (p *eventSinkMgr) waitStarted() {
+	engine.WaitToDo(p.starting.Wait)
+}
+
+// doWhenSceneStart triggers the start events of the objects loaded by a scene
+func (p *eventSinkMgr) doWhenSceneStart(loaded map[threadObj]bool) {
+	sinks := make([]eventSink, 0, len(loaded))
+	for _, ev := range p.allWhenStart {
+		if loaded[ev.pthis] {
+			sinks = append(sinks, ev)
+		}
+	}
+	p.startAll(sinks)
+}
+
 func (p *eventSinkMgr) doWhenAwake(this threadObj) {
 	syncCall(p.allWhenAwake, this, func(ev *eventSink) {
 		if debugEvent {
@@ -162,6 +383,126 @@ func (p *eventSinkMgr) doWhenKeyPressed(key Key) {
 	})
 }
 
+func (p *eventSinkMgr) doWhenRightClick(this threadObj) {
+	asyncCall(p.allWhenRightClick, false, this, func(ev *eventSink) {
+		ev.sink.(func())()
+	})
+}
+
+func (p *eventSinkMgr) doWhenMiddleClick(this threadObj) {
+	asyncCall(p.allWhenMiddleClick, false, this, func(ev *eventSink) {
+		ev.sink.(func())()
+	})
+}
+
+func (p *eventSinkMgr) doWhenMouseEnter(this threadObj) {
+	asyncCall(p.allWhenMouseEnter, false, this, func(ev *eventSink) {
+		ev.sink.(func())()
+	})
+}
+
+func (p *eventSinkMgr) doWhenMouseLeave(this threadObj) {
+	asyncCall(p.allWhenMouseLeave, false, this, func(ev *eventSink) {
+		ev.sink.(func())()
+	})
+}
+
+func (p *eventSinkMgr) doWhenPause() {
+	asyncCall(p.allWhenPause, false, nil, func(ev *eventSink) {
+		ev.sink.(func())()
+	})
+}
+
+func (p *eventSinkMgr) doWhenResume() {
+	asyncCall(p.allWhenResume, false, nil, func(ev *eventSink) {
+		ev.sink.(func())()
+	})
+}
+
+func (p *eventSinkMgr) doWhenMouseWheel(delta mathf.Vec2) {
+	asyncCall(p.allWhenMouseWheel, false, nil, func(ev *eventSink) {
+		ev.sink.(func(float64, float64))(delta.X, delta.Y)
+	})
+}
+
+func (p *eventSinkMgr) doWhenKeyReleased(key Key) {
+	asyncCall(p.allWhenKeyReleased, false, key, func(ev *eventSink) {
+		ev.sink.(func())()
+	})
+}
+
+func (p *eventSinkMgr) doWhenKeyHeld(key Key) {
+	asyncCall(p.allWhenKeyHeld, false, key, func(ev *eventSink) {
+		ev.sink.(func())()
+	})
+}
+
+func (p *eventSinkMgr) doWhenTouchDown(id int, pos mathf.Vec2) {
+	asyncCall(p.allWhenTouchDown, false, nil, func(ev *eventSink) {
+		ev.sink.(func(int, float64, float64))(id, pos.X, pos.Y)
+	})
+}
+
+func (p *eventSinkMgr) doWhenTouchMove(id int, pos mathf.Vec2) {
+	asyncCall(p.allWhenTouchMove, false, nil, func(ev *eventSink) {
+		ev.sink.(func(int, float64, float64))(id, pos.X, pos.Y)
+	})
+}
+
+func (p *eventSinkMgr) doWhenTouchUp(id int, pos mathf.Vec2) {
+	asyncCall(p.allWhenTouchUp, false, nil, func(ev *eventSink) {
+		ev.sink.(func(int, float64, float64))(id, pos.X, pos.Y)
+	})
+}
+
+func (p *eventSinkMgr) doWhenPinch(scale float64) {
+	asyncCall(p.allWhenPinch, false, nil, func(ev *eventSink) {
+		ev.sink.(func(float64))(scale)
+	})
+}
+
+func (p *eventSinkMgr) doWhenRotateGesture(angle float64) {
+	asyncCall(p.allWhenRotateGesture, false, nil, func(ev *eventSink) {
+		ev.sink.(func(float64))(angle)
+	})
+}
+
+func (p *eventSinkMgr) doWhenLongPress(this threadObj) {
+	asyncCall(p.allWhenLongPress, false, this, func(ev *eventSink) {
+		ev.sink.(func())()
+	})
+}
+
+func (p *eventSinkMgr) doWhenDoubleTap(this threadObj) {
+	asyncCall(p.allWhenDoubleTap, false, this, func(ev *eventSink) {
+		ev.sink.(func())()
+	})
+}
+
+func (p *eventSinkMgr) doWhenDrag(this threadObj, delta mathf.Vec2) {
+	asyncCall(p.allWhenDrag, false, this, func(ev *eventSink) {
+		ev.sink.(func(float64, float64))(delta.X, delta.Y)
+	})
+}
+
+func (p *eventSinkMgr) doWhenAction(name string) {
+	asyncCall(p.allWhenAction, false, name, func(ev *eventSink) {
+		ev.sink.(func())()
+	})
+}
+
+func (p *eventSinkMgr) doWhenGamepadButton(id int, btn GamepadButton) {
+	asyncCall(p.allWhenGamepadButton, false, btn, func(ev *eventSink) {
+		ev.sink.(func(int, GamepadButton))(id, btn)
+	})
+}
+
+func (p *eventSinkMgr) doWhenGamepadConnected(id int, connected bool) {
+	asyncCall(p.allWhenGamepadConnected, false, nil, func(ev *eventSink) {
+		ev.sink.(func(int, bool))(id, connected)
+	})
+}
+
 func (p *eventSinkMgr) doWhenSwipe(direction Direction, this threadObj) {
 	asyncCall(p.allWhenSwipe, false, direction, func(ev *eventSink) {
 		if ev.pthis == this {
@@ -170,13 +511,25 @@ func (p *eventSinkMgr) doWhenSwipe(direction Direction, this threadObj) {
 	})
 }
 
-func (p *eventSinkMgr) doWhenClick(this threadObj) {
-	asyncCall(p.allWhenClick, false, this, func(ev *eventSink) {
-		if debugEvent {
-			spxlog.Debug("==> onClick: %s", nameOf(this))
+// doWhenClick starts the click handlers of this by priority, up to the first
+// one stopping the propagation of the click, and reports whether one did.
+func (p *eventSinkMgr) doWhenClick(this threadObj) (stopped bool) {
+	for _, ev := range p.allWhenClick {
+		if !ev.accept(this) {
+			continue
 		}
-		ev.sink.(func())()
-	})
+		gco.CreateAndStart(false, ev.pthis, func(coroutine.Thread) int {
+			if debugEvent {
+				spxlog.Debug("==> onClick: %s", nameOf(this))
+			}
+			ev.sink.(func())()
+			return 0
+		})
+		if ev.handle.stop {
+			return true
+		}
+	}
+	return false
 }
 
 func (p *eventSinkMgr) doWhenTouchStart(this threadObj, obj *SpriteImpl) {
@@ -206,6 +559,15 @@ func (p *eventSinkMgr) doWhenTouchEnd(this threadObj, obj *SpriteImpl) {
 	})
 }
 
+func (p *eventSinkMgr) doWhenCollide(c *Contact) {
+	asyncCall(p.allWhenCollide, false, c, func(ev *eventSink) {
+		if debugEvent {
+			spxlog.Debug("===> onCollide: %s, %s", c.self.name, c.other.name)
+		}
+		ev.sink.(func(Contact))(*c)
+	})
+}
+
 func (p *eventSinkMgr) doWhenCloned(this threadObj, data any) {
 	asyncCall(p.allWhenCloned, true, this, func(ev *eventSink) {
 		if debugEvent {
@@ -227,20 +589,64 @@ func (p *eventSinkMgr) doWhenBackdropChanged(name BackdropName, wait bool) {
 	})
 }
 
+func (p *eventSinkMgr) doWhenSceneLoaded(name string) {
+	asyncCall(p.allWhenSceneLoaded, false, name, func(ev *eventSink) {
+		if debugEvent {
+			spxlog.Debug("==> onSceneLoaded: %s, %s", name, nameOf(ev.pthis))
+		}
+		ev.sink.(func(string))(name)
+	})
+}
+
+func (p *eventSinkMgr) doWhenSceneUnloading(name string) {
+	syncCall(p.allWhenSceneUnloading, name, func(ev *eventSink) {
+		if debugEvent {
+			spxlog.Debug("==> onSceneUnloading: %s, %s", name, nameOf(ev.pthis))
+		}
+		ev.sink.(func(string))(name)
+	})
+}
+
 // -------------------------------------------------------------------------------------
 type IEventSinks interface {
+	After(secs float64, fn func()) *TimerHandle
+	Every(secs float64, fn func()) *TimerHandle
 	OnAnyKey(onKey func(key Key))
 	OnBackdrop__0(onBackdrop func(name BackdropName))
 	OnBackdrop__1(name BackdropName, onBackdrop func())
-	OnClick(onClick func())
-	OnKey__0(key Key, onKey func())
-	OnKey__1(keys []Key, onKey func(Key))
-	OnKey__2(keys []Key, onKey func())
-	OnMsg__0(onMsg func(msg string, data any))
-	OnMsg__1(msg string, onMsg func())
-	OnStart(onStart func())
+	OnAction(name string, onAction func())
+	OnClick(onClick func()) *EventHandle
+	OnDoubleTap(onDoubleTap func())
+	OnDrag(onDrag func(dx, dy float64))
+	OnGamepadButton__0(btn GamepadButton, onButton func(id int))
+	OnGamepadButton__1(onButton func(id int, btn GamepadButton))
+	OnGamepadConnected(onConnected func(id int, connected bool))
+	OnKey__0(key Key, onKey func()) *EventHandle
+	OnKey__1(keys []Key, onKey func(Key)) *EventHandle
+	OnKey__2(keys []Key, onKey func()) *EventHandle
+	OnKeyHeld(key Key, onKey func())
+	OnKeyUp(key Key, onKey func())
+	OnLongPress(onLongPress func())
+	OnMiddleClick(onClick func())
+	OnMouseEnter(onEnter func())
+	OnMouseLeave(onLeave func())
+	OnMouseWheel(onWheel func(dx, dy float64))
+	OnMsg__0(onMsg func(msg string, data any)) *EventHandle
+	OnMsg__1(msg string, onMsg func()) *EventHandle
+	OnMsgOnce(msg string, onMsg func()) *EventHandle
+	OnPause(onPause func())
+	OnPinch(onPinch func(scale float64))
+	OnResume(onResume func())
+	OnRightClick(onClick func())
+	OnRotateGesture(onRotate func(angle float64))
+	OnSceneLoaded(onLoaded func(name string))
+	OnSceneUnloading(onUnloading func(name string))
+	OnStart(onStart func()) *EventHandle
 	OnSwipe__0(direction Direction, onSwipe func())
-	OnTimer(time float64, onTimer func())
+	OnTimer(time float64, onTimer func()) *EventHandle
+	OnTouchDown(onTouch func(id int, x, y float64))
+	OnTouchMove(onTouch func(id int, x, y float64))
+	OnTouchUp(onTouch func(id int, x, y float64))
 	Stop(kind StopKind)
 }
 
@@ -281,8 +687,8 @@ func (p *eventSinks) doWhenSwipe(direction Direction, target threadObj) {
 
 // -------------------------------------------------------------------------------------
 
-func (p *eventSinks) OnStart(onStart func()) {
-	p.allWhenStart = append(p.allWhenStart, eventSink{
+func (p *eventSinks) OnStart(onStart func()) *EventHandle {
+	return addSink(&p.allWhenStart, eventSink{
 		pthis: p.pthis,
 		sink:  onStart,
 	})
@@ -299,9 +705,38 @@ func (p *eventSinks) onAwake(onAwake func()) {
 	})
 }
 
-func (p *eventSinks) OnClick(onClick func()) {
+// OnClick is called when this sprite, or the stage for the game, is clicked.
+// A click goes to the topmost sprite under the mouse, or to the stage if there
+// is none, see SpriteImpl.SetClickThrough.
+func (p *eventSinks) OnClick(onClick func()) *EventHandle {
+	pthis := p.pthis
+	return addSink(&p.allWhenClick, eventSink{
+		pthis: pthis,
+		sink:  onClick,
+		cond: func(data any) bool {
+			return data == pthis
+		},
+	})
+}
+
+// OnRightClick is called when this sprite, or the stage for the game, is
+// clicked with the right mouse button.
+func (p *eventSinks) OnRightClick(onClick func()) {
+	pthis := p.pthis
+	p.allWhenRightClick = append(p.allWhenRightClick, eventSink{
+		pthis: pthis,
+		sink:  onClick,
+		cond: func(data any) bool {
+			return data == pthis
+		},
+	})
+}
+
+// OnMiddleClick is called when this sprite, or the stage for the game, is
+// clicked with the middle mouse button.
+func (p *eventSinks) OnMiddleClick(onClick func()) {
 	pthis := p.pthis
-	p.allWhenClick = append(p.allWhenClick, eventSink{
+	p.allWhenMiddleClick = append(p.allWhenMiddleClick, eventSink{
 		pthis: pthis,
 		sink:  onClick,
 		cond: func(data any) bool {
@@ -310,6 +745,59 @@ func (p *eventSinks) OnClick(onClick func()) {
 	})
 }
 
+// OnMouseEnter is called when the mouse cursor moves onto this sprite, or onto
+// the stage out of any sprite for the game.
+func (p *eventSinks) OnMouseEnter(onEnter func()) {
+	pthis := p.pthis
+	p.allWhenMouseEnter = append(p.allWhenMouseEnter, eventSink{
+		pthis: pthis,
+		sink:  onEnter,
+		cond: func(data any) bool {
+			return data == pthis
+		},
+	})
+}
+
+// OnMouseLeave is called when the mouse cursor moves off this sprite, or onto a
+// sprite for the game.
+func (p *eventSinks) OnMouseLeave(onLeave func()) {
+	pthis := p.pthis
+	p.allWhenMouseLeave = append(p.allWhenMouseLeave, eventSink{
+		pthis: pthis,
+		sink:  onLeave,
+		cond: func(data any) bool {
+			return data == pthis
+		},
+	})
+}
+
+// OnPause is called when the game is paused by Pause, before the sprites
+// freeze.
+func (p *eventSinks) OnPause(onPause func()) {
+	p.allWhenPause = append(p.allWhenPause, eventSink{
+		pthis: p.pthis,
+		sink:  onPause,
+	})
+}
+
+// OnResume is called when the game is resumed by Resume, once the sprites
+// run again.
+func (p *eventSinks) OnResume(onResume func()) {
+	p.allWhenResume = append(p.allWhenResume, eventSink{
+		pthis: p.pthis,
+		sink:  onResume,
+	})
+}
+
+// OnMouseWheel is called when the mouse wheel scrolls by (dx, dy) notches,
+// dy > 0 scrolling up.
+func (p *eventSinks) OnMouseWheel(onWheel func(dx, dy float64)) {
+	p.allWhenMouseWheel = append(p.allWhenMouseWheel, eventSink{
+		pthis: p.pthis,
+		sink:  onWheel,
+	})
+}
+
 func (p *eventSinks) OnAnyKey(onKey func(key Key)) {
 	p.allWhenKeyPressed = append(p.allWhenKeyPressed, eventSink{
 		pthis: p.pthis,
@@ -317,9 +805,9 @@ func (p *eventSinks) OnAnyKey(onKey func(key Key)) {
 	})
 }
 
-func (p *eventSinks) OnTimer(time float64, call func()) {
+func (p *eventSinks) OnTimer(time float64, call func()) *EventHandle {
 	timer.RegisterTimer(time)
-	p.allWhenTimer = append(p.allWhenTimer, eventSink{
+	return addSink(&p.allWhenTimer, eventSink{
 		pthis: p.pthis,
 		sink: func(float64) {
 			if debugEvent {
@@ -333,8 +821,8 @@ func (p *eventSinks) OnTimer(time float64, call func()) {
 	})
 }
 
-func (p *eventSinks) OnKey__0(key Key, onKey func()) {
-	p.allWhenKeyPressed = append(p.allWhenKeyPressed, eventSink{
+func (p *eventSinks) OnKey__0(key Key, onKey func()) *EventHandle {
+	return addSink(&p.allWhenKeyPressed, eventSink{
 		pthis: p.pthis,
 		sink: func(Key) {
 			if debugEvent {
@@ -348,6 +836,162 @@ func (p *eventSinks) OnKey__0(key Key, onKey func()) {
 	})
 }
 
+// OnKeyUp is called when key is released.
+func (p *eventSinks) OnKeyUp(key Key, onKey func()) {
+	p.allWhenKeyReleased = append(p.allWhenKeyReleased, eventSink{
+		pthis: p.pthis,
+		sink:  onKey,
+		cond: func(data any) bool {
+			return data.(Key) == key
+		},
+	})
+}
+
+// OnKeyHeld is called in each frame key is held, from the frame after it was
+// pressed. If Config.KeyDuration is set, it's called every KeyDuration
+// milliseconds instead.
+func (p *eventSinks) OnKeyHeld(key Key, onKey func()) {
+	p.allWhenKeyHeld = append(p.allWhenKeyHeld, eventSink{
+		pthis: p.pthis,
+		sink:  onKey,
+		cond: func(data any) bool {
+			return data.(Key) == key
+		},
+	})
+}
+
+// OnGamepadButton__0 is called when btn is pressed on any gamepad, id is the
+// gamepad.
+func (p *eventSinks) OnGamepadButton__0(btn GamepadButton, onButton func(id int)) {
+	p.allWhenGamepadButton = append(p.allWhenGamepadButton, eventSink{
+		pthis: p.pthis,
+		sink: func(id int, _ GamepadButton) {
+			if debugEvent {
+				spxlog.Debug("==> onGamepadButton: %v, %s", btn, nameOf(p.pthis))
+			}
+			onButton(id)
+		},
+		cond: func(data any) bool {
+			return data.(GamepadButton) == btn
+		},
+	})
+}
+
+// OnGamepadButton__1 is called when a button is pressed on a gamepad.
+func (p *eventSinks) OnGamepadButton__1(onButton func(id int, btn GamepadButton)) {
+	p.allWhenGamepadButton = append(p.allWhenGamepadButton, eventSink{
+		pthis: p.pthis,
+		sink: func(id int, btn GamepadButton) {
+			if debugEvent {
+				spxlog.Debug("==> onGamepadButton: %v, %s", btn, nameOf(p.pthis))
+			}
+			onButton(id, btn)
+		},
+	})
+}
+
+// OnGamepadConnected is called when the gamepad id is connected or
+// disconnected.
+func (p *eventSinks) OnGamepadConnected(onConnected func(id int, connected bool)) {
+	p.allWhenGamepadConnected = append(p.allWhenGamepadConnected, eventSink{
+		pthis: p.pthis,
+		sink:  onConnected,
+	})
+}
+
+// OnAction is called when an input bound to the action name is pressed while
+// none was, see Game.BindAction.
+func (p *eventSinks) OnAction(name string, onAction func()) {
+	p.allWhenAction = append(p.allWhenAction, eventSink{
+		pthis: p.pthis,
+		sink:  onAction,
+		cond: func(data any) bool {
+			return data.(string) == name
+		},
+	})
+}
+
+// OnTouchDown is called when the finger id touches the screen at (x, y).
+func (p *eventSinks) OnTouchDown(onTouch func(id int, x, y float64)) {
+	p.allWhenTouchDown = append(p.allWhenTouchDown, eventSink{
+		pthis: p.pthis,
+		sink:  onTouch,
+	})
+}
+
+// OnTouchMove is called when the finger id moves to (x, y).
+func (p *eventSinks) OnTouchMove(onTouch func(id int, x, y float64)) {
+	p.allWhenTouchMove = append(p.allWhenTouchMove, eventSink{
+		pthis: p.pthis,
+		sink:  onTouch,
+	})
+}
+
+// OnTouchUp is called when the finger id is lifted at (x, y).
+func (p *eventSinks) OnTouchUp(onTouch func(id int, x, y float64)) {
+	p.allWhenTouchUp = append(p.allWhenTouchUp, eventSink{
+		pthis: p.pthis,
+		sink:  onTouch,
+	})
+}
+
+// OnPinch is called when two fingers move apart or closer, scale is the
+// ratio of their distance to the one of the last call.
+func (p *eventSinks) OnPinch(onPinch func(scale float64)) {
+	p.allWhenPinch = append(p.allWhenPinch, eventSink{
+		pthis: p.pthis,
+		sink:  onPinch,
+	})
+}
+
+// OnRotateGesture is called when two fingers turn around each other, angle
+// is the turn in degrees since the last call, clockwise.
+func (p *eventSinks) OnRotateGesture(onRotate func(angle float64)) {
+	p.allWhenRotateGesture = append(p.allWhenRotateGesture, eventSink{
+		pthis: p.pthis,
+		sink:  onRotate,
+	})
+}
+
+// OnLongPress is called when a finger stays down on this sprite, or on the
+// stage for the game.
+func (p *eventSinks) OnLongPress(onLongPress func()) {
+	pthis := p.pthis
+	p.allWhenLongPress = append(p.allWhenLongPress, eventSink{
+		pthis: pthis,
+		sink:  onLongPress,
+		cond: func(data any) bool {
+			return data == pthis
+		},
+	})
+}
+
+// OnDoubleTap is called when this sprite, or the stage for the game, is
+// tapped twice in a row.
+func (p *eventSinks) OnDoubleTap(onDoubleTap func()) {
+	pthis := p.pthis
+	p.allWhenDoubleTap = append(p.allWhenDoubleTap, eventSink{
+		pthis: pthis,
+		sink:  onDoubleTap,
+		cond: func(data any) bool {
+			return data == pthis
+		},
+	})
+}
+
+// OnDrag is called each time a finger dragging this sprite, or the stage for
+// the game, moves by (dx, dy).
+func (p *eventSinks) OnDrag(onDrag func(dx, dy float64)) {
+	pthis := p.pthis
+	p.allWhenDrag = append(p.allWhenDrag, eventSink{
+		pthis: pthis,
+		sink:  onDrag,
+		cond: func(data any) bool {
+			return data == pthis
+		},
+	})
+}
+
 func (p *eventSinks) OnSwipe__0(direction Direction, onSwipe func()) {
 	p.allWhenSwipe = append(p.allWhenSwipe, eventSink{
 		pthis: p.pthis,
@@ -363,8 +1007,8 @@ func (p *eventSinks) OnSwipe__0(direction Direction, onSwipe func()) {
 	})
 }
 
-func (p *eventSinks) OnKey__1(keys []Key, onKey func(Key)) {
-	p.allWhenKeyPressed = append(p.allWhenKeyPressed, eventSink{
+func (p *eventSinks) OnKey__1(keys []Key, onKey func(Key)) *EventHandle {
+	return addSink(&p.allWhenKeyPressed, eventSink{
 		pthis: p.pthis,
 		sink: func(key Key) {
 			if debugEvent {
@@ -384,21 +1028,29 @@ func (p *eventSinks) OnKey__1(keys []Key, onKey func(Key)) {
 	})
 }
 
-func (p *eventSinks) OnKey__2(keys []Key, onKey func()) {
-	p.OnKey__1(keys, func(Key) {
+func (p *eventSinks) OnKey__2(keys []Key, onKey func()) *EventHandle {
+	return p.OnKey__1(keys, func(Key) {
 		onKey()
 	})
 }
 
-func (p *eventSinks) OnMsg__0(onMsg func(msg string, data any)) {
-	p.allWhenIReceive = append(p.allWhenIReceive, eventSink{
+func (p *eventSinks) OnMsg__0(onMsg func(msg string, data any)) *EventHandle {
+	return addSink(&p.allWhenIReceive, eventSink{
 		pthis: p.pthis,
 		sink:  onMsg,
 	})
 }
 
-func (p *eventSinks) OnMsg__1(msg string, onMsg func()) {
-	p.allWhenIReceive = append(p.allWhenIReceive, eventSink{
+// OnMsgOnce is called when msg is broadcast for the first time since it's
+// registered, it's then unregistered.
+func (p *eventSinks) OnMsgOnce(msg string, onMsg func()) *EventHandle {
+	h := p.OnMsg__1(msg, onMsg)
+	h.once = true
+	return h
+}
+
+func (p *eventSinks) OnMsg__1(msg string, onMsg func()) *EventHandle {
+	return addSink(&p.allWhenIReceive, eventSink{
 		pthis: p.pthis,
 		sink: func(msg string, data any) {
 			if debugEvent {
@@ -409,6 +1061,7 @@ func (p *eventSinks) OnMsg__1(msg string, onMsg func()) {
 		cond: func(data any) bool {
 			return data.(string) == msg
 		},
+		name: msg,
 	})
 }
 
@@ -434,6 +1087,23 @@ func (p *eventSinks) OnBackdrop__1(name BackdropName, onBackdrop func()) {
 	})
 }
 
+// OnSceneLoaded is called when the scene name has been loaded by LoadScene.
+func (p *eventSinks) OnSceneLoaded(onLoaded func(name string)) {
+	p.allWhenSceneLoaded = append(p.allWhenSceneLoaded, eventSink{
+		pthis: p.pthis,
+		sink:  onLoaded,
+	})
+}
+
+// OnSceneUnloading is called before the scene name is unloaded by LoadScene.
+// The scene is unloaded once all the handlers return.
+func (p *eventSinks) OnSceneUnloading(onUnloading func(name string)) {
+	p.allWhenSceneUnloading = append(p.allWhenSceneUnloading, eventSink{
+		pthis: p.pthis,
+		sink:  onUnloading,
+	})
+}
+
 // -------------------------------------------------------------------------------------
 
 type StopKind int
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -92,6 +93,7 @@ var (
 var (
 	isSchedInMain bool
 	mainSchedTime time.Time
+	mainOwner     threadObj // the sprite or game whose Main is running
 
 	enabledPhysics bool
 )
@@ -121,6 +123,27 @@ type Game struct {
 	aurec     *audiorecord.Recorder
 	startFlag sync.Once
 
+	// random source, see game_random.go
+	rand     *rand.Rand
+	randSeed int64
+	noise    *noiseGen
+
+	// last id given to a sprite by Snapshot
+	lastSnapshotID int64
+
+	// save data, see game_save.go
+	saves gameSaveMgr
+
+	// scenes, see game_scene.go
+	scenes gameSceneMgr
+
+	// run recording, see game_record.go
+	recorder *gameRecorder
+	replayer *gameReplayer
+
+	// state to restore once reloaded, see game_hotreload.go
+	hotReload *hotReloadState
+
 	// map world
 	worldWidth_  int
 	worldHeight_ int
@@ -154,6 +177,13 @@ type Game struct {
 	debug      bool
 	debugPanel *ui.UiDebug
 
+	// pause, see Pause
+	paused      bool
+	pauseExempt bool
+
+	// joints between the sprites, see game_joint.go
+	joints []*JointHandle
+
 	sprCollisionInfos       map[string]*spriteCollisionInfo
 	isCollisionByPixel      bool
 	isAutoSetCollisionLayer bool
@@ -225,7 +255,10 @@ func (p *Game) reset() {
 
 	p.debugPanel = nil
 	p.askPanel = nil
+	p.paused, p.pauseExempt = false, false
+	p.joints = nil
 	p.isLoaded = false
+	p.scenes.name = ""
 
 	p.startFlag = sync.Once{}
 	p.oncePathFinder = sync.Once{}
@@ -234,6 +267,7 @@ func (p *Game) reset() {
 
 	timer.OnReload()
 	close(p.events)
+	p.events = nil // stops the loops, and drops the events fired until reloaded
 	p.Stop(AllOtherScripts)
 }
 
@@ -304,6 +338,9 @@ func Gopt_Game_Reload(game Gamer, index any) (err error) {
 	if err = loadProjConfig(&proj, g.fs, index); err != nil {
 		return
 	}
+	if file, ok := index.(string); ok {
+		g.scenes.index = file
+	}
 	gco.OnRestart()
 	err = g.loadIndex(v, &proj)
 	gco.OnInited()
@@ -394,12 +431,32 @@ func WaitUntil(condition func() bool) {
 
 // -------------------------------------------------------------------------------------
 
+// cmdLine holds the command line flags. They are parsed once: a game replaced
+// by a hot reload gets the same ones.
+var cmdLine struct {
+	once       sync.Once
+	fullScreen bool
+	record     string
+	replay     string
+}
+
 // parseCommandLineFlags handles command line arguments
 func parseCommandLineFlags(conf *Config) {
 	if conf.DontParseFlags {
 		return
 	}
+	cmdLine.once.Do(parseCmdLine)
+
+	conf.FullScreen = conf.FullScreen || cmdLine.fullScreen
+	if cmdLine.record != "" {
+		conf.Record = cmdLine.record
+	}
+	if cmdLine.replay != "" {
+		conf.Replay = cmdLine.replay
+	}
+}
 
+func parseCmdLine() {
 	f := flag.CommandLine
 	verbose := f.Bool("v", false, "print verbose information")
 	fullscreen := f.Bool("f", false, "full screen")
@@ -419,6 +476,9 @@ func parseCommandLineFlags(conf *Config) {
 	f.Bool("headless", false, "Headless Mode")
 	f.Bool("remote-debug", false, "remote Debug Mode")
 	f.Bool("no-header", false, "disable engine's header output")
+	f.Bool("watch", false, "reload the game when the project changes")
+	record := f.String("record", "", "record the run to a .spxrec file")
+	replay := f.String("replay", "", "replay the run recorded in a .spxrec file")
 	flag.Parse()
 
 	if *help {
@@ -431,7 +491,9 @@ func parseCommandLineFlags(conf *Config) {
 		spxlog.SetLevel(spxlog.LevelDebug)
 		SetDebug(DbgFlagAll)
 	}
-	conf.FullScreen = conf.FullScreen || *fullscreen2 || *fullscreen
+	cmdLine.fullScreen = *fullscreen2 || *fullscreen
+	cmdLine.record = *record
+	cmdLine.replay = *replay
 }
 
 // setupGameConfig configures game settings
@@ -489,12 +551,12 @@ func setupGameSystems(g *Game, proj *projConfig) {
 }
 
 // loadGameSprites loads all sprites
-func loadGameSprites(g *Game, v reflect.Value, fs spxfs.Dir, proj *projConfig) {
+func loadGameSprites(g *Game, v reflect.Value, fs spxfs.Dir, conf *Config, proj *projConfig) {
 	if debugLoad {
 		spxlog.Debug("==> StartLoad")
 	}
 
-	g.startLoad(fs, &Config{Width: g.windowWidth_, Height: g.windowHeight_})
+	g.startLoad(fs, &Config{Width: g.windowWidth_, Height: g.windowHeight_, KeyDuration: conf.KeyDuration})
 	for i, n := 0, v.NumField(); i < n; i++ {
 		name, val := getFieldPtrOrAlloc(g, v, i)
 		if fld, ok := val.(Sprite); ok {
@@ -582,6 +644,7 @@ func findObjPtr(v reflect.Value, name string, from int) any {
 func (p *Game) startLoad(fs spxfs.Dir, cfg *Config) {
 	p.sounds.init(p)
 	p.inputs.init(p)
+	p.inputs.keys.init(cfg.KeyDuration)
 	p.events = make(chan event, eventBufferSize)
 	p.fs = fs
 	p.windowWidth_ = cfg.Width
@@ -619,10 +682,12 @@ func (p *Game) loadIndex(g reflect.Value, proj *projConfig) (err error) {
 	p.setupDisplayConfig(proj)
 	p.setupWorldAndWindow(proj)
 	p.setupPlatformAndCamera(proj)
+	p.setupInputMap(proj)
 
 	inits := p.loadAndInitSprites(g, proj)
 	p.runSpriteCallbacks(inits, proj, g)
 	p.setupCollisionLayers(inits)
+	p.setupJoints(proj)
 	p.loadAudioAndTilemap(proj)
 
 	p.isLoaded = true
@@ -738,12 +803,14 @@ func (p *Game) loadAndInitSprites(g reflect.Value, proj *projConfig) []Sprite {
 func (p *Game) runSpriteCallbacks(inits []Sprite, proj *projConfig, g reflect.Value) {
 	for _, ini := range inits {
 		spr := spriteOf(ini)
+		var owner threadObj = p
 		if spr != nil {
 			spr.onAwake(func() {
 				spr.awake()
 			})
+			owner = spr
 		}
-		runMain(ini.Main)
+		runMain(owner, ini.Main)
 	}
 
 	if proj.Camera != nil && proj.Camera.On != "" {
@@ -822,6 +889,7 @@ func (p *Game) loadAudioAndTilemap(proj *projConfig) {
 	if proj.Bgm != "" {
 		p.Play__0(proj.Bgm, true)
 	}
+	p.scenes.bgm = proj.Bgm
 }
 
 func (p *Game) endLoad(g reflect.Value, proj *projConfig) (err error) {
@@ -926,6 +994,7 @@ func (p *Game) addStageSprites(g reflect.Value, v specsp, inits []Sprite) []Spri
 
 var (
 	tySprite = reflect.TypeOf((*Sprite)(nil)).Elem()
+	tyWidget = reflect.TypeOf((*Widget)(nil)).Elem()
 )
 
 // -------------------------------------------------------------------------------------
@@ -955,10 +1024,13 @@ var (
 
 type threadObj = coroutine.ThreadObj
 
-func runMain(call func()) {
+func runMain(owner threadObj, call func()) {
 	isSchedInMain = true
 	mainSchedTime = time.Now()
+	last := mainOwner
+	mainOwner = owner
 	call()
+	mainOwner = last
 	isSchedInMain = false
 }
 
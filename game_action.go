@@ -0,0 +1,354 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strings"
+
+	"github.com/goplus/spbase/mathf"
+	"github.com/goplus/spx/v2/internal/engine"
+	spxlog "github.com/goplus/spx/v2/internal/log"
+)
+
+// -------------------------------------------------------------------------------------
+// Input Actions
+//
+// Actions name what the player does, such as "jump" or "moveLeft", instead of
+// the inputs doing it. The inputMap section of index.json binds each action to
+// a list of inputs:
+//
+//	"inputMap": {
+//	  "jump": ["Space", "W", "gamepad:A", "sprite:JumpButton"],
+//	  "moveLeft": ["Left", "A", "gamepad:DpadLeft", "gamepad:LeftX-"]
+//	}
+//
+// An input is one of:
+//
+//   - a key name as accepted by KeyFromString, such as "Space" or "A"
+//   - "mouse:Left", "mouse:Right" or "mouse:Middle"
+//   - "gamepad:" and a button, such as "gamepad:A" or "gamepad:Start", of any
+//     connected gamepad
+//   - "gamepad:" and an axis followed by the direction it's pushed to, such as
+//     "gamepad:LeftX-" or "gamepad:TriggerRight+"
+//   - "sprite:" and the name of a sprite, pressed while touched or clicked,
+//     which makes on-screen buttons for touch screens
+//
+// BindAction rebinds an action while the game runs, the bindings are then
+// saved with the save data and restored by the next runs.
+
+// actionPressThreshold is how far a gamepad axis is pushed to press an action
+const actionPressThreshold = 0.5
+
+// inputMapSlot is the save slot the rebound actions are saved to
+const inputMapSlot = "_inputmap"
+
+type inputBindingKind int
+
+const (
+	bindKey inputBindingKind = iota
+	bindMouse
+	bindGamepadButton
+	bindGamepadAxis
+	bindSprite
+)
+
+type inputBinding struct {
+	kind   inputBindingKind
+	key    Key
+	mouse  int64
+	button GamepadButton
+	axis   GamepadAxis
+	dir    float64 // 1 or -1, the direction the axis is pushed to
+	sprite string
+}
+
+var mouseButtonNames = map[string]int64{
+	"Left": MOUSE_BUTTON_LEFT, "Right": MOUSE_BUTTON_RIGHT, "Middle": MOUSE_BUTTON_MIDDLE,
+}
+
+var gamepadButtonNames = map[string]GamepadButton{
+	"A": GamepadA, "B": GamepadB, "X": GamepadX, "Y": GamepadY,
+	"Back": GamepadBack, "Guide": GamepadGuide, "Start": GamepadStart,
+	"LeftStick": GamepadLeftStick, "RightStick": GamepadRightStick,
+	"LeftShoulder": GamepadLeftShoulder, "RightShoulder": GamepadRightShoulder,
+	"DpadUp": GamepadDpadUp, "DpadDown": GamepadDpadDown,
+	"DpadLeft": GamepadDpadLeft, "DpadRight": GamepadDpadRight,
+	"Misc": GamepadMisc, "Touchpad": GamepadTouchpad,
+	"Paddle1": GamepadPaddle1, "Paddle2": GamepadPaddle2,
+	"Paddle3": GamepadPaddle3, "Paddle4": GamepadPaddle4,
+}
+
+var gamepadAxisNames = map[string]GamepadAxis{
+	"LeftX": GamepadLeftX, "LeftY": GamepadLeftY,
+	"RightX": GamepadRightX, "RightY": GamepadRightY,
+	"TriggerLeft": GamepadTriggerLeft, "TriggerRight": GamepadTriggerRight,
+}
+
+func parseInputBinding(input string) (b inputBinding, err error) {
+	kind, name, found := strings.Cut(input, ":")
+	if !found {
+		if b.key = KeyFromString(input); b.key == KeyMax {
+			err = fmt.Errorf("unknown key %q", input)
+		}
+		return
+	}
+	switch kind {
+	case "mouse":
+		var ok bool
+		if b.mouse, ok = mouseButtonNames[name]; !ok {
+			err = fmt.Errorf("unknown mouse button %q", name)
+		}
+		b.kind = bindMouse
+	case "gamepad":
+		if btn, ok := gamepadButtonNames[name]; ok {
+			b.kind, b.button = bindGamepadButton, btn
+			return
+		}
+		b.kind, b.dir = bindGamepadAxis, 1
+		if n := len(name); n > 0 && (name[n-1] == '+' || name[n-1] == '-') {
+			if name[n-1] == '-' {
+				b.dir = -1
+			}
+			name = name[:n-1]
+		}
+		var ok bool
+		if b.axis, ok = gamepadAxisNames[name]; !ok {
+			err = fmt.Errorf("unknown gamepad button or axis %q", name)
+		}
+	case "sprite":
+		b.kind, b.sprite = bindSprite, name
+		if name == "" {
+			err = fmt.Errorf("missing sprite name in %q", input)
+		}
+	default:
+		err = fmt.Errorf("unknown input %q", input)
+	}
+	return
+}
+
+type eventAction struct {
+	Name string
+}
+
+type inputAction struct {
+	inputs   []string
+	bindings []inputBinding
+	strength float64 // between 0 and 1, as of the last update
+	pressed  bool
+}
+
+func (p *inputAction) bind(name string, inputs []string) {
+	p.inputs = inputs
+	p.bindings = p.bindings[:0]
+	for _, input := range inputs {
+		b, err := parseInputBinding(input)
+		if err != nil {
+			spxlog.Warn("inputMap %s: %v", name, err)
+			continue
+		}
+		p.bindings = append(p.bindings, b)
+	}
+}
+
+// inputActions tracks the actions to fire their events
+type inputActions struct {
+	defaults map[string][]string // as declared by index.json
+	actions  map[string]*inputAction
+	names    []string        // of the actions, sorted to update them in a stable order
+	touched  map[string]bool // sprites touched in this frame
+}
+
+func (p *inputActions) init(defaults map[string][]string, saved map[string][]string) {
+	p.defaults = defaults
+	p.actions = make(map[string]*inputAction)
+	p.names = nil
+	p.touched = make(map[string]bool)
+	for name, inputs := range defaults {
+		p.action(name).bind(name, inputs)
+	}
+	for name, inputs := range saved {
+		p.action(name).bind(name, inputs)
+	}
+}
+
+func (p *inputActions) action(name string) *inputAction {
+	act, ok := p.actions[name]
+	if !ok {
+		act = new(inputAction)
+		p.actions[name] = act
+		i, _ := slices.BinarySearch(p.names, name)
+		p.names = slices.Insert(p.names, i, name)
+	}
+	return act
+}
+
+// update computes the strength of the actions from the fingers and the other
+// inputs of this frame, and fires the actions just pressed
+func (p *inputActions) update(g *Game, fingers []engine.Touch) {
+	if len(p.actions) == 0 {
+		return
+	}
+	clear(p.touched)
+	for _, f := range fingers {
+		if spr, ok := g.clickerAt(f.Pos).(*SpriteImpl); ok {
+			p.touched[spr.name] = true
+		}
+	}
+	for _, name := range p.names {
+		act := p.actions[name]
+		strength := 0.0
+		for i := range act.bindings {
+			strength = max(strength, p.strength(g, &act.bindings[i]))
+		}
+		pressed := strength >= actionPressThreshold
+		if pressed && !act.pressed {
+			g.fireEvent(&eventAction{Name: name})
+		}
+		act.strength, act.pressed = strength, pressed
+	}
+}
+
+func (p *inputActions) strength(g *Game, b *inputBinding) float64 {
+	pressed := false
+	switch b.kind {
+	case bindKey:
+		pressed = g.KeyPressed(b.key)
+	case bindMouse:
+		if b.mouse == MOUSE_BUTTON_LEFT {
+			pressed = g.MousePressed()
+		} else {
+			pressed = g.inputs.mouse.pressed(b.mouse)
+		}
+	case bindGamepadButton:
+		pads := &g.inputs.gamepads
+		for devices := pads.connected; devices != 0; devices &= devices - 1 {
+			if pads.buttons[bits.TrailingZeros64(uint64(devices))]&(1<<b.button) != 0 {
+				pressed = true
+				break
+			}
+		}
+	case bindGamepadAxis:
+		value := 0.0
+		for devices := g.inputs.gamepads.connected; devices != 0; devices &= devices - 1 {
+			id := bits.TrailingZeros64(uint64(devices))
+			value = max(value, b.dir*g.GamepadAxis(id, b.axis))
+		}
+		return value
+	case bindSprite:
+		pressed = p.touched[b.sprite]
+	}
+	if pressed {
+		return 1
+	}
+	return 0
+}
+
+// setupInputMap binds the actions declared by index.json, and the ones rebound
+// by a previous run
+func (p *Game) setupInputMap(proj *projConfig) {
+	var saved map[string][]string
+	if b, err := p.saveMgr().store.Read(inputMapSlot); err != nil {
+		spxlog.Error("failed to read the input map: %v", err)
+	} else if b != nil {
+		if err = json.Unmarshal(b, &saved); err != nil {
+			spxlog.Error("failed to read the input map: %v", err)
+		}
+	}
+	p.inputs.actions.init(proj.InputMap, saved)
+}
+
+// saveInputMap saves the actions bound differently from index.json
+func (p *Game) saveInputMap() {
+	acts := &p.inputs.actions
+	saved := make(map[string][]string)
+	for name, act := range acts.actions {
+		if def, ok := acts.defaults[name]; !ok || !slices.Equal(def, act.inputs) {
+			saved[name] = act.inputs
+		}
+	}
+	store := p.saveMgr().store
+	var err error
+	if len(saved) == 0 {
+		err = store.Delete(inputMapSlot)
+	} else {
+		var b []byte
+		if b, err = json.Marshal(saved); err == nil {
+			err = store.Write(inputMapSlot, b)
+		}
+	}
+	if err != nil {
+		spxlog.Error("failed to write the input map: %v", err)
+	}
+}
+
+// -------------------------------------------------------------------------------------
+
+// ActionPressed reports whether an input bound to the action name is pressed.
+func (p *Game) ActionPressed(name string) bool {
+	if act, ok := p.inputs.actions.actions[name]; ok {
+		return act.pressed
+	}
+	return false
+}
+
+// ActionStrength returns how strongly the action name is pressed, between 0
+// and 1. It's 1 for keys and buttons, gamepad axes press it partially.
+func (p *Game) ActionStrength(name string) float64 {
+	if act, ok := p.inputs.actions.actions[name]; ok {
+		return act.strength
+	}
+	return 0
+}
+
+// Axis returns the strength of the action pos minus the one of the action
+// neg, between -1 and 1. For instance Axis("moveLeft", "moveRight") reads the
+// keyboard arrows as well as a gamepad stick.
+func (p *Game) Axis(neg, pos string) float64 {
+	return mathf.Clamp(p.ActionStrength(pos)-p.ActionStrength(neg), -1, 1)
+}
+
+// Actions returns the names of the actions, sorted.
+func (p *Game) Actions() []string {
+	return slices.Clone(p.inputs.actions.names)
+}
+
+// ActionInputs returns the inputs bound to the action name.
+func (p *Game) ActionInputs(name string) []string {
+	if act, ok := p.inputs.actions.actions[name]; ok {
+		return append([]string(nil), act.inputs...)
+	}
+	return nil
+}
+
+// BindAction binds the action name to inputs, replacing its previous inputs.
+// The binding is saved and restored by the next runs of the game, until
+// ResetActions.
+func (p *Game) BindAction(name string, inputs ...string) {
+	p.inputs.actions.action(name).bind(name, append([]string(nil), inputs...))
+	p.saveInputMap()
+}
+
+// ResetActions restores the actions declared by index.json, undoing
+// BindAction.
+func (p *Game) ResetActions() {
+	p.inputs.actions.init(p.inputs.actions.defaults, nil)
+	p.saveInputMap()
+}
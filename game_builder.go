@@ -114,6 +114,21 @@ func (b *gameBuilder) initializeGame() *gameBuilder {
 	}
 	b.gamerValue = reflect.ValueOf(b.gamer).Elem()
 	b.game = instance(b.gamerValue)
+	if index, ok := b.conf.Index.(string); ok {
+		b.game.scenes.index = index
+	}
+	if b.proj.RandomSeed != nil {
+		b.game.SetRandomSeed(*b.proj.RandomSeed)
+	}
+	return b
+}
+
+// setupRecord starts recording or replaying the run
+func (b *gameBuilder) setupRecord() *gameBuilder {
+	if b.err != nil {
+		return b
+	}
+	b.err = b.game.setupRecord(&b.conf)
 	return b
 }
 
@@ -131,7 +146,7 @@ func (b *gameBuilder) loadSprites() *gameBuilder {
 	if b.err != nil {
 		return b
 	}
-	loadGameSprites(b.game, b.gamerValue, b.fs, &b.proj)
+	loadGameSprites(b.game, b.gamerValue, b.fs, &b.conf, &b.proj)
 	return b
 }
 
@@ -159,6 +174,7 @@ func (b *gameBuilder) build() (*Game, error) {
 		parseFlags().
 		setupConfig().
 		initializeGame().
+		setupRecord().
 		setupSystems().
 		loadSprites().
 		finalizeLoad()
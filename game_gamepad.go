@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/goplus/spx/v2/internal/engine"
+)
+
+// -------------------------------------------------------------------------------------
+// Gamepad Input
+//
+// Gamepads are identified by the device id the engine gives them, from 0. The
+// buttons and axes follow the SDL layout, which the engine maps every known
+// controller to: GamepadA is the bottom face button whatever its label. Like
+// the mouse position, the gamepads are synced at the beginning of the frame,
+// which lets recorded runs replay them.
+
+// GamepadButton is a button of a gamepad.
+type GamepadButton int
+
+const (
+	GamepadA             GamepadButton = iota // bottom face button
+	GamepadB                                  // right face button
+	GamepadX                                  // left face button
+	GamepadY                                  // top face button
+	GamepadBack                               // Back, Select or Share
+	GamepadGuide                              // Home, Xbox or PS
+	GamepadStart                              // Start, Menu or Options
+	GamepadLeftStick                          // left stick pressed
+	GamepadRightStick                         // right stick pressed
+	GamepadLeftShoulder                       // LB or L1
+	GamepadRightShoulder                      // RB or R1
+	GamepadDpadUp
+	GamepadDpadDown
+	GamepadDpadLeft
+	GamepadDpadRight
+	GamepadMisc                    // Share, Microphone or Capture
+	GamepadPaddle1                 // upper right paddle
+	GamepadPaddle2                 // upper left paddle
+	GamepadPaddle3                 // lower right paddle
+	GamepadPaddle4                 // lower left paddle
+	GamepadTouchpad                // PS4/PS5 touchpad pressed
+	gamepadButtonMax GamepadButton = iota
+)
+
+// GamepadAxis is an analog axis of a gamepad.
+type GamepadAxis int
+
+const (
+	GamepadLeftX        GamepadAxis = iota // left stick, -1 left to 1 right
+	GamepadLeftY                           // left stick, -1 down to 1 up
+	GamepadRightX                          // right stick, -1 left to 1 right
+	GamepadRightY                          // right stick, -1 down to 1 up
+	GamepadTriggerLeft                     // LT or L2, 0 released to 1 pressed
+	GamepadTriggerRight                    // RT or R2, 0 released to 1 pressed
+)
+
+// DefaultGamepadDeadZone is the dead zone of the gamepad axes, unless changed
+// by SetGamepadDeadZone.
+const DefaultGamepadDeadZone = 0.2
+
+// maxGamepads is the number of gamepads the engine reports
+const maxGamepads = 64
+
+type eventGamepadButton struct {
+	ID     int
+	Button GamepadButton
+}
+
+type eventGamepadConnected struct {
+	ID        int
+	Connected bool
+}
+
+// inputGamepads tracks the gamepads to fire their events
+type inputGamepads struct {
+	deadZone  float64
+	cur       []engine.Gamepad   // synced at the beginning of the frame
+	connected int64              // bit i is set if gamepad i is connected
+	buttons   [maxGamepads]int64 // bit i is set if button i is pressed
+}
+
+func (p *inputGamepads) init() {
+	p.deadZone = DefaultGamepadDeadZone
+	p.cur = p.cur[:0]
+	p.connected = 0
+	p.buttons = [maxGamepads]int64{}
+}
+
+// find returns the gamepad id as of the beginning of the frame
+func (p *inputGamepads) find(id int) (engine.Gamepad, bool) {
+	for _, pad := range p.cur {
+		if pad.Id == int64(id) {
+			return pad, true
+		}
+	}
+	return engine.Gamepad{}, false
+}
+
+// poll fires the connection changes and the buttons pressed since the last
+// poll
+func (p *inputGamepads) poll(g eventFirer) {
+	connected := int64(0)
+	for _, pad := range p.cur {
+		connected |= 1 << pad.Id
+	}
+	for changed := connected ^ p.connected; changed != 0; changed &= changed - 1 {
+		id := bits.TrailingZeros64(uint64(changed))
+		if connected&(1<<id) == 0 {
+			p.buttons[id] = 0
+		}
+		g.fireEvent(&eventGamepadConnected{ID: id, Connected: connected&(1<<id) != 0})
+	}
+	p.connected = connected
+	for _, pad := range p.cur {
+		for pressed := pad.Buttons &^ p.buttons[pad.Id]; pressed != 0; pressed &= pressed - 1 {
+			btn := GamepadButton(bits.TrailingZeros64(uint64(pressed)))
+			g.fireEvent(&eventGamepadButton{ID: int(pad.Id), Button: btn})
+		}
+		p.buttons[pad.Id] = pad.Buttons
+	}
+}
+
+// Gamepads returns the ids of the connected gamepads, in increasing order.
+func (p *Game) Gamepads() []int {
+	var ids []int
+	for _, pad := range p.inputs.gamepads.cur {
+		ids = append(ids, int(pad.Id))
+	}
+	return ids
+}
+
+// GamepadConnected reports whether the gamepad id is connected.
+func (p *Game) GamepadConnected(id int) bool {
+	_, ok := p.inputs.gamepads.find(id)
+	return ok
+}
+
+// GamepadButtonPressed reports whether btn of the gamepad id is pressed.
+func (p *Game) GamepadButtonPressed(id int, btn GamepadButton) bool {
+	if btn < 0 || btn >= gamepadButtonMax {
+		return false
+	}
+	pad, _ := p.inputs.gamepads.find(id)
+	return pad.Buttons&(1<<btn) != 0
+}
+
+// GamepadAxis returns the position of axis of the gamepad id, between -1 and
+// 1 for the sticks and between 0 and 1 for the triggers. Unlike the engine,
+// the Y axes point up as the stage does. Positions within the dead zone read
+// 0, the others are rescaled so that the axis still goes smoothly from 0 to
+// 1 past the dead zone.
+func (p *Game) GamepadAxis(id int, axis GamepadAxis) float64 {
+	pad, ok := p.inputs.gamepads.find(id)
+	if !ok || axis < 0 || int(axis) >= len(pad.Axes) {
+		return 0
+	}
+	value := pad.Axes[axis]
+	if axis == GamepadLeftY || axis == GamepadRightY {
+		value = -value
+	}
+	deadZone := p.inputs.gamepads.deadZone
+	mag := math.Abs(value)
+	if mag <= deadZone {
+		return 0
+	}
+	mag = math.Min((mag-deadZone)/(1-deadZone), 1)
+	return math.Copysign(mag, value)
+}
+
+// SetGamepadDeadZone sets the dead zone of the gamepad axes, between 0 and 1.
+// Worn sticks don't rest at 0, a dead zone ignores their small moves.
+func (p *Game) SetGamepadDeadZone(deadZone float64) {
+	p.inputs.gamepads.deadZone = math.Max(0, math.Min(deadZone, 0.99))
+}
+
+// GamepadDeadZone returns the dead zone of the gamepad axes.
+func (p *Game) GamepadDeadZone() float64 {
+	return p.inputs.gamepads.deadZone
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/goplus/spx/v2/internal/engine"
+	"github.com/goplus/spx/v2/internal/hotreload"
+	spxlog "github.com/goplus/spx/v2/internal/log"
+)
+
+// -------------------------------------------------------------------------------------
+// Hot Reload
+//
+// In watch mode (gox run --watch) the launcher reports the changes to the
+// project while the game runs:
+//
+//   - changed images are reloaded by the engine, the sprites using them are
+//     updated in place unless their size changed
+//   - other changed assets, such as index files and sounds, reload the game
+//     from its index file
+//   - changed scripts replace the game by the one built from them
+//
+// A reloaded game starts afresh and its OnStart events fire. The backdrop, the
+// variables and the sprites of the game are then restored from a snapshot
+// taken before the reload, as well as the current scene.
+
+type hotReloadState struct {
+	snap  *GameSnapshot
+	scene string
+}
+
+// pendingReload is the state of the game replaced by ReloadGame, restored by
+// the game replacing it
+var pendingReload *hotReloadState
+
+type hotReloader struct{}
+
+func init() {
+	hotreload.SetHandler(hotReloader{})
+}
+
+func runningGame() *Game {
+	if p, ok := engine.GetGame().(*Game); ok && p.isRunned {
+		return p
+	}
+	return nil
+}
+
+func (hotReloader) ReloadAssets(files []string) {
+	p := runningGame()
+	if p == nil {
+		return
+	}
+	engine.Go(p, func(context.Context) {
+		p.reloadAssets(files)
+	})
+}
+
+func (hotReloader) ReloadGame(run func() error, release func()) error {
+	p := runningGame()
+	if p == nil {
+		release()
+		return run()
+	}
+	done := make(chan error, 1)
+	engine.Go(p, func(context.Context) {
+		// the scripts of the replaced game end as soon as the new one runs
+		replaced := gco.Threads()
+		go func() {
+			for _, info := range replaced {
+				<-info.Th.Done()
+			}
+			release()
+		}()
+		pendingReload = &hotReloadState{snap: p.Snapshot(), scene: p.SceneName()}
+		p.stopRecord()
+		cmdLine.record, cmdLine.replay = "", "" // a recording doesn't survive a reload
+		p.reset()
+		p.isRunned = false
+		engine.ClearAllSprites()
+		gco.OnRestart()
+		done <- run()
+	})
+	return <-done
+}
+
+func (p *Game) reloadAssets(files []string) {
+	var images []string
+	reload := false
+	for _, file := range files {
+		switch strings.ToLower(path.Ext(file)) {
+		case ".png", ".jpg", ".jpeg", ".svg", ".webp", ".bmp":
+			images = append(images, file)
+		case ".json":
+			// keep the game running until the file is fixed
+			var v any
+			if err := loadJson(&v, p.fs, file); err != nil {
+				spxlog.Error("hot reload: can't load %s: %v", file, err)
+				return
+			}
+			reload = true
+		default:
+			reload = true
+		}
+	}
+	for _, file := range images {
+		old, cached := imageSizeCache.LoadAndDelete(file)
+		delete(cachedBounds_, file)
+		delete(cachedOutlines_, file)
+		resMgr.ReloadTexture(engine.ToAssetPath(file))
+		if cached && getImageSizeCached(file) != old {
+			reload = true // the costumes using it have the old size
+		}
+	}
+	if debugLoad {
+		spxlog.Debug("==> HotReload: %v", files)
+	}
+	if reload {
+		p.reloadGame()
+	}
+}
+
+// reloadGame reloads the game from its index file, keeping its state
+func (p *Game) reloadGame() {
+	p.hotReload = &hotReloadState{snap: p.Snapshot(), scene: p.SceneName()}
+	if err := Gopt_Game_Reload(p.gamer_, p.scenes.indexFile()); err != nil {
+		spxlog.Error("hot reload failed: %v", err)
+	}
+}
+
+// restoreHotReload restores the state of the game before its reload, once
+// its OnStart handlers have started
+func (p *Game) restoreHotReload() {
+	st := p.hotReload
+	if st == nil {
+		return
+	}
+	p.hotReload = nil
+	engine.Go(p, func(context.Context) {
+		if st.scene != p.SceneName() {
+			p.LoadSceneAndWait(st.scene)
+		}
+		p.sinkMgr.waitStarted()
+		p.Restore(st.snap)
+	})
+}
@@ -17,7 +17,10 @@
 package spx
 
 import (
+	"slices"
+
 	"github.com/goplus/spx/v2/internal/engine"
+	gtime "github.com/goplus/spx/v2/internal/time"
 	"github.com/goplus/spx/v2/internal/timer"
 )
 
@@ -25,10 +28,80 @@ import (
 // Keyboard Input
 // ============================================================================
 
+type eventKeyHeld struct {
+	Key Key
+}
+
+// inputKeys tracks the keys pressed and released in each frame, and fires
+// the key held events
+type inputKeys struct {
+	events       []engine.KeyEvent // synced at the beginning of the frame
+	interval     float64           // seconds between two held events, 0 for every frame
+	held         map[Key]float64   // time of the next held event of the keys held
+	order        []Key             // the keys held, sorted to fire their events in a stable order
+	justPressed  map[Key]bool
+	justReleased map[Key]bool
+}
+
+func (p *inputKeys) init(keyDuration int) {
+	p.interval = float64(keyDuration) / 1000
+	p.held = make(map[Key]float64)
+	p.justPressed = make(map[Key]bool)
+	p.justReleased = make(map[Key]bool)
+}
+
+// beginFrame forgets the keys pressed and released in the last frame
+func (p *inputKeys) beginFrame() {
+	clear(p.justPressed)
+	clear(p.justReleased)
+}
+
+func (p *inputKeys) onKey(key Key, pressed bool) {
+	if pressed {
+		p.justPressed[key] = true
+		p.held[key] = gtime.UnscaledTimeSinceLevelLoad() + p.interval
+	} else {
+		p.justReleased[key] = true
+		delete(p.held, key)
+	}
+}
+
+// update fires the held events of the keys held since a previous frame
+func (p *inputKeys) update(g eventFirer) {
+	now := gtime.UnscaledTimeSinceLevelLoad()
+	p.order = p.order[:0]
+	for key := range p.held {
+		p.order = append(p.order, key)
+	}
+	slices.Sort(p.order)
+	for _, key := range p.order {
+		next := p.held[key]
+		if p.justPressed[key] || now < next {
+			continue
+		}
+		p.held[key] = max(next+p.interval, now)
+		g.fireEvent(&eventKeyHeld{Key: key})
+	}
+}
+
+// KeyPressed reports whether key is pressed.
 func (p *Game) KeyPressed(key Key) bool {
+	if p.replayer.active() {
+		return p.replayer.keyPressed(key)
+	}
 	return inputMgr.GetKey(int64(key))
 }
 
+// KeyJustPressed reports whether key was pressed in this frame.
+func (p *Game) KeyJustPressed(key Key) bool {
+	return p.inputs.keys.justPressed[key]
+}
+
+// KeyJustReleased reports whether key was released in this frame.
+func (p *Game) KeyJustReleased(key Key) bool {
+	return p.inputs.keys.justReleased[key]
+}
+
 // ============================================================================
 // Mouse Input
 // ============================================================================
@@ -42,6 +115,9 @@ func (p *Game) MouseY() float64 {
 }
 
 func (p *Game) MousePressed() bool {
+	if p.replayer.active() {
+		return p.replayer.mousePressed
+	}
 	return inputMgr.MousePressed()
 }
 
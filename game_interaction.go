@@ -115,6 +115,7 @@ func (p *Game) doBroadcast(msg string, data any, wait bool) {
 	if debugInstr {
 		spxlog.Debug("Broadcast: msg=%s, wait=%v", msg, wait)
 	}
+	p.sinkMgr.countSent(messageKey{name: msg}, p.sinkMgr.allWhenIReceive, msg, p.debug)
 	p.sinkMgr.doWhenIReceive(msg, data, wait)
 }
 
@@ -0,0 +1,408 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"math"
+	"slices"
+
+	"github.com/goplus/spbase/mathf"
+	spxlog "github.com/goplus/spx/v2/internal/log"
+	gtime "github.com/goplus/spx/v2/internal/time"
+)
+
+// -------------------------------------------------------------------------------------
+// Joints
+//
+// A joint constrains how two sprites move relative to each other, see
+// AddJoint for what the joints can move.
+//
+// The anchors are the points joined, relative to the sprite positions when
+// heading right: they turn with the sprites.
+
+// JointKind is the kind of a joint, see AddJoint.
+type JointKind int
+
+const (
+	PinJoint      JointKind = iota // keeps the anchors together
+	SpringJoint                    // pulls the anchors to Length apart, as a damped spring
+	DistanceJoint                  // keeps the anchors Length apart, like a rod
+	SliderJoint                    // keeps the anchor of b on the axis of a, between Lower and Upper
+	HingeJoint                     // a pin joint whose motor turns the heading of b at MotorSpeed
+)
+
+func toJointKind(kind string) JointKind {
+	switch kind {
+	case "spring":
+		return SpringJoint
+	case "distance":
+		return DistanceJoint
+	case "slider":
+		return SliderJoint
+	case "hinge":
+		return HingeJoint
+	}
+	return PinJoint
+}
+
+// JointParams tunes a joint, see AddJoint. The fields not used by the kind of
+// the joint are ignored.
+type JointParams struct {
+	// anchors of the sprites a and b. For pin, slider and hinge joints, if they
+	// are both 0, the anchor of a is where b is when the joint is added.
+	AnchorAX float64 `json:"anchorAX"`
+	AnchorAY float64 `json:"anchorAY"`
+	AnchorBX float64 `json:"anchorBX"`
+	AnchorBY float64 `json:"anchorBY"`
+
+	// distance between the anchors of spring and distance joints, the one when
+	// the joint is added if 0
+	Length float64 `json:"length"`
+
+	Stiffness float64 `json:"stiffness"` // force of a spring per pixel stretched, default 50
+	Damping   float64 `json:"damping"`   // force of a spring against its speed of stretching
+
+	// axis of a slider joint, relative to a, default (1, 0)
+	AxisX float64 `json:"axisX"`
+	AxisY float64 `json:"axisY"`
+
+	// limits of a slider joint along the axis, none if Lower >= Upper
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+
+	// speed of the motor: in degrees per second for a hinge joint, turning b
+	// clockwise if > 0, or in pixels per second along the axis for a slider
+	// joint. Only a dynamic b has a motor.
+	MotorSpeed float64 `json:"motorSpeed"`
+
+	BreakForce float64 `json:"breakForce"` // the joint breaks if it pulls harder, never if 0
+}
+
+const (
+	jointDefaultStiffness = 50
+	jointIterations       = 8   // solver passes per frame, for the chains
+	jointBias             = 0.5 // part of the position error fixed per frame
+)
+
+// JointHandle is returned by AddJoint to break or tune the joint.
+type JointHandle struct {
+	kind   JointKind
+	a, b   *SpriteImpl
+	params JointParams
+	broken bool
+
+	impulse mathf.Vec2 // net impulse applied in the current frame
+}
+
+// Break removes the joint.
+func (j *JointHandle) Break() {
+	j.broken = true
+}
+
+// Broken reports whether the joint was removed, by Break, by its BreakForce or
+// by one of its sprites being destroyed.
+func (j *JointHandle) Broken() bool {
+	return j.broken
+}
+
+// Kind returns the kind of the joint.
+func (j *JointHandle) Kind() JointKind {
+	return j.kind
+}
+
+// Params returns the parameters of the joint, with Length and the anchors
+// filled in when the joint was added.
+func (j *JointHandle) Params() JointParams {
+	return j.params
+}
+
+// SetParams changes the parameters of the joint.
+func (j *JointHandle) SetParams(params JointParams) {
+	j.params = params
+	if j.kind == SpringJoint && j.params.Stiffness == 0 {
+		j.params.Stiffness = jointDefaultStiffness
+	}
+	j.SetMotorSpeed(j.params.MotorSpeed)
+}
+
+// SetMotorSpeed changes the speed of the motor of a hinge or slider joint. The
+// motor is rejected, with a warning, if b isn't a dynamic sprite.
+func (j *JointHandle) SetMotorSpeed(speed float64) {
+	if speed != 0 && j.b.physicsMode != DynamicPhysics {
+		spxlog.Warn("joint motor of %s ignored, the sprite isn't dynamic", j.b.name)
+		speed = 0
+	}
+	j.params.MotorSpeed = speed
+}
+
+// motorSpeed returns the speed of the motor, 0 if b is no longer dynamic
+func (j *JointHandle) motorSpeed() float64 {
+	if j.b.physicsMode != DynamicPhysics {
+		return 0
+	}
+	return j.params.MotorSpeed
+}
+
+// -------------------------------------------------------------------------------------
+
+// AddJoint joins the sprites a and b by a joint of the given kind. The joint
+// is removed when one of them is destroyed.
+//
+// The joints are solved by spx, not by the physics engine: before each physics
+// step, they change the velocities of the dynamic sprites, and only those
+// move. A static, kinematic or non-physics sprite stays where it is, like a
+// fixed anchor. The physics bodies don't rotate, so a joint doesn't turn the
+// sprites, except the motor of a hinge joint, which turns the heading of b.
+func (p *Game) AddJoint(kind JointKind, a, b Sprite, params JointParams) *JointHandle {
+	j := &JointHandle{kind: kind, a: spriteOf(a), b: spriteOf(b)}
+	if j.a == nil || j.b == nil || j.a == j.b || j.a.HasDestroyed || j.b.HasDestroyed {
+		j.broken = true
+		return j
+	}
+	switch kind {
+	case PinJoint, SliderJoint, HingeJoint:
+		if params.AnchorAX == 0 && params.AnchorAY == 0 && params.AnchorBX == 0 && params.AnchorBY == 0 {
+			anchor := rotateVec(mathf.NewVec2(j.b.x-j.a.x, j.b.y-j.a.y), -headingAngle(j.a))
+			params.AnchorAX, params.AnchorAY = anchor.X, anchor.Y
+		}
+	case SpringJoint, DistanceJoint:
+		if params.Length <= 0 {
+			j.params = params
+			anchorA, anchorB := j.anchors(newJointBody(j.a), newJointBody(j.b))
+			params.Length = anchorB.Sub(anchorA).Length()
+		}
+	}
+	j.SetParams(params)
+	p.joints = append(p.joints, j)
+	return j
+}
+
+// Joints returns the joints of the sprite, or all the joints if sprite is nil.
+func (p *Game) Joints(sprite Sprite) []*JointHandle {
+	spr := spriteOf(sprite)
+	var joints []*JointHandle
+	for _, j := range p.joints {
+		if !j.broken && (spr == nil || j.a == spr || j.b == spr) {
+			joints = append(joints, j)
+		}
+	}
+	return joints
+}
+
+// deleteJoints breaks the joints of a sprite being destroyed
+func (p *Game) deleteJoints(sprite *SpriteImpl) {
+	for _, j := range p.joints {
+		if j.a == sprite || j.b == sprite {
+			j.broken = true
+		}
+	}
+}
+
+// setupJoints adds the joints described by index.json, or by a scene. The
+// joints of the sprites unloaded with the previous scene are already broken,
+// the ones of the persistent sprites are kept.
+func (p *Game) setupJoints(proj *projConfig) {
+	for _, cfg := range proj.Joints {
+		a, b := p.sprs[cfg.A], p.sprs[cfg.B]
+		if a == nil || b == nil {
+			spxlog.Warn("joint between unknown sprites: %s, %s", cfg.A, cfg.B)
+			continue
+		}
+		p.AddJoint(toJointKind(cfg.Kind), a, b, cfg.JointParams)
+	}
+}
+
+// -------------------------------------------------------------------------------------
+
+// jointBody is the state of a sprite during the solving of the joints
+type jointBody struct {
+	pos, vel mathf.Vec2
+	invMass  float64 // 0 if the joints don't move the sprite
+	angle    float64 // radians, counterclockwise from heading right
+	changed  bool
+}
+
+func newJointBody(spr *SpriteImpl) *jointBody {
+	return &jointBody{pos: mathf.NewVec2(spr.x, spr.y), angle: headingAngle(spr)}
+}
+
+// updateJoints solves the joints for the next physics step
+func (p *Game) updateJoints() {
+	p.joints = slices.DeleteFunc(p.joints, func(j *JointHandle) bool {
+		return j.broken
+	})
+	dt := gtime.DeltaTime()
+	if len(p.joints) == 0 || dt <= 0 {
+		return
+	}
+
+	bodies := make(map[*SpriteImpl]*jointBody)
+	bodyOf := func(spr *SpriteImpl) *jointBody {
+		if b, ok := bodies[spr]; ok {
+			return b
+		}
+		b := newJointBody(spr)
+		if spr.physicsMode != NoPhysics {
+			b.vel = spriteMgr.GetVelocity(spr.getSpriteId())
+		}
+		if spr.physicsMode == DynamicPhysics && !pausedObj(spr) {
+			b.invMass = 1
+			if mass := spr.mass; mass > 0 {
+				b.invMass = 1 / mass
+			}
+		}
+		bodies[spr] = b
+		return b
+	}
+
+	joints := make([]*JointHandle, 0, len(p.joints))
+	for _, j := range p.joints {
+		if !pausedObj(j.a) && !pausedObj(j.b) {
+			joints = append(joints, j)
+		}
+	}
+	for _, j := range joints {
+		if speed := j.motorSpeed(); j.kind == HingeJoint && speed != 0 {
+			j.b.setDirection(speed*dt*objTimeScale(j.b), true)
+		}
+	}
+	n := 0
+	for _, j := range joints {
+		a, b := bodyOf(j.a), bodyOf(j.b)
+		if a.invMass+b.invMass == 0 {
+			continue
+		}
+		j.impulse = mathf.Vec2{}
+		if j.kind == SpringJoint {
+			j.applySpring(a, b, dt)
+		}
+		joints[n] = j
+		n++
+	}
+	joints = joints[:n]
+	for range jointIterations {
+		for _, j := range joints {
+			j.solve(bodyOf(j.a), bodyOf(j.b), dt)
+		}
+	}
+
+	for _, j := range joints {
+		if j.params.BreakForce > 0 && j.impulse.Length()/dt > j.params.BreakForce {
+			j.broken = true
+		}
+	}
+	for spr, b := range bodies {
+		if b.changed {
+			spriteMgr.SetVelocity(spr.getSpriteId(), b.vel)
+		}
+	}
+}
+
+// anchors returns the anchors of the joint in world space
+func (j *JointHandle) anchors(a, b *jointBody) (anchorA, anchorB mathf.Vec2) {
+	anchorA = a.pos.Add(rotateVec(mathf.NewVec2(j.params.AnchorAX, j.params.AnchorAY), a.angle))
+	anchorB = b.pos.Add(rotateVec(mathf.NewVec2(j.params.AnchorBX, j.params.AnchorBY), b.angle))
+	return
+}
+
+// applySpring applies the force of a spring joint for the frame
+func (j *JointHandle) applySpring(a, b *jointBody, dt float64) {
+	anchorA, anchorB := j.anchors(a, b)
+	d := anchorB.Sub(anchorA)
+	dist := d.Length()
+	if dist == 0 {
+		return
+	}
+	n := d.Divf(dist)
+	speed := b.vel.Sub(a.vel).Dot(n)
+	force := -(j.params.Stiffness*(dist-j.params.Length) + j.params.Damping*speed)
+	j.applyImpulse(a, b, n.Mulf(force*dt))
+}
+
+// solve changes the velocities of the sprites to fix the joint in the next
+// physics step
+func (j *JointHandle) solve(a, b *jointBody, dt float64) {
+	anchorA, anchorB := j.anchors(a, b)
+	d := anchorB.Sub(anchorA)
+	vel := b.vel.Sub(a.vel)
+	switch j.kind {
+	case PinJoint, HingeJoint:
+		j.applyVelocity(a, b, d.Mulf(-jointBias/dt).Sub(vel))
+	case DistanceJoint:
+		dist := d.Length()
+		if dist == 0 {
+			return
+		}
+		n := d.Divf(dist)
+		target := -jointBias * (dist - j.params.Length) / dt
+		j.applyVelocity(a, b, n.Mulf(target-vel.Dot(n)))
+	case SliderJoint:
+		axis := mathf.NewVec2(j.params.AxisX, j.params.AxisY)
+		if axis.LengthSquared() == 0 {
+			axis = mathf.NewVec2(1, 0)
+		}
+		axis = rotateVec(axis.Normalize(), a.angle)
+		normal := mathf.NewVec2(-axis.Y, axis.X)
+		dv := normal.Mulf(-jointBias*d.Dot(normal)/dt - vel.Dot(normal))
+
+		t, speed := d.Dot(axis), vel.Dot(axis)
+		target := speed
+		if speed := j.motorSpeed(); speed != 0 {
+			target = speed
+		}
+		if j.params.Lower < j.params.Upper {
+			if t < j.params.Lower {
+				target = math.Max(target, -jointBias*(t-j.params.Lower)/dt)
+			} else if t > j.params.Upper {
+				target = math.Min(target, -jointBias*(t-j.params.Upper)/dt)
+			}
+		}
+		j.applyVelocity(a, b, dv.Add(axis.Mulf(target-speed)))
+	}
+}
+
+// applyVelocity changes the velocity of b relative to a by dv, sharing it by
+// their masses
+func (j *JointHandle) applyVelocity(a, b *jointBody, dv mathf.Vec2) {
+	j.applyImpulse(a, b, dv.Divf(a.invMass+b.invMass))
+}
+
+func (j *JointHandle) applyImpulse(a, b *jointBody, impulse mathf.Vec2) {
+	if impulse.LengthSquared() == 0 {
+		return
+	}
+	j.impulse = j.impulse.Add(impulse)
+	if a.invMass > 0 {
+		a.vel = a.vel.Sub(impulse.Mulf(a.invMass))
+		a.changed = true
+	}
+	if b.invMass > 0 {
+		b.vel = b.vel.Add(impulse.Mulf(b.invMass))
+		b.changed = true
+	}
+}
+
+// headingAngle returns the rotation of a sprite from heading right, in
+// radians counterclockwise
+func headingAngle(spr *SpriteImpl) float64 {
+	return (90 - spr.direction) * math.Pi / 180
+}
+
+func rotateVec(v mathf.Vec2, angle float64) mathf.Vec2 {
+	sin, cos := math.Sincos(angle)
+	return mathf.NewVec2(v.X*cos-v.Y*sin, v.X*sin+v.Y*cos)
+}
@@ -32,7 +32,9 @@ import (
 
 type clicker interface {
 	threadObj
-	doWhenClick(this threadObj)
+	doWhenClick(this threadObj) bool
+	doWhenRightClick(this threadObj)
+	doWhenMiddleClick(this threadObj)
 	getProxy() *engine.Sprite
 	Visible() bool
 }
@@ -42,48 +44,71 @@ func (p *Game) doWhenLeftButtonUp(ev *eventLeftButtonUp) {
 	p.inputs.checkTracking(point)
 }
 
-func (p *Game) doWhenLeftButtonDown(ev *eventLeftButtonDown) {
-	point := ev.Pos
+// clickerAt returns the topmost visible sprite at point, or nil if none
+func (p *Game) clickerAt(point mathf.Vec2) clicker {
+	var hit clicker
+	p.eachClickerAt(point, func(o clicker) bool {
+		hit = o
+		return false
+	})
+	return hit
+}
 
-	// Detect target sprite for both swipe and click events
+// eachClickerAt calls fn with the visible sprites at point, from the topmost
+// one, until it returns false
+func (p *Game) eachClickerAt(point mathf.Vec2, fn func(o clicker) bool) {
 	tempItems := p.getTempShapes()
 	count := len(tempItems)
-
-	var target clicker = nil
-	var targetSprite *SpriteImpl = nil
 	for i := range count {
 		item := tempItems[count-i-1]
 		if o, ok := item.(clicker); ok {
 			syncSprite := o.getProxy()
 			if syncSprite != nil && o.Visible() {
-				isClicked := spriteMgr.CheckCollisionWithPoint(syncSprite.GetId(), point, true)
-				if isClicked {
-					target = o
-					// Try to get the SpriteImpl from the clicker
-					if sprite, ok := o.(*SpriteImpl); ok {
-						targetSprite = sprite
-					}
-					break
+				if spriteMgr.CheckCollisionWithPoint(syncSprite.GetId(), point, true) && !fn(o) {
+					return
 				}
 			}
 		}
 	}
+}
+
+// clickThrough reports whether o lets the clicks through to what is below it
+func clickThrough(o clicker) bool {
+	sprite, ok := o.(*SpriteImpl)
+	return ok && sprite.clickThrough
+}
+
+func (p *Game) doWhenLeftButtonDown(ev *eventLeftButtonDown) {
+	point := ev.Pos
 
-	// Start swipe tracking with detected target sprite (can be nil for stage swipes)
+	// Detect the sprites hit for both swipe and click events
+	var hits []clicker
+	p.eachClickerAt(point, func(o clicker) bool {
+		hits = append(hits, o)
+		return clickThrough(o)
+	})
+
+	// Start swipe tracking with the topmost sprite (nil for stage swipes)
+	var targetSprite *SpriteImpl
+	if len(hits) > 0 {
+		targetSprite, _ = hits[0].(*SpriteImpl)
+	}
 	p.inputs.startTracking(point, targetSprite)
 
 	// add a global click cooldown
-	if !p.inputs.canTriggerClickEvent(inputGlobalClickTimerId) {
+	if !p.inputs.canTriggerClickEvent(MOUSE_BUTTON_LEFT, inputGlobalClickTimerId) {
 		return
 	}
 
-	if target != nil {
-		syncSprite := target.getProxy()
-		if p.inputs.canTriggerClickEvent(syncSprite.GetId()) {
-			target.doWhenClick(target)
+	// The click goes to the topmost sprite, then to the ones below as long as
+	// they let it through, and to the stage if none stops it
+	for _, o := range hits {
+		if p.inputs.canTriggerClickEvent(MOUSE_BUTTON_LEFT, o.getProxy().GetId()) && o.doWhenClick(o) {
+			return
 		}
-	} else {
-		if p.inputs.canTriggerClickEvent(inputStageClickTimerId) {
+	}
+	if len(hits) == 0 || clickThrough(hits[len(hits)-1]) {
+		if p.inputs.canTriggerClickEvent(MOUSE_BUTTON_LEFT, inputStageClickTimerId) {
 			p.sinkMgr.doWhenClick(p)
 		}
 	}
@@ -105,11 +130,46 @@ func (p *Game) handleEvent(ev event) {
 		p.doWhenLeftButtonDown(e)
 	case *eventMouseMove:
 		p.doWhenMouseMove(e)
+	case *eventMouseButtonDown:
+		p.doWhenMouseButtonDown(e)
+	case *eventMouseWheel:
+		p.sinkMgr.doWhenMouseWheel(e.Delta)
+	case *eventMouseEnter:
+		p.sinkMgr.doWhenMouseEnter(e.Target)
+	case *eventMouseLeave:
+		p.sinkMgr.doWhenMouseLeave(e.Target)
 	case *eventKeyDown:
 		p.sinkMgr.doWhenKeyPressed(e.Key)
+	case *eventKeyUp:
+		p.sinkMgr.doWhenKeyReleased(e.Key)
+	case *eventKeyHeld:
+		p.sinkMgr.doWhenKeyHeld(e.Key)
+	case *eventTouchDown:
+		p.sinkMgr.doWhenTouchDown(e.ID, e.Pos)
+	case *eventTouchMove:
+		p.sinkMgr.doWhenTouchMove(e.ID, e.Pos)
+	case *eventTouchUp:
+		p.sinkMgr.doWhenTouchUp(e.ID, e.Pos)
+	case *eventPinch:
+		p.doWhenPinch(e)
+	case *eventRotateGesture:
+		p.sinkMgr.doWhenRotateGesture(e.Angle)
+	case *eventLongPress:
+		p.sinkMgr.doWhenLongPress(e.Target)
+	case *eventDoubleTap:
+		p.sinkMgr.doWhenDoubleTap(e.Target)
+	case *eventDrag:
+		p.sinkMgr.doWhenDrag(e.Target, e.Delta)
+	case *eventGamepadButton:
+		p.sinkMgr.doWhenGamepadButton(e.ID, e.Button)
+	case *eventGamepadConnected:
+		p.sinkMgr.doWhenGamepadConnected(e.ID, e.Connected)
+	case *eventAction:
+		p.sinkMgr.doWhenAction(e.Name)
 	case *eventStart:
 		p.sinkMgr.doWhenAwake(nil)
 		p.sinkMgr.doWhenStart()
+		p.restoreHotReload()
 	case *eventTimer:
 		p.sinkMgr.doWhenTimer(e.Time)
 	}
@@ -118,6 +178,9 @@ func (p *Game) handleEvent(ev event) {
 func (p *Game) fireEvent(ev event) {
 	select {
 	case p.events <- ev:
+		if p.recorder != nil {
+			p.recorder.onEvent(ev)
+		}
 	default:
 		if debugInstr {
 			spxlog.Warn("Event buffer is full. Skip event: %v", ev)
@@ -125,12 +188,32 @@ func (p *Game) fireEvent(ev event) {
 	}
 }
 
-func (p *Game) eventLoop(me coroutine.Thread) int {
+// dispatchEvents handles the events fired so far, and reports whether events
+// is still open
+func (p *Game) dispatchEvents(events chan event) bool {
 	for {
-		var ev event
-		engine.WaitForChan(p.events, &ev)
-		p.handleEvent(ev)
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return false
+			}
+			p.handleEvent(ev)
+		default:
+			return true
+		}
+	}
+}
+
+func (p *Game) eventLoop(me coroutine.Thread) int {
+	events := p.events
+	// Dispatch the events fired so far once per frame, inputEventLoop
+	// dispatching the input ones as soon as it fires them. Blocking on the
+	// channel would resume this thread from another goroutine, racing with the
+	// end of the frame, and recorded runs could not be replayed.
+	for p.dispatchEvents(events) {
+		engine.WaitNextFrame()
 	}
+	return 0
 }
 
 // processPendingAudios plays any pending audio for sprites
@@ -172,6 +255,7 @@ func (p *Game) processAnimationEvents(items []Shape, tempAnimations []string) []
 func (p *Game) logicLoop(me coroutine.Thread) int {
 	tempAudios := []string{}
 	tempAnimations := []string{}
+	events := p.events
 	for {
 		p.camera.onUpdate(gtime.DeltaTime())
 		tempItems := p.getTempShapes()
@@ -183,7 +267,12 @@ func (p *Game) logicLoop(me coroutine.Thread) int {
 		if targetTimer := timer.CheckTimerEvent(); targetTimer >= 0 {
 			p.fireEvent(&eventTimer{Time: targetTimer})
 		}
+		p.sinkMgr.updateTimers()
+		p.updateJoints()
 		engine.WaitNextFrame()
+		if p.events != events {
+			return 0 // the game was reset, another loop runs it
+		}
 		p.showDebugPanel()
 	}
 }
@@ -191,45 +280,68 @@ func (p *Game) logicLoop(me coroutine.Thread) int {
 func (p *Game) inputEventLoop(me coroutine.Thread) int {
 	lastLbtnPressed := false
 	lastMousePos := mathf.Vec2{} // Track last mouse position
-	keyEvents := make([]engine.KeyEvent, 0)
+	events := p.events
 
 	for {
-		// Check mouse button state
-		curLbtnPressed := inputMgr.GetMouseState(MOUSE_BUTTON_LEFT)
-		if curLbtnPressed != lastLbtnPressed {
-			if lastLbtnPressed {
-				p.fireEvent(&eventLeftButtonUp{Pos: p.mousePos})
-			} else {
-				p.fireEvent(&eventLeftButtonDown{Pos: p.mousePos})
+		if p.replayer.active() {
+			// Fire the recorded events instead, the live ones are dropped
+			for _, ev := range p.replayer.events() {
+				p.fireEvent(ev)
 			}
-		}
-		lastLbtnPressed = curLbtnPressed
+			lastLbtnPressed = p.replayer.mousePressed
+		} else {
+			// Check mouse button state
+			curLbtnPressed := inputMgr.GetMouseState(MOUSE_BUTTON_LEFT)
+			if curLbtnPressed != lastLbtnPressed {
+				if lastLbtnPressed {
+					p.fireEvent(&eventLeftButtonUp{Pos: p.mousePos})
+				} else {
+					p.fireEvent(&eventLeftButtonDown{Pos: p.mousePos})
+				}
+			}
+			lastLbtnPressed = curLbtnPressed
 
-		// Check mouse movement
-		// Note: We need to get the actual current mouse position from the engine
-		// For now, we'll use the stored mousePos which should be updated elsewhere
-		curMousePos := inputMgr.GetGlobalMousePos()
-		mathfMousePos := mathf.Vec2{X: float64(curMousePos.X), Y: float64(curMousePos.Y)}
+			// Handle keyboard events, synced at the beginning of the frame
+			keyEvents := p.inputs.keys.events
+			for _, ev := range keyEvents {
+				if ev.IsPressed {
+					p.fireEvent(&eventKeyDown{Key: Key(ev.Id)})
+				} else {
+					p.fireEvent(&eventKeyUp{Key: Key(ev.Id)})
+				}
+			}
+			p.inputs.keys.events = keyEvents[:0]
+		}
+		// The right and middle buttons, the wheel, the touches and the
+		// gamepads are synced from the recording when replaying, their events
+		// are fired again from the same state
+		p.inputs.mouse.poll(p)
+		p.inputs.touches.poll(p)
+		p.inputs.gamepads.poll(p)
+		p.inputs.keys.update(p)
+		p.inputs.mouse.updateHover(p)
+		fingers := p.inputs.touches.fingers(lastLbtnPressed, p.mousePos)
+		p.inputs.gestures.update(p, fingers)
+		p.inputs.actions.update(p, fingers)
 
-		// Check if mouse moved significantly
-		dx := mathfMousePos.X - lastMousePos.X
-		dy := mathfMousePos.Y - lastMousePos.Y
+		// Check if mouse moved significantly. The position is the one synced
+		// at the beginning of the frame, so recorded runs see the same path.
+		curMousePos := p.mousePos
+		dx := curMousePos.X - lastMousePos.X
+		dy := curMousePos.Y - lastMousePos.Y
 		if math.Abs(dx) > mouseMovementThreshold || math.Abs(dy) > mouseMovementThreshold {
-			p.inputs.onMouseMove(mathfMousePos)
-			lastMousePos = mathfMousePos
+			p.inputs.onMouseMove(curMousePos)
+			lastMousePos = curMousePos
 		}
-
-		// Handle keyboard events
-		keyEvents = engine.GetKeyEvents(keyEvents)
-		for _, ev := range keyEvents {
-			if ev.IsPressed {
-				p.fireEvent(&eventKeyDown{Key: Key(ev.Id)})
-			} else {
-				p.fireEvent(&eventKeyUp{Key: Key(ev.Id)})
-			}
+		// right away rather than in the next frame, once eventLoop has started
+		// the game
+		if p.sinkMgr.calledStart {
+			p.dispatchEvents(events)
 		}
-		keyEvents = keyEvents[:0]
 		engine.WaitNextFrame()
+		if p.events != events {
+			return 0 // the game was reset, another loop runs it
+		}
 	}
 }
 
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"github.com/goplus/spbase/mathf"
+	"github.com/goplus/spx/v2/internal/engine"
+)
+
+// -------------------------------------------------------------------------------------
+// Mouse Buttons, Wheel and Hover
+//
+// The left button clicks and swipes, see doWhenLeftButtonDown. The right and
+// middle buttons click too, with the same cooldowns, and the wheel scrolls. The
+// sprite under the cursor, or the stage if none, is hovered: the mouse enters
+// it and later leaves it. Like the mouse position, the right and middle
+// buttons and the wheel are synced at the beginning of the frame, which lets
+// recorded runs replay them.
+
+type eventMouseButtonDown struct {
+	Button int64 // MOUSE_BUTTON_RIGHT or MOUSE_BUTTON_MIDDLE
+	Pos    mathf.Vec2
+}
+
+type eventMouseWheel struct {
+	Delta mathf.Vec2
+}
+
+type eventMouseEnter struct {
+	Target threadObj
+}
+
+type eventMouseLeave struct {
+	Target threadObj
+}
+
+// inputMouse tracks the right and middle buttons, the wheel and the hovered
+// sprite to fire their events
+type inputMouse struct {
+	wheel   mathf.Vec2 // synced at the beginning of the frame
+	buttons int64      // bit i is set if button i is pressed, synced likewise
+	right   bool
+	middle  bool
+	hovered threadObj // the sprite hovered, or the game
+}
+
+// syncButtons reads the right and middle buttons from the engine
+func (p *inputMouse) syncButtons() {
+	p.buttons = 0
+	for _, button := range [...]int64{MOUSE_BUTTON_RIGHT, MOUSE_BUTTON_MIDDLE} {
+		if engine.SyncGetMouseState(button) {
+			p.buttons |= 1 << button
+		}
+	}
+}
+
+// pressed reports whether the right or middle button is pressed
+func (p *inputMouse) pressed(button int64) bool {
+	return p.buttons&(1<<button) != 0
+}
+
+// poll fires the right and middle buttons pressed and the wheel scrolled
+// since the last poll
+func (p *inputMouse) poll(g *Game) {
+	if right := p.pressed(MOUSE_BUTTON_RIGHT); right != p.right {
+		if p.right = right; right {
+			g.fireEvent(&eventMouseButtonDown{Button: MOUSE_BUTTON_RIGHT, Pos: g.mousePos})
+		}
+	}
+	if middle := p.pressed(MOUSE_BUTTON_MIDDLE); middle != p.middle {
+		if p.middle = middle; middle {
+			g.fireEvent(&eventMouseButtonDown{Button: MOUSE_BUTTON_MIDDLE, Pos: g.mousePos})
+		}
+	}
+	if p.wheel != (mathf.Vec2{}) {
+		g.fireEvent(&eventMouseWheel{Delta: p.wheel})
+		p.wheel = mathf.Vec2{}
+	}
+}
+
+// updateHover fires the mouse leaving the sprite it hovered and entering the
+// one it hovers now. Hit testing all the sprites in every frame isn't free, it
+// only happens while some script waits for these events.
+func (p *inputMouse) updateHover(g *Game) {
+	sinks := &g.sinkMgr
+	if len(sinks.allWhenMouseEnter) == 0 && len(sinks.allWhenMouseLeave) == 0 {
+		p.hovered = nil
+		return
+	}
+	hovered := g.touchTarget(g.mousePos)
+	if hovered == p.hovered {
+		return
+	}
+	if p.hovered != nil {
+		g.fireEvent(&eventMouseLeave{Target: p.hovered})
+	}
+	p.hovered = hovered
+	g.fireEvent(&eventMouseEnter{Target: hovered})
+}
+
+func (p *Game) doWhenMouseButtonDown(ev *eventMouseButtonDown) {
+	if !p.inputs.canTriggerClickEvent(ev.Button, inputGlobalClickTimerId) {
+		return
+	}
+	target := p.clickerAt(ev.Pos)
+	if target == nil {
+		if p.inputs.canTriggerClickEvent(ev.Button, inputStageClickTimerId) {
+			if ev.Button == MOUSE_BUTTON_RIGHT {
+				p.sinkMgr.doWhenRightClick(p)
+			} else {
+				p.sinkMgr.doWhenMiddleClick(p)
+			}
+		}
+		return
+	}
+	if p.inputs.canTriggerClickEvent(ev.Button, target.getProxy().GetId()) {
+		if ev.Button == MOUSE_BUTTON_RIGHT {
+			target.doWhenRightClick(target)
+		} else {
+			target.doWhenMiddleClick(target)
+		}
+	}
+}
+
+// -------------------------------------------------------------------------------------
+
+// SpriteAt returns the topmost visible sprite at (x, y), or nil if there is
+// none.
+func (p *Game) SpriteAt(x, y float64) Sprite {
+	if spr, ok := p.clickerAt(mathf.NewVec2(x, y)).(*SpriteImpl); ok {
+		return spr.sprite
+	}
+	return nil
+}
+
+// SpriteUnderMouse returns the topmost visible sprite under the mouse cursor,
+// or nil if there is none.
+func (p *Game) SpriteUnderMouse() Sprite {
+	return p.SpriteAt(p.mousePos.X, p.mousePos.Y)
+}
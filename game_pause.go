@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"github.com/goplus/spbase/mathf"
+	"github.com/goplus/spx/v2/internal/coroutine"
+)
+
+// -------------------------------------------------------------------------------------
+// Pause
+//
+// Pausing the game freezes the stage and the sprites, but the ones exempted
+// from it: their waits, glides and animations stop where they are, their
+// timers stop counting and they don't receive events, until the game resumes.
+// A pause menu is made of exempted sprites, which still animate and handle
+// clicks. The engine isn't paused, the exempted sprites need it: the bodies of
+// the paused sprites are frozen instead, see syncPhysicsFreeze.
+
+func init() {
+	gco.SetPauseFilter(func(th coroutine.Thread) bool {
+		return pausedObj(th.Obj)
+	})
+}
+
+// pausedObj reports whether obj, the owner of a script, is frozen by Pause
+func pausedObj(obj threadObj) bool {
+	switch o := obj.(type) {
+	case *SpriteImpl:
+		return o.g != nil && o.g.paused && !o.pauseExempt
+	case *Game:
+		return o.paused && !o.pauseExempt
+	}
+	return false
+}
+
+// syncAnimSpeed scales the animation of the sprite by its time scale, and
+// freezes it while it's paused
+func (p *SpriteImpl) syncAnimSpeed() {
+	if p.syncSprite == nil {
+		return
+	}
+	speed := p.timeScale
+	if pausedObj(p) {
+		speed = 0
+	}
+	spriteMgr.SetAnimSpeedScale(p.syncSprite.GetId(), speed)
+}
+
+// syncPhysicsFreeze makes the body of the sprite static while it's paused, so
+// that it neither moves nor falls. Its velocity is kept aside and restored
+// when the game resumes.
+func (p *SpriteImpl) syncPhysicsFreeze() {
+	if p.syncSprite == nil {
+		return
+	}
+	id := p.syncSprite.GetId()
+	freeze := pausedObj(p) && (p.physicsMode == DynamicPhysics || p.physicsMode == KinematicPhysics)
+	switch {
+	case freeze && !p.frozen:
+		p.frozen, p.frozenVelocity = true, spriteMgr.GetVelocity(id)
+		spriteMgr.SetVelocity(id, mathf.Vec2{})
+		spriteMgr.SetPhysicsMode(id, StaticPhysics)
+	case !freeze && p.frozen:
+		p.frozen = false
+		spriteMgr.SetPhysicsMode(id, p.physicsMode)
+		spriteMgr.SetVelocity(id, p.frozenVelocity)
+	}
+}
+
+// syncPaused freezes the animations and the bodies of the sprites paused, and
+// unfreezes the others
+func (p *Game) syncPaused() {
+	for _, item := range p.getAllShapes() {
+		if spr, ok := item.(*SpriteImpl); ok {
+			spr.syncAnimSpeed()
+			spr.syncPhysicsFreeze()
+		}
+	}
+}
+
+// -------------------------------------------------------------------------------------
+
+// Pause freezes the stage and the sprites not exempted from it, see
+// SetPauseExempt. The OnPause handlers are called first.
+func (p *Game) Pause() {
+	if p.paused {
+		return
+	}
+	p.sinkMgr.doWhenPause()
+	p.paused = true
+	p.syncPaused()
+}
+
+// Resume resumes the game paused by Pause, then calls the OnResume handlers.
+func (p *Game) Resume() {
+	if !p.paused {
+		return
+	}
+	p.paused = false
+	p.syncPaused()
+	p.sinkMgr.doWhenResume()
+}
+
+// Paused reports whether the game is paused.
+func (p *Game) Paused() bool {
+	return p.paused
+}
+
+// SetPauseExempt makes the stage scripts keep running while the game is
+// paused, or not if exempt is false.
+func (p *Game) SetPauseExempt(exempt bool) {
+	p.pauseExempt = exempt
+}
+
+// SetScriptPauseExempt makes the running script keep running while the game
+// is paused, or not if exempt is false, whether its sprite is exempted or not.
+func (p *Game) SetScriptPauseExempt(exempt bool) {
+	if th := gco.Current(); th != nil {
+		th.SetPauseExempt(exempt)
+	}
+}
+
+// SetPauseExempt makes the sprite keep running while the game is paused, or
+// not if exempt is false. Its clones made afterwards are exempted too.
+func (p *SpriteImpl) SetPauseExempt(exempt bool) {
+	p.pauseExempt = exempt
+	p.syncAnimSpeed()
+	p.syncPhysicsFreeze()
+}
+
+// PauseExempt reports whether the sprite keeps running while the game is
+// paused.
+func (p *SpriteImpl) PauseExempt() bool {
+	return p.pauseExempt
+}
@@ -17,6 +17,7 @@
 package spx
 
 import (
+	"math"
 	"sort"
 
 	"github.com/goplus/spbase/mathf"
@@ -93,6 +94,313 @@ func (p *Game) Raycast__2(fromX, fromY, toX, toY float64) (hit bool, sprite Spri
 	return p.Raycast__0(fromX, fromY, toX, toY, []Sprite{})
 }
 
+// -----------------------------------------------------------------------------
+// Physics Queries with Filters
+//
+// The queries below return the sprites matching a QueryFilter. Rays hit the
+// exact engine shapes. The engine can only query circles and unrotated rects,
+// so the other queries take capsules and polygons as the rects bounding them,
+// and a turned rect as the rect bounding it. The engine finds the candidates,
+// then the collider shapes of spx, see contactShape, tell triggers from
+// collision bodies, rule out the candidates of a turned rect that don't touch
+// it and give the contact points. Those shapes bound capsules and polygons
+// too, so their contacts are approximate.
+
+// QueryFilter selects the sprites a physics query may return. The zero value
+// accepts the collision bodies of all sprites.
+type QueryFilter struct {
+	Mask            int64               // layers to hit, 0 for all of them
+	Ignore          []Sprite            // sprites never hit
+	IncludeTriggers bool                // hit the triggers too, not only the collision bodies
+	Predicate       func(s Sprite) bool // accepts a sprite, if not nil
+}
+
+// QueryHit is a sprite hit by a ray or a shape cast.
+type QueryHit struct {
+	Sprite           Sprite  // nil for the tilemap
+	X, Y             float64 // hit point
+	NormalX, NormalY float64 // unit normal of the surface hit
+	Distance         float64 // from the start of the ray or the cast
+}
+
+const (
+	maxRaycastHits = 16 // rays cast by RaycastAll, each one cast again past the last hit
+	castRefineIter = 8  // bisections to find where a shape cast hits
+)
+
+func (f *QueryFilter) mask() int64 {
+	if f.Mask == 0 {
+		return -1
+	}
+	return f.Mask
+}
+
+func (f *QueryFilter) ignoreIds() []int64 {
+	ids := make([]int64, 0, len(f.Ignore))
+	for _, item := range f.Ignore {
+		if item == nil {
+			continue
+		}
+		if impl := spriteOf(item); impl != nil && impl.syncSprite != nil {
+			ids = append(ids, impl.getSpriteId())
+		}
+	}
+	return ids
+}
+
+func (f *QueryFilter) ignores(sprite *SpriteImpl) bool {
+	for _, item := range f.Ignore {
+		if item != nil && spriteOf(item) == sprite {
+			return true
+		}
+	}
+	return false
+}
+
+// accept reports whether the sprite found by the engine passes the filter,
+// shape being the query shape to check the collision body against when
+// triggers are excluded, or both the body and the trigger when it's turned
+func (f *QueryFilter) accept(sprite *SpriteImpl, shape *contactShape) bool {
+	if f.ignores(sprite) {
+		return false
+	}
+	if shape != nil && (!f.IncludeTriggers || shape.angle != 0) && !f.overlaps(sprite, shape) {
+		return false
+	}
+	return f.Predicate == nil || f.Predicate(sprite.sprite)
+}
+
+// overlaps reports whether shape overlaps the collision body of sprite, or its
+// trigger if triggers are included
+func (f *QueryFilter) overlaps(sprite *SpriteImpl, shape *contactShape) bool {
+	if sprite.collisionInfo.Type != physicsColliderNone {
+		if _, _, depth := contactOf(*shape, sprite.contactShape(false)); depth > 0 {
+			return true
+		}
+	}
+	if f.IncludeTriggers && sprite.triggerInfo.Type != physicsColliderNone {
+		_, _, depth := contactOf(*shape, sprite.contactShape(true))
+		return depth > 0
+	}
+	return false
+}
+
+func spriteOfId(id engine.Object) *SpriteImpl {
+	if sprite := engine.GetSprite(id); sprite != nil {
+		if impl, ok := sprite.Target.(*SpriteImpl); ok {
+			return impl
+		}
+	}
+	return nil
+}
+
+// RaycastAll returns the sprites hit by the ray from (fromX, fromY) to (toX,
+// toY), nearest first. The tilemap blocks the ray: when hit, it's the last
+// hit returned, with a nil sprite. The engine only returns the first hit of a
+// ray, so the ray is cast again past each hit, at most 16 times, counting the
+// sprites the filter rejects: the sprites farther away aren't returned.
+func (p *Game) RaycastAll__0(fromX, fromY, toX, toY float64, filter QueryFilter) []QueryHit {
+	from := mathf.NewVec2(fromX, fromY)
+	to := mathf.NewVec2(toX, toY)
+	ignoreIds := filter.ignoreIds()
+	hits := make([]QueryHit, 0)
+	for i := 0; i < maxRaycastHits; i++ {
+		ary := physicMgr.RaycastWithDetails(from, to, ignoreIds, filter.mask(), filter.IncludeTriggers, true)
+		result, err := tryRaycastResult(ary)
+		if err != nil {
+			spxlog.Warn("Raycast error: %v", err)
+			break
+		}
+		if !result.Hited {
+			break
+		}
+		hit := QueryHit{
+			X:        result.PosX,
+			Y:        result.PosY,
+			NormalX:  result.NormalX,
+			NormalY:  result.NormalY,
+			Distance: mathf.NewVec2(result.PosX, result.PosY).Sub(from).Length(),
+		}
+		impl := spriteOfId(result.SpriteId)
+		if impl == nil {
+			hits = append(hits, hit)
+			break
+		}
+		ignoreIds = append(ignoreIds, result.SpriteId)
+		if filter.Predicate == nil || filter.Predicate(impl.sprite) {
+			hit.Sprite = impl.sprite
+			hits = append(hits, hit)
+		}
+	}
+	return hits
+}
+
+func (p *Game) RaycastAll__1(fromX, fromY, toX, toY float64) []QueryHit {
+	return p.RaycastAll__0(fromX, fromY, toX, toY, QueryFilter{})
+}
+
+// queryShape returns the shape of part centered on (x, y), for the queries of
+// the engine and contactOf. Capsules and polygons are taken as the rects
+// bounding them.
+func queryShape(part *ColliderPart, x, y float64) contactShape {
+	shape := contactShape{center: mathf.NewVec2(x, y).Add(part.pivot())}
+	if part.Type == physicsColliderCircle {
+		if len(part.Params) >= 1 {
+			shape.radius = math.Max(part.Params[0], 0.01)
+		}
+		return shape
+	}
+	cfg := physicConfig{Type: part.Type, Params: part.Params}
+	w, h := cfg.getDimensions()
+	shape.half = mathf.NewVec2(math.Max(w, 0.01)/2, math.Max(h, 0.01)/2)
+	return shape
+}
+
+// overlapShape returns the sprites whose shapes overlap shape
+func (p *Game) overlapShape(shape contactShape, filter *QueryFilter) []*SpriteImpl {
+	var ary engine.Array
+	if shape.radius > 0 {
+		ary = physicMgr.CheckCollisionCircle(shape.center, shape.radius, filter.mask())
+	} else {
+		size := mathf.NewVec2(shape.extent(mathf.NewVec2(1, 0)), shape.extent(mathf.NewVec2(0, 1))).Mulf(2)
+		ary = physicMgr.CheckCollisionRect(shape.center, size, filter.mask())
+	}
+	ids, _ := ary.([]engine.Object)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	sprites := make([]*SpriteImpl, 0, len(ids))
+	for _, id := range ids {
+		if impl := spriteOfId(id); impl != nil && filter.accept(impl, &shape) {
+			sprites = append(sprites, impl)
+		}
+	}
+	return sprites
+}
+
+func toSprites(items []*SpriteImpl) []Sprite {
+	sprites := make([]Sprite, len(items))
+	for i, item := range items {
+		sprites[i] = item.sprite
+	}
+	return sprites
+}
+
+// ShapeCast sweeps the shape from (fromX, fromY) to (toX, toY) and returns
+// the sprites it hits, nearest first. The shape is a rect, a circle, or a
+// capsule or polygon taken as the rect bounding it. The engine has no shape
+// casts: it finds the sprites in the rect bounding the whole sweep, then the
+// path is sampled for each of them every extent of the shape, the radius or
+// half the smallest side, so that none is stepped over. A hit is located by
+// castRefineIter bisections, to within 1/256 of a step. The sprites are
+// checked against the collider shapes of spx, see Contact: a turned capsule
+// or polygon is taken as the turned rect bounding it. Sprites overlapping the
+// shape at the start are hit at distance 0.
+func (p *Game) ShapeCast__0(shape ColliderPart, fromX, fromY, toX, toY float64, filter QueryFilter) []QueryHit {
+	start := queryShape(&shape, fromX, fromY)
+	delta := mathf.NewVec2(toX-fromX, toY-fromY)
+	length := delta.Length()
+	extent := start.radius
+	if extent == 0 {
+		extent = math.Min(start.half.X, start.half.Y)
+	}
+	steps := 1
+	if length > 0 {
+		steps = int(math.Ceil(length / math.Max(extent, 0.5)))
+	}
+	at := func(t float64) contactShape {
+		s := start
+		s.center = s.center.Add(delta.Mulf(t))
+		return s
+	}
+	hitAt := func(sprite *SpriteImpl, t float64) bool {
+		s := at(t)
+		return filter.overlaps(sprite, &s)
+	}
+
+	// the rect bounding the sweep finds the candidates
+	half := mathf.NewVec2(start.extent(mathf.NewVec2(1, 0)), start.extent(mathf.NewVec2(0, 1)))
+	if start.radius > 0 {
+		half = mathf.NewVec2(start.radius, start.radius)
+	}
+	sweep := contactShape{
+		center: start.center.Add(delta.Mulf(0.5)),
+		half:   half.Add(mathf.NewVec2(math.Abs(delta.X), math.Abs(delta.Y)).Mulf(0.5)),
+	}
+	hits := make([]QueryHit, 0)
+	for _, sprite := range p.overlapShape(sweep, &filter) {
+		i := 0
+		for i <= steps && !hitAt(sprite, float64(i)/float64(steps)) {
+			i++
+		}
+		if i > steps {
+			continue
+		}
+		// bisect between the last step it was missed at and this one
+		hi := float64(i) / float64(steps)
+		lo := hi
+		if i > 0 {
+			lo = float64(i-1) / float64(steps)
+		}
+		for j := 0; j < castRefineIter && hi-lo > 0; j++ {
+			mid := (lo + hi) / 2
+			if hitAt(sprite, mid) {
+				hi = mid
+			} else {
+				lo = mid
+			}
+		}
+		cast := at(hi)
+		point, normal, depth := contactOf(cast, sprite.contactShape(false))
+		if filter.IncludeTriggers && (sprite.collisionInfo.Type == physicsColliderNone || depth <= 0) {
+			point, normal, _ = contactOf(cast, sprite.contactShape(true)) // the trigger was hit
+		}
+		hits = append(hits, QueryHit{
+			Sprite:   sprite.sprite,
+			X:        point.X,
+			Y:        point.Y,
+			NormalX:  normal.X,
+			NormalY:  normal.Y,
+			Distance: hi * length,
+		})
+	}
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Distance < hits[j].Distance })
+	return hits
+}
+
+func (p *Game) ShapeCast__1(shape ColliderPart, fromX, fromY, toX, toY float64) []QueryHit {
+	return p.ShapeCast__0(shape, fromX, fromY, toX, toY, QueryFilter{})
+}
+
+// PointQuery returns the sprites whose shapes contain the point (x, y).
+func (p *Game) PointQuery__0(x, y float64, filter QueryFilter) []Sprite {
+	shape := contactShape{center: mathf.NewVec2(x, y), half: mathf.NewVec2(0.5, 0.5)}
+	return toSprites(p.overlapShape(shape, &filter))
+}
+
+func (p *Game) PointQuery__1(x, y float64) []Sprite {
+	return p.PointQuery__0(x, y, QueryFilter{})
+}
+
+// OverlapSprite returns the sprites overlapping the collider of sprite, or
+// its trigger if it has no collider. The collider of a turned sprite is
+// turned with it, a capsule or polygon being taken as the rect bounding it,
+// see Contact.
+func (p *Game) OverlapSprite__0(sprite Sprite, filter QueryFilter) []Sprite {
+	impl := spriteOf(sprite)
+	if impl == nil || impl.syncSprite == nil {
+		return nil
+	}
+	isTrigger := impl.collisionInfo.Type == physicsColliderNone
+	if isTrigger && impl.triggerInfo.Type == physicsColliderNone {
+		return nil
+	}
+	filter.Ignore = append(filter.Ignore[:len(filter.Ignore):len(filter.Ignore)], sprite)
+	return toSprites(p.overlapShape(impl.contactShape(isTrigger), &filter))
+}
+
+func (p *Game) OverlapSprite__1(sprite Sprite) []Sprite {
+	return p.OverlapSprite__0(sprite, QueryFilter{})
+}
+
 // -----------------------------------------------------------------------------
 // Debug Drawing
 
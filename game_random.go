@@ -0,0 +1,235 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/goplus/spx/v2/internal/engine"
+)
+
+// -------------------------------------------------------------------------------------
+// Random Number Source
+//
+// Every game owns its random source, which all randomness of spx goes through:
+// rand, random positions, random list items, noise... Setting the seed, by
+// SetRandomSeed or the randomSeed field of index.json, makes a run
+// reproducible. The source is only used by coroutines, which never run
+// concurrently.
+
+// defaultRand is used when no game is running
+var defaultRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// SetRandomSeed reseeds the random source of the game, so the same seed gives
+// the same sequence of random numbers and the same noise.
+func (p *Game) SetRandomSeed(seed int64) {
+	p.randSeed = seed
+	p.rand = rand.New(rand.NewSource(seed))
+	p.noise = nil
+}
+
+// RandomSeed returns the seed of the random source of the game.
+func (p *Game) RandomSeed() int64 {
+	p.random()
+	return p.randSeed
+}
+
+func (p *Game) random() *rand.Rand {
+	if p.rand == nil {
+		p.SetRandomSeed(time.Now().UnixNano())
+	}
+	return p.rand
+}
+
+// curRand returns the random source of the running game
+func curRand() *rand.Rand {
+	if g, ok := engine.GetGame().(*Game); ok && g != nil {
+		return g.random()
+	}
+	return defaultRand
+}
+
+// -------------------------------------------------------------------------------------
+// Random Number Utilities
+
+func Rand__0(from, to int) float64 {
+	if to < from {
+		to = from
+	}
+	return float64(from + curRand().Intn(to-from+1))
+}
+
+func Rand__1(from, to float64) float64 {
+	if to < from {
+		to = from
+	}
+	return curRand().Float64()*(to-from) + from
+}
+
+// RandChoice returns a random item of list, or an empty Value if the list is
+// empty or nil.
+func RandChoice(list *List) Value {
+	if list == nil {
+		return Value{}
+	}
+	return list.At(Random)
+}
+
+// RandWeighted picks a random index of weights, the chance of an index being
+// proportional to its weight. Non-positive weights are never picked. It returns
+// Invalid (-1) if no weight is positive.
+func RandWeighted(weights ...float64) int {
+	total := 0.0
+	for _, w := range weights {
+		if w > 0 {
+			total += w
+		}
+	}
+	if total <= 0 {
+		return Invalid
+	}
+	r := curRand().Float64() * total
+	last := Invalid
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		if r < w {
+			return i
+		}
+		r -= w
+		last = i
+	}
+	return last // rounding error
+}
+
+// Shuffle shuffles the items of list in place. A nil list is left as is.
+func Shuffle(list *List) {
+	if list == nil {
+		return
+	}
+	data := list.data
+	curRand().Shuffle(len(data), func(i, j int) {
+		data[i], data[j] = data[j], data[i]
+	})
+}
+
+// -------------------------------------------------------------------------------------
+// Noise Utilities
+//
+// Only Perlin noise is provided, there is no simplex noise.
+
+// Noise__0 returns the Perlin noise at x, a value in [-1, 1] which changes
+// smoothly with x. The noise depends on the random seed of the game.
+func Noise__0(x float64) float64 {
+	return curNoise().at(x, 0, 0)
+}
+
+// Noise__1 returns the Perlin noise at (x, y), see Noise__0.
+func Noise__1(x, y float64) float64 {
+	return curNoise().at(x, y, 0)
+}
+
+// Noise__2 returns the Perlin noise at (x, y, z), see Noise__0.
+func Noise__2(x, y, z float64) float64 {
+	return curNoise().at(x, y, z)
+}
+
+// noiseGen implements Ken Perlin's improved noise
+type noiseGen struct {
+	perm [512]uint8
+}
+
+var defaultNoise = newNoiseGen(time.Now().UnixNano())
+
+// curNoise returns the noise of the running game
+func curNoise() *noiseGen {
+	if g, ok := engine.GetGame().(*Game); ok && g != nil {
+		if g.noise == nil {
+			g.noise = newNoiseGen(g.RandomSeed())
+		}
+		return g.noise
+	}
+	return defaultNoise
+}
+
+// newNoiseGen builds the permutation table from its own source, so that using
+// noise doesn't change the random numbers the game gets.
+func newNoiseGen(seed int64) *noiseGen {
+	p := new(noiseGen)
+	for i := 0; i < 256; i++ {
+		p.perm[i] = uint8(i)
+	}
+	rand.New(rand.NewSource(seed)).Shuffle(256, func(i, j int) {
+		p.perm[i], p.perm[j] = p.perm[j], p.perm[i]
+	})
+	copy(p.perm[256:], p.perm[:256])
+	return p
+}
+
+func (p *noiseGen) at(x, y, z float64) float64 {
+	fx, fy, fz := math.Floor(x), math.Floor(y), math.Floor(z)
+	ix, iy, iz := int(fx)&255, int(fy)&255, int(fz)&255
+	x, y, z = x-fx, y-fy, z-fz
+	u, v, w := noiseFade(x), noiseFade(y), noiseFade(z)
+
+	perm := &p.perm
+	a := int(perm[ix]) + iy
+	aa, ab := int(perm[a])+iz, int(perm[a+1])+iz
+	b := int(perm[ix+1]) + iy
+	ba, bb := int(perm[b])+iz, int(perm[b+1])+iz
+
+	return noiseLerp(w,
+		noiseLerp(v,
+			noiseLerp(u, noiseGrad(perm[aa], x, y, z), noiseGrad(perm[ba], x-1, y, z)),
+			noiseLerp(u, noiseGrad(perm[ab], x, y-1, z), noiseGrad(perm[bb], x-1, y-1, z))),
+		noiseLerp(v,
+			noiseLerp(u, noiseGrad(perm[aa+1], x, y, z-1), noiseGrad(perm[ba+1], x-1, y, z-1)),
+			noiseLerp(u, noiseGrad(perm[ab+1], x, y-1, z-1), noiseGrad(perm[bb+1], x-1, y-1, z-1))))
+}
+
+func noiseFade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+func noiseLerp(t, a, b float64) float64 {
+	return a + t*(b-a)
+}
+
+func noiseGrad(hash uint8, x, y, z float64) float64 {
+	h := hash & 15
+	u, v := x, y
+	if h >= 8 {
+		u = y
+	}
+	if h >= 4 {
+		if h == 12 || h == 14 {
+			v = x
+		} else {
+			v = z
+		}
+	}
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+	return u + v
+}
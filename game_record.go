@@ -0,0 +1,252 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"io"
+
+	"github.com/goplus/spbase/mathf"
+	"github.com/goplus/spx/v2/internal/engine"
+	spxlog "github.com/goplus/spx/v2/internal/log"
+	"github.com/goplus/spx/v2/internal/record"
+)
+
+// -----------------------------------------------------------------------------
+// Recording and Replay
+//
+// A recorded run captures the random seed, the timing of every frame, the
+// input events fired by inputEventLoop and the state of the other inputs: the
+// right and middle mouse buttons, the wheel, the touches and the gamepads.
+// Frames are counted from the first engine update after the game is loaded,
+// which is the same frame in both the recorded and the replayed run.
+
+// gameRecorder writes the run to a .spxrec file
+type gameRecorder struct {
+	w       *record.Writer
+	frame   record.Frame
+	started bool
+}
+
+// onFrame is the frame time hook, it closes the previous frame and starts a
+// new one
+func (p *gameRecorder) onFrame(delta, unscaledDelta float64) (float64, float64) {
+	if p.started {
+		p.writeFrame()
+	}
+	p.started = true
+	p.frame.Delta, p.frame.UnscaledDelta = delta, unscaledDelta
+	p.frame.Events = p.frame.Events[:0]
+	return delta, unscaledDelta
+}
+
+func (p *gameRecorder) writeFrame() {
+	if err := p.w.WriteFrame(&p.frame); err != nil {
+		spxlog.Error("failed to write recording: %v", err)
+	}
+}
+
+func (p *gameRecorder) onMouse(pos mathf.Vec2, mouse *inputMouse) {
+	p.frame.MouseX, p.frame.MouseY = pos.X, pos.Y
+	p.frame.MouseButtons = mouse.buttons
+	p.frame.WheelX, p.frame.WheelY = mouse.wheel.X, mouse.wheel.Y
+}
+
+func (p *gameRecorder) onGamepads(pads []engine.Gamepad) {
+	p.frame.Gamepads = p.frame.Gamepads[:0]
+	for _, pad := range pads {
+		p.frame.Gamepads = append(p.frame.Gamepads, record.Gamepad(pad))
+	}
+}
+
+func (p *gameRecorder) onTouches(touches []engine.Touch) {
+	p.frame.Touches = p.frame.Touches[:0]
+	for _, t := range touches {
+		p.frame.Touches = append(p.frame.Touches, record.Touch{Id: t.Id, X: t.Pos.X, Y: t.Pos.Y})
+	}
+}
+
+func (p *gameRecorder) onEvent(ev event) {
+	var rev record.Event
+	switch e := ev.(type) {
+	case *eventKeyDown:
+		rev = record.Event{Kind: record.EventKeyDown, Key: int64(e.Key)}
+	case *eventKeyUp:
+		rev = record.Event{Kind: record.EventKeyUp, Key: int64(e.Key)}
+	case *eventLeftButtonDown:
+		rev = record.Event{Kind: record.EventMouseDown, X: e.Pos.X, Y: e.Pos.Y}
+	case *eventLeftButtonUp:
+		rev = record.Event{Kind: record.EventMouseUp, X: e.Pos.X, Y: e.Pos.Y}
+	case *eventMouseButtonDown, *eventMouseWheel, *eventTouchDown, *eventTouchMove, *eventTouchUp,
+		*eventGamepadButton, *eventGamepadConnected:
+		return // recorded as their state, see onMouse, onTouches and onGamepads
+	default:
+		return // not an input event, the replayed run fires it by itself
+	}
+	p.frame.Events = append(p.frame.Events, rev)
+}
+
+func (p *gameRecorder) close() {
+	if p.started {
+		p.writeFrame()
+	}
+	if err := p.w.Close(); err != nil {
+		spxlog.Error("failed to close recording: %v", err)
+	}
+}
+
+// gameReplayer feeds a .spxrec file back to the game. Once the recording is
+// exhausted, the game falls back to the live input.
+type gameReplayer struct {
+	r     *record.Reader
+	frame record.Frame
+	done  bool
+
+	keys         map[Key]bool
+	mousePressed bool
+}
+
+func (p *gameReplayer) active() bool {
+	return p != nil && !p.done
+}
+
+// onFrame is the frame time hook, it reads the next recorded frame
+func (p *gameReplayer) onFrame(delta, unscaledDelta float64) (float64, float64) {
+	if p.done {
+		return delta, unscaledDelta
+	}
+	if err := p.r.ReadFrame(&p.frame); err != nil {
+		if err != io.EOF {
+			spxlog.Error("failed to read recording: %v", err)
+		}
+		spxlog.Info("replay finished")
+		p.done = true
+		p.r.Close()
+		return delta, unscaledDelta
+	}
+	// the live key and mouse state changes between frames too, so apply
+	// the events before any script polls the state
+	for _, rev := range p.frame.Events {
+		switch rev.Kind {
+		case record.EventKeyDown:
+			p.keys[Key(rev.Key)] = true
+		case record.EventKeyUp:
+			delete(p.keys, Key(rev.Key))
+		case record.EventMouseDown:
+			p.mousePressed = true
+		case record.EventMouseUp:
+			p.mousePressed = false
+		}
+	}
+	return p.frame.Delta, p.frame.UnscaledDelta
+}
+
+func (p *gameReplayer) mousePos() mathf.Vec2 {
+	return mathf.NewVec2(p.frame.MouseX, p.frame.MouseY)
+}
+
+// mouseButtons returns the right and middle buttons pressed and the wheel
+// scrolled in the current frame
+func (p *gameReplayer) mouseButtons() (int64, mathf.Vec2) {
+	return p.frame.MouseButtons, mathf.NewVec2(p.frame.WheelX, p.frame.WheelY)
+}
+
+// events returns the input events of the current frame, once
+func (p *gameReplayer) events() []event {
+	evs := make([]event, 0, len(p.frame.Events))
+	for _, rev := range p.frame.Events {
+		pos := mathf.NewVec2(rev.X, rev.Y)
+		switch rev.Kind {
+		case record.EventKeyDown:
+			evs = append(evs, &eventKeyDown{Key: Key(rev.Key)})
+		case record.EventKeyUp:
+			evs = append(evs, &eventKeyUp{Key: Key(rev.Key)})
+		case record.EventMouseDown:
+			evs = append(evs, &eventLeftButtonDown{Pos: pos})
+		case record.EventMouseUp:
+			evs = append(evs, &eventLeftButtonUp{Pos: pos})
+		}
+	}
+	p.frame.Events = p.frame.Events[:0]
+	return evs
+}
+
+// applyKeys reports the keys pressed and released in the current frame to keys
+func (p *gameReplayer) applyKeys(keys *inputKeys) {
+	for _, rev := range p.frame.Events {
+		switch rev.Kind {
+		case record.EventKeyDown:
+			keys.onKey(Key(rev.Key), true)
+		case record.EventKeyUp:
+			keys.onKey(Key(rev.Key), false)
+		}
+	}
+}
+
+// gamepads appends the gamepads of the current frame to lst
+func (p *gameReplayer) gamepads(lst []engine.Gamepad) []engine.Gamepad {
+	for _, pad := range p.frame.Gamepads {
+		lst = append(lst, engine.Gamepad(pad))
+	}
+	return lst
+}
+
+// touches appends the fingers touching in the current frame to lst
+func (p *gameReplayer) touches(lst []engine.Touch) []engine.Touch {
+	for _, t := range p.frame.Touches {
+		lst = append(lst, engine.Touch{Id: t.Id, Pos: mathf.NewVec2(t.X, t.Y)})
+	}
+	return lst
+}
+
+func (p *gameReplayer) keyPressed(key Key) bool {
+	if key == KeyAny {
+		return len(p.keys) > 0
+	}
+	return p.keys[key]
+}
+
+// setupRecord starts recording or replaying the run as configured. Replaying
+// takes precedence when both are set.
+func (p *Game) setupRecord(conf *Config) error {
+	switch {
+	case conf.Replay != "":
+		r, err := record.Open(conf.Replay)
+		if err != nil {
+			return err
+		}
+		p.SetRandomSeed(r.Seed())
+		p.replayer = &gameReplayer{r: r, keys: make(map[Key]bool)}
+		engine.SetFrameTimeHook(p.replayer.onFrame)
+	case conf.Record != "":
+		w, err := record.Create(conf.Record, p.RandomSeed())
+		if err != nil {
+			return err
+		}
+		p.recorder = &gameRecorder{w: w}
+		engine.SetFrameTimeHook(p.recorder.onFrame)
+	}
+	return nil
+}
+
+// stopRecord flushes the recording, if any
+func (p *Game) stopRecord() {
+	if p.recorder != nil {
+		engine.SetFrameTimeHook(nil)
+		p.recorder.close()
+		p.recorder = nil
+	}
+}
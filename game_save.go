@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"unsafe"
+
+	"github.com/goplus/spx/v2/internal/engine"
+	spxlog "github.com/goplus/spx/v2/internal/log"
+	"github.com/goplus/spx/v2/internal/storage"
+)
+
+// -------------------------------------------------------------------------------------
+// Save Data
+//
+// Save data is kept in named slots, in the persistent data directory on
+// desktop, in the localStorage on web and in memory on the headless engine.
+// A slot is a versioned JSON document mapping keys to values. The game uses the
+// slot "default" until SetSaveSlot selects another one.
+
+const (
+	defaultSaveSlot = "default"
+	saveDataVersion = 1
+)
+
+type saveSlotData struct {
+	Version int                        `json:"version"`
+	Data    map[string]json.RawMessage `json:"data"`
+}
+
+type gameSaveMgr struct {
+	store storage.Store
+	slot  string
+	cache map[string]*saveSlotData
+}
+
+func (p *Game) saveMgr() *gameSaveMgr {
+	mgr := &p.saves
+	if mgr.store == nil {
+		mgr.store = storage.Open(platformMgr.GetPersistantDataDir())
+		mgr.cache = make(map[string]*saveSlotData)
+		if mgr.slot == "" {
+			mgr.slot = defaultSaveSlot
+		}
+	}
+	return mgr
+}
+
+// load returns the data of slot, read from the store on the first access
+func (p *gameSaveMgr) load(slot string) *saveSlotData {
+	if data, ok := p.cache[slot]; ok {
+		return data
+	}
+	data := &saveSlotData{Version: saveDataVersion, Data: make(map[string]json.RawMessage)}
+	if b, err := p.store.Read(slot); err != nil {
+		spxlog.Error("failed to read save slot %s: %v", slot, err)
+	} else if b != nil {
+		var saved saveSlotData
+		if err = json.Unmarshal(b, &saved); err != nil {
+			spxlog.Error("failed to read save slot %s: %v", slot, err)
+		} else if saved.Version > saveDataVersion {
+			spxlog.Error("failed to read save slot %s: unsupported version %d", slot, saved.Version)
+		} else if saved.Data != nil {
+			data.Data = saved.Data
+		}
+	}
+	p.cache[slot] = data
+	return data
+}
+
+func (p *gameSaveMgr) flush(slot string) {
+	b, err := json.Marshal(p.cache[slot])
+	if err == nil {
+		err = p.store.Write(slot, b)
+	}
+	if err != nil {
+		spxlog.Error("failed to write save slot %s: %v", slot, err)
+	}
+}
+
+func checkSaveSlot(slot string) {
+	if !storage.ValidSlot(slot) || slot == inputMapSlot {
+		engine.Panic(fmt.Sprintf("invalid save slot name %q: use letters, digits, '_', '-' or '.'", slot))
+	}
+}
+
+// SetSaveSlot selects the slot the save data is read from and written to.
+// Slot names are made of letters, digits, '_', '-' and '.'.
+func (p *Game) SetSaveSlot(slot string) {
+	checkSaveSlot(slot)
+	p.saveMgr().slot = slot
+}
+
+// SaveSlot returns the name of the current save slot.
+func (p *Game) SaveSlot() string {
+	return p.saveMgr().slot
+}
+
+// SaveSlots returns the names of the existing save slots.
+func (p *Game) SaveSlots() []string {
+	slots, err := p.saveMgr().store.Slots()
+	if err != nil {
+		spxlog.Error("failed to list save slots: %v", err)
+	}
+	return slices.DeleteFunc(slots, func(slot string) bool {
+		return slot == inputMapSlot // input bindings, not save data
+	})
+}
+
+// DeleteSaveSlot deletes slot with all its data.
+func (p *Game) DeleteSaveSlot(slot string) {
+	checkSaveSlot(slot)
+	mgr := p.saveMgr()
+	delete(mgr.cache, slot)
+	if err := mgr.store.Delete(slot); err != nil {
+		spxlog.Error("failed to delete save slot %s: %v", slot, err)
+	}
+}
+
+// SaveData saves value as key in the current slot. The value is stored as
+// JSON, so only its exported fields are saved.
+func (p *Game) SaveData(key string, value any) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		spxlog.Error("SaveData %s: %v", key, err)
+		return
+	}
+	mgr := p.saveMgr()
+	mgr.load(mgr.slot).Data[key] = b
+	mgr.flush(mgr.slot)
+}
+
+// LoadData loads key of the current slot into out, which must be a pointer.
+// It returns false if there is no such key or the saved value doesn't fit out.
+func (p *Game) LoadData(key string, out any) bool {
+	mgr := p.saveMgr()
+	b, ok := mgr.load(mgr.slot).Data[key]
+	if !ok {
+		return false
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		spxlog.Error("LoadData %s: %v", key, err)
+		return false
+	}
+	return true
+}
+
+// HasData reports whether key exists in the current slot.
+func (p *Game) HasData(key string) bool {
+	mgr := p.saveMgr()
+	_, ok := mgr.load(mgr.slot).Data[key]
+	return ok
+}
+
+// DeleteData deletes key from the current slot.
+func (p *Game) DeleteData(key string) {
+	mgr := p.saveMgr()
+	data := mgr.load(mgr.slot)
+	if _, ok := data.Data[key]; ok {
+		delete(data.Data, key)
+		mgr.flush(mgr.slot)
+	}
+}
+
+// -------------------------------------------------------------------------------------
+// Variables Snapshot
+
+type varsSnapshot struct {
+	Game    map[string]json.RawMessage            `json:"game,omitempty"`
+	Sprites map[string]map[string]json.RawMessage `json:"sprites,omitempty"`
+}
+
+// SaveVars saves the variables of the game and of its sprites as key in the
+// current slot. Variables are the exported fields of the game and sprite
+// classes, except the sprites and widgets bound by spx. Sounds, costumes and
+// backdrops are referred to by name, so their names are saved as any string.
+// Clones are not saved.
+func (p *Game) SaveVars(key string) {
+	snap := varsSnapshot{Sprites: make(map[string]map[string]json.RawMessage)}
+	if p.gamer_ != nil {
+		snap.Game = saveVarsOf(reflect.ValueOf(p.gamer_).Elem(), false)
+	}
+	for name, spr := range p.sprs {
+		if vars := saveVarsOf(reflect.ValueOf(spr).Elem(), false); len(vars) > 0 {
+			snap.Sprites[name] = vars
+		}
+	}
+	p.SaveData(key, &snap)
+}
+
+// LoadVars restores the variables saved by SaveVars as key in the current
+// slot. Variables which no longer exist are ignored. It returns false if there
+// is no such key.
+func (p *Game) LoadVars(key string) bool {
+	var snap varsSnapshot
+	if !p.LoadData(key, &snap) {
+		return false
+	}
+	if p.gamer_ != nil {
+		loadVarsOf(reflect.ValueOf(p.gamer_).Elem(), snap.Game, false)
+	}
+	for name, vars := range snap.Sprites {
+		if spr, ok := p.sprs[name]; ok {
+			loadVarsOf(reflect.ValueOf(spr).Elem(), vars, false)
+		}
+	}
+	return true
+}
+
+// isVarField reports whether fld is a variable declared by the user. With
+// unexported, the unexported variables are included.
+func isVarField(fld reflect.StructField, unexported bool) bool {
+	if fld.Anonymous || (!unexported && !fld.IsExported()) {
+		return false
+	}
+	typ := fld.Type
+	for typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Pointer {
+		if isBoundType(typ) {
+			return false
+		}
+		typ = typ.Elem()
+	}
+	switch typ.Kind() {
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return false
+	}
+	return !isBoundType(typ) && !isBoundType(reflect.PointerTo(typ))
+}
+
+// isBoundType reports whether typ is a sprite or a widget, bound by spx
+func isBoundType(typ reflect.Type) bool {
+	return typ.Implements(tySprite) || typ.Implements(tyWidget)
+}
+
+// varOf returns the i-th field of v, accessible even if unexported
+func varOf(v reflect.Value, i int) reflect.Value {
+	fld := v.Field(i)
+	return reflect.NewAt(fld.Type(), unsafe.Pointer(fld.UnsafeAddr())).Elem()
+}
+
+func saveVarsOf(v reflect.Value, unexported bool) map[string]json.RawMessage {
+	vars := make(map[string]json.RawMessage)
+	t := v.Type()
+	for i, n := 0, t.NumField(); i < n; i++ {
+		fld := t.Field(i)
+		if !isVarField(fld, unexported) {
+			continue
+		}
+		b, err := json.Marshal(varOf(v, i).Interface())
+		if err != nil {
+			spxlog.Warn("skip variable %s.%s: %v", t.Name(), fld.Name, err)
+			continue
+		}
+		vars[fld.Name] = b
+	}
+	return vars
+}
+
+func loadVarsOf(v reflect.Value, vars map[string]json.RawMessage, unexported bool) {
+	t := v.Type()
+	for name, b := range vars {
+		fld, ok := t.FieldByName(name)
+		if !ok || len(fld.Index) != 1 || !isVarField(fld, unexported) {
+			continue
+		}
+		val := reflect.New(fld.Type)
+		if err := json.Unmarshal(b, val.Interface()); err != nil {
+			spxlog.Warn("skip variable %s.%s: %v", t.Name(), name, err)
+			continue
+		}
+		varOf(v, fld.Index[0]).Set(val.Elem())
+	}
+}
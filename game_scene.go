@@ -0,0 +1,327 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"context"
+	"path"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/goplus/spx/v2/internal/engine"
+	spxlog "github.com/goplus/spx/v2/internal/log"
+)
+
+// -------------------------------------------------------------------------------------
+// Scenes
+//
+// A scene is an index file: the scene "index" is index.json, the scene the game
+// starts with, and any other scene name is scenes/<name>.json. Loading a scene
+// destroys the sprites of the current one, clones included, except the
+// persistent sprites (see DontDestroyOnLoad), then sets up the stage and the
+// sprites listed in the zorder of the new index file as the game does at
+// startup. The sprites of the new scene start afresh: their variables are reset
+// and their OnStart events fire. The game keeps its variables and scripts.
+
+const mainScene = "index"
+
+type gameSceneMgr struct {
+	name      string    // the current scene, mainScene if empty
+	index     string    // the index file of mainScene, index.json if empty
+	bgm       SoundName // the background music of the current scene
+	switching bool
+
+	mutex    sync.Mutex
+	preloads map[string]*scenePreload
+}
+
+// scenePreload is the index file of a scene, loaded in the background along
+// with the images it uses
+type scenePreload struct {
+	done chan struct{}
+	proj projConfig
+	err  error
+}
+
+func scenePath(name string) string {
+	if name == mainScene {
+		return "index.json"
+	}
+	return "scenes/" + name + ".json"
+}
+
+// indexFile returns the index file the game was loaded from
+func (p *gameSceneMgr) indexFile() string {
+	if p.index == "" {
+		return "index.json"
+	}
+	return p.index
+}
+
+// SceneName returns the name of the current scene.
+func (p *Game) SceneName() string {
+	if p.scenes.name == "" {
+		return mainScene
+	}
+	return p.scenes.name
+}
+
+// PreloadScene starts loading the scene name in the background, so that a
+// later LoadScene of it doesn't stall the game.
+func (p *Game) PreloadScene(name string) {
+	p.preloadScene(name)
+}
+
+func (p *Game) preloadScene(name string) *scenePreload {
+	mgr := &p.scenes
+	mgr.mutex.Lock()
+	defer mgr.mutex.Unlock()
+	if pl, ok := mgr.preloads[name]; ok {
+		return pl
+	}
+	if mgr.preloads == nil {
+		mgr.preloads = make(map[string]*scenePreload)
+	}
+	pl := &scenePreload{done: make(chan struct{})}
+	mgr.preloads[name] = pl
+	go func() {
+		defer close(pl.done)
+		if pl.err = loadProjConfig(&pl.proj, p.fs, scenePath(name)); pl.err == nil {
+			p.preloadSceneImages(&pl.proj)
+		}
+	}()
+	return pl
+}
+
+// preloadSceneImages loads the backdrops of proj and the costumes of the
+// sprites in its zorder into the image size cache
+func (p *Game) preloadSceneImages(proj *projConfig) {
+	for _, c := range proj.getBackdrops() {
+		resolveImageSize(c.ImageWidth, c.ImageHeight, c.Path)
+	}
+	for _, v := range proj.Zorder {
+		name, ok := v.(string)
+		if !ok {
+			continue
+		}
+		baseDir := "sprites/" + name + "/"
+		var conf spriteConfig
+		if err := loadJson(&conf, p.fs, baseDir+"index.json"); err != nil {
+			continue // reported when the sprite is loaded
+		}
+		for _, c := range conf.Costumes {
+			resolveImageSize(c.ImageWidth, c.ImageHeight, path.Join(baseDir, c.Path))
+		}
+		if cs := conf.CostumeSet; cs != nil {
+			resolveImageSize(cs.ImageWidth, cs.ImageHeight, path.Join(baseDir, cs.Path))
+		}
+		if cs := conf.CostumeMPSet; cs != nil {
+			getImageSizeCached(path.Join(baseDir, cs.Path))
+		}
+	}
+}
+
+// LoadScene switches to the scene name. The switch runs in the background, see
+// LoadSceneAndWait to wait for it.
+func (p *Game) LoadScene(name string) {
+	p.loadScene(name)
+}
+
+// LoadSceneAndWait switches to the scene name and waits until it is loaded.
+func (p *Game) LoadSceneAndWait(name string) {
+	done := p.loadScene(name)
+	engine.WaitToDo(func() {
+		<-done
+	})
+}
+
+// loadScene runs the switch in a script of the game, as the sprite calling it
+// may be destroyed by the switch
+func (p *Game) loadScene(name string) chan struct{} {
+	done := make(chan struct{})
+	engine.Go(p, func(context.Context) {
+		defer close(done)
+		for p.scenes.switching {
+			engine.WaitNextFrame()
+		}
+		p.scenes.switching = true
+		defer func() {
+			p.scenes.switching = false
+		}()
+		p.switchScene(name)
+	})
+	return done
+}
+
+func (p *Game) switchScene(name string) {
+	pl := p.preloadScene(name)
+	engine.WaitToDo(func() {
+		<-pl.done
+	})
+	p.scenes.mutex.Lock()
+	delete(p.scenes.preloads, name)
+	p.scenes.mutex.Unlock()
+	if pl.err != nil {
+		spxlog.Error("failed to load scene %s: %v", name, pl.err)
+		return
+	}
+	proj := &pl.proj
+	if debugLoad {
+		spxlog.Debug("==> LoadScene: %s", name)
+	}
+
+	g := reflect.ValueOf(p.gamer_).Elem()
+	p.sinkMgr.doWhenSceneUnloading(p.SceneName())
+	kept := p.unloadScene(g)
+	p.scenes.name = name
+
+	p.setupSceneStage(proj)
+	inits := p.loadSceneSprites(g, proj, kept)
+	for _, ini := range inits {
+		spr := spriteOf(ini)
+		var owner threadObj = p
+		if spr != nil {
+			spr.onAwake(func() {
+				spr.awake()
+			})
+			owner = spr
+		}
+		runMain(owner, ini.Main)
+	}
+	colliders := slices.Clone(inits)
+	for _, sp := range kept {
+		colliders = append(colliders, sp.sprite)
+	}
+	p.setupCollisionLayers(colliders)
+	p.setupJoints(proj)
+
+	loaded := make(map[threadObj]bool, len(inits))
+	for _, ini := range inits {
+		if spr := spriteOf(ini); spr != nil {
+			spr.doWhenAwake(spr)
+			loaded[spr] = true
+		}
+	}
+	p.sinkMgr.doWhenSceneStart(loaded)
+	p.sinkMgr.doWhenSceneLoaded(name)
+}
+
+// unloadScene destroys the shapes of the current scene, except the persistent
+// sprites. It returns the persistent prototype sprites by name.
+func (p *Game) unloadScene(g reflect.Value) map[string]*SpriteImpl {
+	kept := make(map[string]*SpriteImpl)
+	for _, item := range p.spriteMgr.all() { // removeShape doesn't change the slice
+		switch v := item.(type) {
+		case *SpriteImpl:
+			if v.persistent {
+				if !v.isCloned_ {
+					kept[v.name] = v
+				}
+				continue
+			}
+			v.Destroy()
+		case *Monitor:
+			p.removeShape(v)
+			engine.WaitMainThread(func() {
+				v.panel.Destroy()
+			})
+		case *measure:
+			p.removeShape(v)
+			engine.WaitMainThread(func() {
+				v.panel.Destroy()
+			})
+		}
+	}
+	// release the engine sprites now, as the prototypes get new ones below
+	engine.WaitMainThread(p.spriteMgr.flushDestroy)
+
+	// reload the destroyed prototypes, as the game does at startup
+	for name, spr := range p.sprs {
+		if sp := spriteOf(spr); sp != nil && sp.HasDestroyed {
+			if err := p.loadSprite(spr, name, g); err != nil {
+				engine.Panic(err)
+			}
+		}
+	}
+	return kept
+}
+
+// setupSceneStage applies the map, backdrops, camera and music of proj. A scene
+// without backdrops keeps the current ones.
+func (p *Game) setupSceneStage(proj *projConfig) {
+	changed := false
+	if proj.Map.Width > 0 && proj.Map.Height > 0 {
+		p.worldWidth_, p.worldHeight_ = proj.Map.Width, proj.Map.Height
+		p.minWorldX_, p.minWorldY_ = -p.worldWidth_/2, -p.worldHeight_/2
+		p.mapMode = toMapMode(proj.Map.Mode)
+		changed = true
+	}
+	if backdrops := proj.getBackdrops(); len(backdrops) > 0 {
+		p.baseObj.initBackdrops("", backdrops, proj.getBackdropIndex())
+		changed = true
+	}
+	if changed {
+		p.setupBackdrop()
+	}
+
+	if proj.Camera != nil && proj.Camera.On != "" {
+		p.Camera.Follow__1(proj.Camera.On)
+	}
+	if proj.Bgm != p.scenes.bgm {
+		if p.scenes.bgm != "" {
+			p.StopPlaying(p.scenes.bgm)
+		}
+		if proj.Bgm != "" {
+			p.Play__0(proj.Bgm, true)
+		}
+		p.scenes.bgm = proj.Bgm
+	}
+}
+
+// loadSceneSprites adds the shapes in the zorder of proj. Persistent sprites
+// listed in it are brought to their layer.
+func (p *Game) loadSceneSprites(g reflect.Value, proj *projConfig, kept map[string]*SpriteImpl) []Sprite {
+	inits := make([]Sprite, 0, len(proj.Zorder))
+	for layer, v := range proj.Zorder {
+		if name, ok := v.(string); ok {
+			if sp, ok := kept[name]; ok {
+				p.activateShape(sp)
+				continue
+			}
+			sp := p.getSpriteProtoByName(name, g)
+			spr := spriteOf(sp)
+			spr.setLayer(layer)
+			p.addShape(spr)
+			inits = append(inits, sp)
+		} else {
+			inits = p.addSpecialShape(g, v.(specsp), inits)
+		}
+	}
+	return inits
+}
+
+// -------------------------------------------------------------------------------------
+
+// DontDestroyOnLoad keeps the sprite when another scene is loaded, with its
+// state and running scripts. Clones of the sprite are not kept.
+func (p *SpriteImpl) DontDestroyOnLoad() {
+	p.persistent = true
+	if p.syncSprite != nil {
+		engine.WaitMainThread(p.syncSprite.SetDontDestroyOnLoad)
+	}
+}
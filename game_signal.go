@@ -0,0 +1,239 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"sync/atomic"
+
+	"github.com/goplus/spx/v2/internal/engine"
+	spxlog "github.com/goplus/spx/v2/internal/log"
+)
+
+// -------------------------------------------------------------------------------------
+// Signals
+//
+// A signal is a typed message: Emit sends a value of type T to the handlers
+// registered by On. Unlike Broadcast, the compiler checks the values sent
+// match the ones received, and as signals are variables rather than strings a
+// misspelt one doesn't compile. The handlers run as coroutines of the sprite
+// registering them, and go away with it when a clone is destroyed.
+
+// Signal sends values of type T to its handlers.
+type Signal[T any] struct {
+	sig *Signal_
+}
+
+// NewSignal returns a new signal sending values of type T.
+func NewSignal[T any]() *Signal[T] {
+	return &Signal[T]{sig: NewSignal_(reflect.TypeOf((*T)(nil)).Elem().String())}
+}
+
+// String returns the name of the signal in MessageStats and the debug logs.
+func (s *Signal[T]) String() string {
+	return s.sig.String()
+}
+
+// On calls onEmit with the value sent, in a new coroutine, each time the
+// signal is emitted. The handler belongs to the sprite whose script calls On,
+// or to the game outside of any sprite script.
+func (s *Signal[T]) On(onEmit func(v T)) {
+	s.sig.On(func(v any) {
+		t, _ := v.(T) // a nil interface value
+		onEmit(t)
+	})
+}
+
+// Emit sends v to the handlers of the signal, without waiting for them.
+func (s *Signal[T]) Emit(v T) {
+	s.sig.Emit(v)
+}
+
+// EmitAndWait sends v to the handlers of the signal, and waits until all of
+// them return.
+func (s *Signal[T]) EmitAndWait(v T) {
+	s.sig.EmitAndWait(v)
+}
+
+// Signal_ is the untyped signal a Signal is made of. Instead of being used
+// directly, it is meant to build Signal only: the interpreters can't export
+// generic types, they declare Signal on top of Signal_ in a package patch, see
+// cmd/igox/launcher and cmd/gox/pkg/gengo.
+type Signal_ struct {
+	name string
+}
+
+var signalCount atomic.Int64
+
+// NewSignal_ returns a new untyped signal, typ names the type of its values.
+func NewSignal_(typ string) *Signal_ {
+	id := signalCount.Add(1)
+	return &Signal_{name: fmt.Sprintf("Signal[%s]#%d", typ, id)}
+}
+
+// String returns the name of the signal in MessageStats and the debug logs.
+func (s *Signal_) String() string {
+	return s.name
+}
+
+// On calls onEmit with the value sent, in a new coroutine, each time the
+// signal is emitted.
+func (s *Signal_) On(onEmit func(v any)) {
+	g := signalGame()
+	g.sinkMgr.allWhenSignal = append(g.sinkMgr.allWhenSignal, eventSink{
+		pthis: g.scriptOwner(),
+		sink:  onEmit,
+		cond: func(data any) bool {
+			return data == s
+		},
+		name: s.name,
+	})
+}
+
+// Emit sends v to the handlers of the signal, without waiting for them.
+func (s *Signal_) Emit(v any) {
+	s.emit(v, false)
+}
+
+// EmitAndWait sends v to the handlers of the signal, and waits until all of
+// them return.
+func (s *Signal_) EmitAndWait(v any) {
+	s.emit(v, true)
+}
+
+func (s *Signal_) emit(v any, wait bool) {
+	g := signalGame()
+	if debugInstr {
+		spxlog.Debug("Emit: signal=%s, wait=%v", s.name, wait)
+	}
+	mgr := &g.sinkMgr
+	mgr.countSent(messageKey{name: s.name, signal: true}, mgr.allWhenSignal, s, g.debug)
+	call(mgr.allWhenSignal, wait, s, func(ev *eventSink) {
+		if debugEvent {
+			spxlog.Debug("==> onSignal: %s, %s", s.name, nameOf(ev.pthis))
+		}
+		ev.sink.(func(any))(v)
+	})
+}
+
+// signalGame returns the running game, signals only work while it runs
+func signalGame() *Game {
+	g, ok := engine.GetGame().(*Game)
+	if !ok || g == nil {
+		engine.Panic("signals are used before the game starts")
+	}
+	return g
+}
+
+// scriptOwner returns the sprite, or the game, whose script is running
+func (p *Game) scriptOwner() threadObj {
+	if mainOwner != nil {
+		return mainOwner
+	}
+	if owner := engine.GetCoroutineOwner(); owner != nil {
+		return owner
+	}
+	return p
+}
+
+// -------------------------------------------------------------------------------------
+// Message Statistics
+
+// messageKey identifies a message, or a signal, sent
+type messageKey struct {
+	name   string
+	signal bool
+}
+
+// countSent counts a message or signal sent to sinks. In debug mode, it also
+// warns the first time nobody receives it, which is often a misspelt name.
+func (p *eventSinkMgr) countSent(key messageKey, sinks []eventSink, data any, debug bool) {
+	if p.sent == nil {
+		p.sent = make(map[messageKey]int)
+	}
+	p.sent[key]++
+	if !debug || p.unheard[key] {
+		return
+	}
+	if slices.ContainsFunc(sinks, func(ev eventSink) bool { return ev.cond == nil || ev.cond(data) }) {
+		return
+	}
+	if p.unheard == nil {
+		p.unheard = make(map[messageKey]bool)
+	}
+	p.unheard[key] = true
+	if key.signal {
+		spxlog.Warn("%s is emitted with no handler", key.name)
+	} else {
+		spxlog.Warn("message %q is broadcast with no receiver", key.name)
+	}
+}
+
+// MessageStat tells how a message, or a signal, is used.
+type MessageStat struct {
+	Name      string // the message, or the signal as named by Signal.String
+	Signal    bool
+	Listeners int // the handlers receiving it, OnMsg__0 ones included
+	Sent      int // the times it was broadcast or emitted
+}
+
+// MessageStats returns the messages and signals listened to or sent, the
+// messages first, sorted by name. A message sent with no listeners, or
+// listened to but never sent, is likely to be misspelt somewhere.
+func (p *Game) MessageStats() []MessageStat {
+	mgr := &p.sinkMgr
+	stats := make(map[messageKey]*MessageStat)
+	stat := func(key messageKey) *MessageStat {
+		st, ok := stats[key]
+		if !ok {
+			st = &MessageStat{Name: key.name, Signal: key.signal}
+			stats[key] = st
+		}
+		return st
+	}
+	anyMsg := 0
+	for _, ev := range mgr.allWhenIReceive {
+		if ev.cond == nil {
+			anyMsg++
+		} else {
+			stat(messageKey{name: ev.name}).Listeners++
+		}
+	}
+	for _, ev := range mgr.allWhenSignal {
+		stat(messageKey{name: ev.name, signal: true}).Listeners++
+	}
+	for key, n := range mgr.sent {
+		stat(key).Sent = n
+	}
+	ret := make([]MessageStat, 0, len(stats))
+	for _, st := range stats {
+		if !st.Signal {
+			st.Listeners += anyMsg
+		}
+		ret = append(ret, *st)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Signal != ret[j].Signal {
+			return !ret[i].Signal
+		}
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
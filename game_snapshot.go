@@ -0,0 +1,240 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"encoding/json"
+	"reflect"
+
+	spxlog "github.com/goplus/spx/v2/internal/log"
+	"github.com/goplus/spx/v2/internal/timer"
+)
+
+// -------------------------------------------------------------------------------------
+// Game Snapshot
+
+const gameSnapshotVersion = 1
+
+// GameSnapshot is the runtime state of a game: the backdrop, the timer, the
+// variables of the game and the state of every sprite, clones included. All
+// the variables are captured, exported or not. A snapshot can be saved with
+// SaveData, as it serializes to JSON.
+type GameSnapshot struct {
+	Version  int                        `json:"version"`
+	Backdrop int                        `json:"backdrop"`
+	Timer    float64                    `json:"timer"`
+	Vars     map[string]json.RawMessage `json:"vars,omitempty"`
+	Sprites  []*SpriteSnapshot          `json:"sprites"` // in layer order, from back to front
+}
+
+// SpriteSnapshot is the state of a sprite in a GameSnapshot.
+type SpriteSnapshot struct {
+	ID      int64                      `json:"id"`
+	Name    string                     `json:"name"`
+	Cloned  bool                       `json:"cloned,omitempty"`
+	X       float64                    `json:"x"`
+	Y       float64                    `json:"y"`
+	Heading float64                    `json:"heading"`
+	Size    float64                    `json:"size"`
+	Costume int                        `json:"costume"`
+	Visible bool                       `json:"visible"`
+	Vars    map[string]json.RawMessage `json:"vars,omitempty"`
+}
+
+// Snapshot captures the runtime state of the game. The physics velocities,
+// the graphic effects and the pen state of the sprites aren't captured, nor
+// the running scripts and sounds: Restore leaves them as they are.
+func (p *Game) Snapshot() *GameSnapshot {
+	snap := &GameSnapshot{
+		Version:  gameSnapshotVersion,
+		Backdrop: p.getCostumeIndex(),
+		Timer:    timer.Timer(),
+	}
+	if p.gamer_ != nil {
+		snap.Vars = saveVarsOf(reflect.ValueOf(p.gamer_).Elem(), true)
+	}
+	for _, item := range p.spriteMgr.all() {
+		if sp, ok := item.(*SpriteImpl); ok && !sp.isDying {
+			if sp.snapshotID == 0 {
+				p.lastSnapshotID++
+				sp.snapshotID = p.lastSnapshotID
+			}
+			snap.Sprites = append(snap.Sprites, sp.snapshot())
+		}
+	}
+	return snap
+}
+
+func (p *SpriteImpl) snapshot() *SpriteSnapshot {
+	return &SpriteSnapshot{
+		ID:      p.snapshotID,
+		Name:    p.name,
+		Cloned:  p.isCloned_,
+		X:       p.x,
+		Y:       p.y,
+		Heading: p.direction,
+		Size:    p.scale,
+		Costume: p.costumeIndex_,
+		Visible: p.isVisible,
+		Vars:    saveVarsOf(reflect.ValueOf(p.sprite).Elem(), true),
+	}
+}
+
+func (p *SpriteImpl) restore(snap *SpriteSnapshot) {
+	p.x, p.y = snap.X, snap.Y
+	p.direction = snap.Heading
+	if p.scale != snap.Size {
+		p.SetSize(snap.Size)
+	}
+	if snap.Costume >= 0 && snap.Costume < len(p.costumes) && snap.Costume != p.costumeIndex_ {
+		p.setCustumeIndex(snap.Costume)
+		p.defaultCostumeIndex = snap.Costume
+	}
+	if snap.Visible {
+		p.Show()
+	} else {
+		p.Hide()
+	}
+	p.updateTransform()
+	loadVarsOf(reflect.ValueOf(p.sprite).Elem(), snap.Vars, true)
+}
+
+// Restore brings the game back to the state captured by snap, without
+// reloading it. Clones missing from the game are created again, firing their
+// OnCloned events with nil data, and clones created after the snapshot are
+// destroyed. Running scripts are left untouched.
+func (p *Game) Restore(snap *GameSnapshot) {
+	if snap == nil {
+		return
+	}
+	if snap.Version > gameSnapshotVersion {
+		spxlog.Error("Restore: unsupported snapshot version %d", snap.Version)
+		return
+	}
+
+	if snap.Backdrop != p.getCostumeIndex() && p.goSetCostume(snap.Backdrop) {
+		p.setupBackdrop()
+		p.doWindowSize()
+	}
+	timer.SetTimer(snap.Timer)
+	if p.gamer_ != nil {
+		loadVarsOf(reflect.ValueOf(p.gamer_).Elem(), snap.Vars, true)
+	}
+
+	byID := make(map[int64]*SpriteImpl)
+	unmatched := make(map[*SpriteImpl]bool)
+	for _, item := range p.spriteMgr.all() {
+		if sp, ok := item.(*SpriteImpl); ok && !sp.isDying {
+			unmatched[sp] = true
+			if sp.snapshotID != 0 {
+				byID[sp.snapshotID] = sp
+			}
+		}
+	}
+
+	// restore the sprites, creating the missing clones
+	order := make([]*SpriteImpl, 0, len(snap.Sprites))
+	for _, ss := range snap.Sprites {
+		sp, ok := byID[ss.ID]
+		if ok && unmatched[sp] {
+			sp.restore(ss)
+		} else if !ss.Cloned {
+			// the game was reloaded since the snapshot: match prototypes by name
+			if sp = p.spriteMgr.findSprite(ss.Name); sp == nil || !unmatched[sp] {
+				spxlog.Warn("Restore: sprite not found - %s", ss.Name)
+				continue
+			}
+			sp.snapshotID = ss.ID
+			sp.restore(ss)
+		} else {
+			src := p.spriteMgr.findSprite(ss.Name)
+			if src == nil {
+				spxlog.Warn("Restore: can't clone destroyed sprite - %s", ss.Name)
+				continue
+			}
+			doClone(src.sprite, nil, false, func(dest *SpriteImpl) {
+				dest.snapshotID = ss.ID
+				dest.restore(ss)
+				sp = dest
+			})
+		}
+		delete(unmatched, sp)
+		order = append(order, sp)
+	}
+	if p.lastSnapshotID < maxSnapshotID(snap) {
+		p.lastSnapshotID = maxSnapshotID(snap)
+	}
+
+	// destroy the clones created after the snapshot, the current one last as
+	// destroying it aborts the running script
+	var self, cur *SpriteImpl
+	if th := gco.Current(); th != nil {
+		cur, _ = th.Obj.(*SpriteImpl)
+	}
+	for sp := range unmatched {
+		if !sp.isCloned_ {
+			continue
+		}
+		if sp == cur {
+			self = sp
+			continue
+		}
+		sp.Destroy()
+	}
+
+	p.restoreLayers(order)
+	if self != nil {
+		self.Destroy()
+	}
+}
+
+func maxSnapshotID(snap *GameSnapshot) (id int64) {
+	for _, ss := range snap.Sprites {
+		id = max(id, ss.ID)
+	}
+	return
+}
+
+// restoreLayers sorts the sprites in the order of the snapshot. Sprites not in
+// the snapshot go behind, and other shapes keep their place.
+func (p *Game) restoreLayers(order []*SpriteImpl) {
+	sm := p.spriteMgr
+	inOrder := make(map[*SpriteImpl]bool, len(order))
+	for _, sp := range order {
+		inOrder[sp] = true
+	}
+	sprites := make([]*SpriteImpl, 0, len(sm.items))
+	for _, item := range sm.items {
+		if sp, ok := item.(*SpriteImpl); ok && !inOrder[sp] {
+			sprites = append(sprites, sp)
+		}
+	}
+	sprites = append(sprites, order...)
+
+	items := make([]Shape, len(sm.items))
+	i := 0
+	for idx, item := range sm.items {
+		if _, ok := item.(*SpriteImpl); ok {
+			items[idx] = sprites[i]
+			i++
+		} else {
+			items[idx] = item
+		}
+	}
+	sm.items = items
+	sm.updateRenderLayers()
+}
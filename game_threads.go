@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"fmt"
+
+	"github.com/goplus/spx/v2/internal/coroutine"
+)
+
+// -------------------------------------------------------------------------------------
+// Threads
+//
+// Each script of the game runs in a thread: the event handlers, the Forever
+// loops, the timers... Threads lists them to tell which one hangs the game,
+// and Stop kills one. In debug mode, the debug panel lists them too, and they
+// are served as JSON, see serveDebug.
+
+// ThreadState tells what a thread is doing.
+type ThreadState int
+
+const (
+	ThreadRunning   = ThreadState(coroutine.ThreadRunning)
+	ThreadWaiting   = ThreadState(coroutine.ThreadWaiting)
+	ThreadSuspended = ThreadState(coroutine.ThreadSuspended)
+)
+
+func (s ThreadState) String() string {
+	switch s {
+	case ThreadRunning:
+		return "running"
+	case ThreadWaiting:
+		return "waiting"
+	case ThreadSuspended:
+		return "suspended"
+	}
+	return fmt.Sprintf("ThreadState(%d)", int(s))
+}
+
+func (s ThreadState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Thread describes a thread running a script, see Threads.
+type Thread struct {
+	ID         int64       `json:"id"`
+	Name       string      `json:"name"`
+	Owner      string      `json:"owner"` // name of the sprite running it, "" for the stage
+	Sprite     Sprite      `json:"-"`     // sprite running it, nil for the stage
+	State      ThreadState `json:"state"`
+	Frames     int64       `json:"frames"`               // number of frames it's alive
+	WaitReason string      `json:"waitReason,omitempty"` // what it waits for, "" if it doesn't
+
+	th coroutine.Thread
+}
+
+// Stop stops the thread. It stops now if it's the running thread, otherwise
+// the next time it resumes.
+func (t *Thread) Stop() {
+	gco.Stop(t.th)
+}
+
+func (t *Thread) String() string {
+	s := fmt.Sprintf("#%d %s %s %d frames", t.ID, t.Name, t.State, t.Frames)
+	if t.WaitReason != "" {
+		s += " (" + t.WaitReason + ")"
+	}
+	return s
+}
+
+// Threads returns the threads alive, by creation order.
+func Threads() []*Thread {
+	infos := gco.Threads()
+	threads := make([]*Thread, len(infos))
+	for i, info := range infos {
+		t := &Thread{
+			ID:         info.Th.ID(),
+			Name:       info.Th.Name(),
+			State:      ThreadState(info.State),
+			Frames:     info.Frames,
+			WaitReason: info.WaitReason,
+			th:         info.Th,
+		}
+		if spr, ok := info.Th.Obj.(*SpriteImpl); ok {
+			t.Owner, t.Sprite = spr.name, spr.sprite
+		}
+		threads[i] = t
+	}
+	return threads
+}
+
+// SetThreadName names the thread running the script, after its sprite by
+// default, to tell it in Threads.
+func (p *Game) SetThreadName(name string) {
+	if th := gco.Current(); th != nil {
+		th.SetName(name)
+	}
+}
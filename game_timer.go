@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"slices"
+
+	"github.com/goplus/spx/v2/internal/coroutine"
+	gtime "github.com/goplus/spx/v2/internal/time"
+)
+
+// -------------------------------------------------------------------------------------
+// Timers
+//
+// After and Every call a function later, once or repeatedly. Unlike OnTimer,
+// they count from the time they're started rather than from the start of the
+// game, and ResetTimer doesn't affect them. The timers of a sprite belong to
+// it: they're cancelled when its clone is destroyed. By default timers follow
+// the game time, slowed down or sped up by the time scale, unscaled ones
+// follow the real time.
+
+// TimerHandle is returned by After and Every to control the timer.
+type TimerHandle struct {
+	owner     threadObj
+	fn        func()
+	interval  float64
+	remaining float64 // seconds until the next call
+	repeat    bool
+	unscaled  bool
+	stopped   bool
+}
+
+// Cancel stops the timer. A call already started goes on.
+func (h *TimerHandle) Cancel() {
+	h.stopped = true
+}
+
+// Active reports whether the timer will call its function again.
+func (h *TimerHandle) Active() bool {
+	return !h.stopped
+}
+
+// SetUnscaled makes the timer follow the real time, whatever the time scale,
+// or the game time if unscaled is false.
+func (h *TimerHandle) SetUnscaled(unscaled bool) {
+	h.unscaled = unscaled
+}
+
+// update advances the timer, and calls its function if it's due
+func (h *TimerHandle) update(delta, unscaledDelta float64) {
+	if h.unscaled {
+		delta = unscaledDelta
+	}
+	if h.remaining -= delta; h.remaining > 0 {
+		return
+	}
+	if !h.repeat {
+		h.stopped = true
+	} else if h.interval > 0 {
+		for h.remaining <= 0 { // calls late by more than an interval are skipped
+			h.remaining += h.interval
+		}
+	}
+	gco.CreateAndStart(false, h.owner, func(coroutine.Thread) int {
+		h.fn()
+		return 0
+	})
+}
+
+func (p *eventSinkMgr) addTimer(h *TimerHandle) *TimerHandle {
+	h.remaining = h.interval
+	p.timers = append(p.timers, h)
+	return h
+}
+
+// updateTimers advances the timers by the duration of the frame
+func (p *eventSinkMgr) updateTimers() {
+	delta, unscaledDelta := gtime.DeltaTime(), gtime.UnscaledDeltaTime()
+	for _, h := range p.timers {
+		if !h.stopped && !pausedObj(h.owner) {
+			h.update(delta*objTimeScale(h.owner), unscaledDelta)
+		}
+	}
+	p.timers = slices.DeleteFunc(p.timers, func(h *TimerHandle) bool {
+		return h.stopped
+	})
+}
+
+func (p *eventSinkMgr) deleteTimers(this any) {
+	for _, h := range p.timers {
+		if h.owner == this {
+			h.stopped = true
+		}
+	}
+}
+
+// -------------------------------------------------------------------------------------
+
+// After calls fn once, secs seconds later.
+func (p *eventSinks) After(secs float64, fn func()) *TimerHandle {
+	return p.addTimer(&TimerHandle{owner: p.pthis, fn: fn, interval: secs})
+}
+
+// Every calls fn every secs seconds, from secs seconds later, until the timer
+// is cancelled. It's called in each frame if secs is 0.
+func (p *eventSinks) Every(secs float64, fn func()) *TimerHandle {
+	return p.addTimer(&TimerHandle{owner: p.pthis, fn: fn, interval: secs, repeat: true})
+}
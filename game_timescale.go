@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"github.com/goplus/spx/v2/internal/coroutine"
+	"github.com/goplus/spx/v2/internal/engine"
+	gtime "github.com/goplus/spx/v2/internal/time"
+)
+
+// -------------------------------------------------------------------------------------
+// Time scale
+//
+// The time scale of the game slows it down or speeds it up as a whole: the
+// waits, the glides, steps and turns, the timers, the animations and the
+// physics. A sprite has its own time scale on top of it, which applies to its
+// scripts, its timers and its animations. UnscaledWait waits in real time,
+// for the UI.
+
+func init() {
+	gco.SetTimeScaleFilter(func(th coroutine.Thread) float64 {
+		return objTimeScale(th.Obj)
+	})
+}
+
+// objTimeScale returns the time scale of obj, the owner of a script, on top of
+// the game one
+func objTimeScale(obj threadObj) float64 {
+	if o, ok := obj.(*SpriteImpl); ok {
+		return o.timeScale
+	}
+	return 1
+}
+
+// -------------------------------------------------------------------------------------
+
+// SetTimeScale slows the game down if scale < 1, or speeds it up if scale > 1.
+// A scale of 0 stops everything but the unscaled waits and timers, see
+// UnscaledWait.
+func (p *Game) SetTimeScale(scale float64) {
+	gtime.SetTimeScale(max(scale, 0))
+}
+
+// TimeScale returns the time scale of the game, 1 by default.
+func (p *Game) TimeScale() float64 {
+	return gtime.TimeScale()
+}
+
+// UnscaledWait waits for secs seconds in real time, whatever the time scale of
+// the game or of the sprite.
+func (p *Game) UnscaledWait(secs float64) {
+	engine.WaitUnscaled(secs)
+}
+
+// SetTimeScale slows the sprite down if scale < 1, or speeds it up if scale > 1,
+// on top of the game time scale: its waits, glides, steps and turns, its timers
+// and its animations. Its clones made afterwards are scaled too.
+func (p *SpriteImpl) SetTimeScale(scale float64) {
+	p.timeScale = max(scale, 0)
+	p.syncAnimSpeed()
+}
+
+// TimeScale returns the time scale of the sprite, 1 by default.
+func (p *SpriteImpl) TimeScale() float64 {
+	return p.timeScale
+}
@@ -0,0 +1,301 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"math"
+
+	"github.com/goplus/spbase/mathf"
+	"github.com/goplus/spx/v2/internal/engine"
+	gtime "github.com/goplus/spx/v2/internal/time"
+)
+
+// -------------------------------------------------------------------------------------
+// Touch Input
+//
+// Each finger touching the screen is tracked by the id the engine gives it,
+// from its touch down to its touch up, at the same position as the mouse
+// would be. On top of the fingers, gesture recognizers detect pinches,
+// two-finger rotations, long presses, double taps and drags, see
+// inputGestureRecognizer. Until a touch screen is used, the mouse acts as a
+// finger for the one-finger gestures.
+
+type eventTouchDown struct {
+	ID  int
+	Pos mathf.Vec2
+}
+
+type eventTouchMove struct {
+	ID  int
+	Pos mathf.Vec2
+}
+
+type eventTouchUp struct {
+	ID  int
+	Pos mathf.Vec2
+}
+
+// inputTouches tracks the fingers to fire their events
+type inputTouches struct {
+	cur   []engine.Touch // synced at the beginning of the frame
+	last  []engine.Touch // as of the last poll
+	mouse [1]engine.Touch
+	seen  bool // a touch screen is used, the mouse no longer acts as a finger
+}
+
+func findTouch(touches []engine.Touch, id int64) (engine.Touch, bool) {
+	for _, t := range touches {
+		if t.Id == id {
+			return t, true
+		}
+	}
+	return engine.Touch{}, false
+}
+
+// poll fires the fingers touching down, moving and touching up since the
+// last poll
+func (p *inputTouches) poll(g eventFirer) {
+	for _, t := range p.cur {
+		if old, ok := findTouch(p.last, t.Id); !ok {
+			g.fireEvent(&eventTouchDown{ID: int(t.Id), Pos: t.Pos})
+		} else if old.Pos != t.Pos {
+			g.fireEvent(&eventTouchMove{ID: int(t.Id), Pos: t.Pos})
+		}
+	}
+	for _, t := range p.last {
+		if _, ok := findTouch(p.cur, t.Id); !ok {
+			g.fireEvent(&eventTouchUp{ID: int(t.Id), Pos: t.Pos})
+		}
+	}
+	p.last = append(p.last[:0], p.cur...)
+	if len(p.cur) > 0 {
+		p.seen = true
+	}
+}
+
+// fingers returns the fingers the gestures are recognized from
+func (p *inputTouches) fingers(mousePressed bool, mousePos mathf.Vec2) []engine.Touch {
+	if p.seen || len(p.cur) > 0 {
+		return p.cur
+	}
+	if mousePressed {
+		p.mouse[0] = engine.Touch{Pos: mousePos}
+		return p.mouse[:]
+	}
+	return nil
+}
+
+// -------------------------------------------------------------------------------------
+// Gestures
+
+type eventPinch struct {
+	Scale float64
+}
+
+type eventRotateGesture struct {
+	Angle float64
+}
+
+type eventLongPress struct {
+	Target threadObj
+}
+
+type eventDoubleTap struct {
+	Target threadObj
+}
+
+type eventDrag struct {
+	Target threadObj
+	Delta  mathf.Vec2
+}
+
+// gestureEpsilon ignores the rounding errors of the finger positions
+const gestureEpsilon = 1e-6
+
+// inputGestureRecognizer recognizes the gestures made with one or two fingers
+type inputGestureRecognizer struct {
+	// Configuration parameters
+	longPressTime     float64 // Seconds a finger stays down to long press
+	doubleTapInterval float64 // Maximum seconds between the taps of a double tap
+	doubleTapDistance float64 // Maximum distance between the taps of a double tap
+	dragThreshold     float64 // Distance a finger moves to start dragging
+	pinchZoom         bool    // Whether pinches zoom the camera
+	minZoom           float64
+	maxZoom           float64
+
+	// One finger state
+	pressing    bool
+	finger      int64
+	pressTime   float64 // unscaled time since level load
+	pressPos    mathf.Vec2
+	lastPos     mathf.Vec2
+	target      threadObj // the sprite pressed, or the game
+	longPressed bool
+	dragging    bool
+	tapTime     float64
+	tapPos      mathf.Vec2
+	tapTarget   threadObj // the target of the first tap of a double tap, if any
+
+	// Two fingers state
+	multi     bool // two fingers touched, wait until all of them are lifted
+	pairing   bool
+	pair      [2]int64
+	lastDist  float64
+	lastAngle float64
+}
+
+func (p *inputGestureRecognizer) init() {
+	p.longPressTime = 0.5
+	p.doubleTapInterval = 0.3
+	p.doubleTapDistance = 20
+	p.dragThreshold = 10
+	p.pinchZoom = false
+	p.minZoom, p.maxZoom = 0.5, 4
+	p.pressing, p.multi, p.pairing = false, false, false
+	p.target, p.tapTarget = nil, nil
+}
+
+// update recognizes the gestures from the fingers touching in this frame
+func (p *inputGestureRecognizer) update(g *Game, fingers []engine.Touch) {
+	now := gtime.UnscaledTimeSinceLevelLoad()
+	switch len(fingers) {
+	case 0:
+		if p.pressing {
+			p.release(g, now)
+		}
+		p.multi, p.pairing = false, false
+	case 1:
+		p.pairing = false
+		if !p.multi {
+			p.press(g, fingers[0], now)
+		}
+	default:
+		p.pressing, p.tapTarget = false, nil
+		p.multi = true
+		p.twoFingers(g, fingers[0], fingers[1])
+	}
+}
+
+func (p *inputGestureRecognizer) press(g *Game, f engine.Touch, now float64) {
+	if !p.pressing || f.Id != p.finger {
+		p.pressing, p.finger = true, f.Id
+		p.pressTime, p.pressPos, p.lastPos = now, f.Pos, f.Pos
+		p.target = g.touchTarget(f.Pos)
+		p.longPressed, p.dragging = false, false
+		return
+	}
+	if !p.dragging && f.Pos.DistanceTo(p.pressPos) > p.dragThreshold {
+		p.dragging, p.lastPos = true, p.pressPos // the drag starts where pressed
+	}
+	if p.dragging {
+		if delta := f.Pos.Sub(p.lastPos); delta != (mathf.Vec2{}) {
+			g.fireEvent(&eventDrag{Target: p.target, Delta: delta})
+		}
+	} else if !p.longPressed && now-p.pressTime >= p.longPressTime {
+		p.longPressed = true
+		g.fireEvent(&eventLongPress{Target: p.target})
+	}
+	p.lastPos = f.Pos
+}
+
+func (p *inputGestureRecognizer) release(g *Game, now float64) {
+	p.pressing = false
+	if p.dragging || p.longPressed {
+		p.tapTarget = nil
+		return
+	}
+	if p.tapTarget != nil && p.tapTarget == p.target && now-p.tapTime <= p.doubleTapInterval &&
+		p.lastPos.DistanceTo(p.tapPos) <= p.doubleTapDistance {
+		p.tapTarget = nil
+		g.fireEvent(&eventDoubleTap{Target: p.target})
+		return
+	}
+	p.tapTarget, p.tapTime, p.tapPos = p.target, now, p.lastPos
+}
+
+func (p *inputGestureRecognizer) twoFingers(g *Game, a, b engine.Touch) {
+	d := b.Pos.Sub(a.Pos)
+	dist := d.Length()
+	angle := math.Atan2(d.Y, d.X) * 180 / math.Pi
+	if !p.pairing || p.pair != [2]int64{a.Id, b.Id} {
+		p.pairing, p.pair = true, [2]int64{a.Id, b.Id}
+		p.lastDist, p.lastAngle = dist, angle
+		return
+	}
+	if scale := dist / p.lastDist; p.lastDist > 0 && math.Abs(scale-1) > gestureEpsilon {
+		g.fireEvent(&eventPinch{Scale: scale})
+	}
+	// clockwise as turning a sprite, the stage y axis points up
+	if delta := normalizeDirection(p.lastAngle - angle); math.Abs(delta) > gestureEpsilon {
+		g.fireEvent(&eventRotateGesture{Angle: delta})
+	}
+	p.lastDist, p.lastAngle = dist, angle
+}
+
+// touchTarget returns the sprite at pos which can be clicked, or the game
+func (p *Game) touchTarget(pos mathf.Vec2) threadObj {
+	if target := p.clickerAt(pos); target != nil {
+		return target
+	}
+	return p
+}
+
+func (p *Game) doWhenPinch(ev *eventPinch) {
+	if g := &p.inputs.gestures; g.pinchZoom {
+		p.camera.SetZoom(mathf.Clamp(p.camera.Zoom()*ev.Scale, g.minZoom, g.maxZoom))
+	}
+	p.sinkMgr.doWhenPinch(ev.Scale)
+}
+
+// -------------------------------------------------------------------------------------
+
+// TouchCount returns the number of fingers touching the screen.
+func (p *Game) TouchCount() int {
+	return len(p.inputs.touches.last)
+}
+
+// SetSwipeConfig configures the swipes: they last at most timeToSwipe
+// seconds and go between minDistance and maxDistance.
+func (p *Game) SetSwipeConfig(timeToSwipe, minDistance, maxDistance float64) {
+	p.inputs.swipeRecognizer.setSwipeConfig(timeToSwipe, minDistance, maxDistance)
+}
+
+// SetLongPressConfig sets how many seconds a finger stays down without
+// dragging to long press, 0.5 by default.
+func (p *Game) SetLongPressConfig(duration float64) {
+	p.inputs.gestures.longPressTime = duration
+}
+
+// SetDoubleTapConfig sets the maximum seconds and distance between the taps
+// of a double tap, 0.3 and 20 by default.
+func (p *Game) SetDoubleTapConfig(interval, maxDistance float64) {
+	p.inputs.gestures.doubleTapInterval = interval
+	p.inputs.gestures.doubleTapDistance = maxDistance
+}
+
+// SetDragConfig sets the distance a finger moves to start dragging, 10 by
+// default. A finger moving less taps or long presses.
+func (p *Game) SetDragConfig(threshold float64) {
+	p.inputs.gestures.dragThreshold = threshold
+}
+
+// SetPinchZoom makes pinches zoom the camera between minZoom and maxZoom, or
+// stops it if enabled is false. OnPinch events fire either way.
+func (p *Game) SetPinchZoom(enabled bool, minZoom, maxZoom float64) {
+	g := &p.inputs.gestures
+	g.pinchZoom, g.minZoom, g.maxZoom = enabled, minZoom, maxZoom
+}
@@ -17,8 +17,6 @@
 package spx
 
 import (
-	"math/rand"
-
 	"github.com/goplus/spbase/mathf"
 	"github.com/goplus/spx/v2/internal/engine"
 )
@@ -105,7 +103,8 @@ func (p *Game) objectPos(obj any) (float64, float64) {
 	case Pos:
 		if v == Random {
 			worldW, worldH := p.worldSize_()
-			mx, my := rand.Intn(worldW), rand.Intn(worldH)
+			rnd := p.random()
+			mx, my := rnd.Intn(worldW), rnd.Intn(worldH)
 			return float64(mx - (worldW >> 1)), float64((worldH >> 1) - my)
 		}
 	case Sprite:
@@ -157,23 +156,6 @@ func (p *Game) getTempShapes() []Shape {
 	return p.spriteMgr.getTempShapes()
 }
 
-// -------------------------------------------------------------------------------------
-// Random Number Utilities
-
-func Rand__0(from, to int) float64 {
-	if to < from {
-		to = from
-	}
-	return float64(from + rand.Intn(to-from+1))
-}
-
-func Rand__1(from, to float64) float64 {
-	if to < from {
-		to = from
-	}
-	return rand.Float64()*(to-from) + from
-}
-
 // -------------------------------------------------------------------------------------
 // Math Utilities
 
@@ -45,17 +45,20 @@ var (
 )
 
 var (
-	cachedBounds_ map[string]mathf.Rect2
+	cachedBounds_   map[string]mathf.Rect2
+	cachedOutlines_ map[string][]float64
 )
 
 func (p *Game) OnEngineStart() {
 	cachedBounds_ = make(map[string]mathf.Rect2)
+	cachedOutlines_ = make(map[string][]float64)
 	onStart := func() {
 		defer engine.CheckPanic()
 		initInput()
 		gamer := p.gamer_
+		p.hotReload, pendingReload = pendingReload, nil
 		if me, ok := gamer.(interface{ MainEntry() }); ok {
-			runMain(me.MainEntry)
+			runMain(p, me.MainEntry)
 		}
 		if !p.isRunned {
 			Gopt_Game_Run(gamer, "assets")
@@ -66,6 +69,7 @@ func (p *Game) OnEngineStart() {
 }
 
 func (p *Game) OnEngineDestroy() {
+	p.stopRecord()
 }
 
 func (p *Game) OnEngineReset() {
@@ -127,7 +131,36 @@ func (p *Game) syncUpdateCamera() {
 }
 
 func (p *Game) syncUpdateInput() {
+	touches := &p.inputs.touches
+	gamepads := &p.inputs.gamepads
+	// the keys pressed and released are known before any script runs, so
+	// that all of them see the same KeyJustPressed in this frame
+	keys := &p.inputs.keys
+	keys.beginFrame()
+	n := len(keys.events)
+	keys.events = engine.GetKeyEvents(keys.events)
+	if p.replayer.active() {
+		p.mousePos = p.replayer.mousePos()
+		p.inputs.mouse.buttons, p.inputs.mouse.wheel = p.replayer.mouseButtons()
+		touches.cur = p.replayer.touches(touches.cur[:0])
+		keys.events = keys.events[:0]
+		p.replayer.applyKeys(keys)
+		gamepads.cur = p.replayer.gamepads(gamepads.cur[:0])
+		return
+	}
+	for _, ev := range keys.events[n:] {
+		keys.onKey(Key(ev.Id), ev.IsPressed)
+	}
 	p.mousePos = engine.SyncGetMousePos()
+	p.inputs.mouse.wheel = engine.SyncGetMouseWheel()
+	p.inputs.mouse.syncButtons()
+	touches.cur = engine.SyncGetTouches(touches.cur[:0])
+	gamepads.cur = engine.SyncGetGamepads(gamepads.cur[:0])
+	if p.recorder != nil {
+		p.recorder.onMouse(p.mousePos, &p.inputs.mouse)
+		p.recorder.onGamepads(gamepads.cur)
+		p.recorder.onTouches(touches.cur)
+	}
 }
 
 func (sprite *SpriteImpl) syncCheckInitProxy() {
@@ -142,6 +175,10 @@ func (sprite *SpriteImpl) syncCheckInitProxy() {
 		sprite.syncSprite.RegisterOnAnimationLooped(sprite.syncOnAnimationLooped)
 		sprite.syncSprite.RegisterOnAnimationFinished(sprite.syncOnAnimationFinished)
 		sprite.updateProxyTransform(true)
+		if sprite.timeScale != 1 || pausedObj(sprite) {
+			sprite.syncAnimSpeed()
+			sprite.syncPhysicsFreeze()
+		}
 	}
 }
 
@@ -199,6 +236,7 @@ func (p *Game) syncUpdateProxy() {
 			// sync position
 			if sprite.isVisible {
 				syncCheckUpdateCostume(&sprite.baseObj)
+				sprite.syncCheckUpdateShapes()
 				count++
 			}
 			syncSprite.SetVisible(sprite.isVisible)
@@ -247,7 +285,7 @@ func syncOnAtlasChanged(p *baseObj) {
 	p.setMaterialParamsVec4(key, val, true)
 }
 
-func (*Game) syncUpdatePhysic() {
+func (p *Game) syncUpdatePhysic() {
 	triggers := make([]engine.TriggerEvent, 0)
 	triggers = engine.GetTriggerEvents(triggers)
 	for _, pair := range triggers {
@@ -257,21 +295,27 @@ func (*Game) syncUpdatePhysic() {
 		dstSrpite, ok2 := dst.(*SpriteImpl)
 		if ok1 && ok2 {
 			if srcSprite.isVisible && !srcSprite.isDying && dstSrpite.isVisible && !dstSrpite.isDying {
-				srcSprite.hasOnTouchStart = true
-				srcSprite.fireTouchStart(dstSrpite)
+				if !pair.Collision {
+					srcSprite.hasOnTouchStart = true
+					srcSprite.fireTouchStart(dstSrpite)
+				}
+				p.syncFireContact(srcSprite, dstSrpite, !pair.Collision)
 			}
 
 		} else {
 			fmt.Printf("Physics error: unexpected trigger pair - invalid sprite types\n")
 		}
 	}
+	p.syncUpdateContactVelocities()
 }
 
 func syncInitSpritePhysicInfo(sprite *SpriteImpl, syncProxy *engine.Sprite) {
 	sprite.initCollisionParams()
+	sprite.applyCostumeShapes()
 	sprite.collisionInfo.syncToProxy(syncProxy, false, sprite)
 	sprite.triggerInfo.syncToProxy(syncProxy, true, sprite)
 	syncProxy.SetGravityScale(sprite.gravity)
+	sprite.syncBodyProps(syncProxy)
 	syncProxy.SetPhysicsMode(sprite.physicsMode)
 }
 
@@ -18,10 +18,10 @@ package spx
 
 import (
 	"math"
-	"time"
 
 	"github.com/goplus/spbase/mathf"
 	spxlog "github.com/goplus/spx/v2/internal/log"
+	gtime "github.com/goplus/spx/v2/internal/time"
 	gdx "github.com/goplus/spx/v2/pkg/gdspx/pkg/engine"
 )
 
@@ -253,9 +253,15 @@ type eventFirer interface {
 type inputManager struct {
 	tempItems []Shape
 	g         *Game
-	id2Timer  map[gdx.Object]int64
+	id2Timer  map[clickTimerKey]int64
 
 	swipeRecognizer inputSwipeRecognizer
+	gestures        inputGestureRecognizer
+	touches         inputTouches
+	gamepads        inputGamepads
+	actions         inputActions
+	keys            inputKeys
+	mouse           inputMouse
 }
 
 const (
@@ -267,10 +273,14 @@ const (
 
 func (p *inputManager) init(g *Game) {
 	p.tempItems = make([]Shape, 50)
-	p.id2Timer = make(map[gdx.Object]int64)
+	p.id2Timer = make(map[clickTimerKey]int64)
 	p.g = g
 
 	p.swipeRecognizer.init()
+	p.gestures.init()
+	p.touches = inputTouches{}
+	p.gamepads.init()
+	p.mouse = inputMouse{}
 }
 
 func (p *inputManager) startTracking(startPos mathf.Vec2, targetSprite *SpriteImpl) {
@@ -321,15 +331,23 @@ func (p *inputManager) onMouseMove(pos mathf.Vec2) {
 	}
 }
 
-func (p *inputManager) canTriggerClickEvent(id gdx.Object) bool {
-	currentTime := time.Now()
-	milliseconds := currentTime.UnixNano() / int64(time.Millisecond)
-	if lastTime, ok := p.id2Timer[id]; ok {
+// clickTimerKey identifies a click cooldown: the mouse button clicked and
+// the sprite clicked, or one of the special timer ids
+type clickTimerKey struct {
+	button int64
+	id     gdx.Object
+}
+
+func (p *inputManager) canTriggerClickEvent(button int64, id gdx.Object) bool {
+	// frame time rather than wall time, so recorded runs replay identically
+	milliseconds := int64(gtime.UnscaledTimeSinceLevelLoad() * 1000)
+	key := clickTimerKey{button, id}
+	if lastTime, ok := p.id2Timer[key]; ok {
 		if milliseconds-lastTime < inputMouseClickIntervalMs {
 			return false
 		}
 	}
-	p.id2Timer[id] = milliseconds
+	p.id2Timer[key] = milliseconds
 	return true
 }
 
@@ -347,7 +365,7 @@ type inputSwipeRecognizer struct {
 
 	// State data
 	isTracking   bool
-	startTime    time.Time
+	startTime    float64 // unscaled time since level load
 	startPoint   mathf.Vec2
 	endPoint     mathf.Vec2
 	points       []mathf.Vec2 // Trajectory points
@@ -382,7 +400,7 @@ func (p *inputSwipeRecognizer) setSwipeConfig(timeToSwipe, minDistance, maxDista
 // startTracking begins swipe tracking
 func (sr *inputSwipeRecognizer) startTracking(startPos mathf.Vec2, targetSprite *SpriteImpl) {
 	sr.isTracking = true
-	sr.startTime = time.Now()
+	sr.startTime = gtime.UnscaledTimeSinceLevelLoad()
 	sr.startPoint = startPos
 	sr.endPoint = startPos
 	sr.points = sr.points[:0] // clear previous points
@@ -407,7 +425,7 @@ func (sr *inputSwipeRecognizer) onMouseMove(pos mathf.Vec2) {
 
 	// Check if time limit exceeded
 	if sr.enableTimeLimit && sr.timeToSwipe > 0 {
-		elapsed := time.Since(sr.startTime).Seconds()
+		elapsed := gtime.UnscaledTimeSinceLevelLoad() - sr.startTime
 		if elapsed > sr.timeToSwipe {
 			sr.stopTracking()
 			return
@@ -435,7 +453,7 @@ func (sr *inputSwipeRecognizer) checkForSwipeCompletion() bool {
 
 	// 1. Time validation
 	if sr.enableTimeLimit && sr.timeToSwipe > 0 {
-		elapsed := time.Since(sr.startTime).Seconds()
+		elapsed := gtime.UnscaledTimeSinceLevelLoad() - sr.startTime
 		if elapsed > sr.timeToSwipe {
 			return false
 		}
@@ -452,8 +470,11 @@ func (sr *inputSwipeRecognizer) checkForSwipeCompletion() bool {
 	direction := sr.calculateDirection(sr.startPoint, sr.endPoint)
 
 	// 5. Calculate velocity and distance
-	elapsed := time.Since(sr.startTime).Seconds()
-	sr.swipeVelocity = idealDistance / elapsed
+	elapsed := gtime.UnscaledTimeSinceLevelLoad() - sr.startTime
+	sr.swipeVelocity = 0
+	if elapsed > 0 {
+		sr.swipeVelocity = idealDistance / elapsed
+	}
 	sr.swipeDistance = idealDistance
 	sr.detectedDirection = direction
 
@@ -43,6 +43,12 @@ type threadImpl struct {
 
 	ctx        context.Context
 	cancelFunc context.CancelFunc
+	done       chan struct{} // closed when the thread ends
+
+	pauseExempt bool
+
+	startFrame int64
+	waitReason string // what the thread waits for, "" if it doesn't, see Threads
 }
 
 // Context returns the context associated with this thread.
@@ -63,19 +69,49 @@ func (p *threadImpl) Cancel() {
 }
 
 func (p *threadImpl) String() string {
-	return fmt.Sprintf("id=%d name=%s ", p.id, p.name)
+	return fmt.Sprintf("id=%d name=%s ", p.id, p.Name())
+}
+
+func (p *threadImpl) ID() int64 {
+	return p.id
 }
 
 func (p *threadImpl) Name() string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
 	return p.name
 }
+
+// SetName names the thread, after its owner by default. It may be called from
+// any goroutine, e.g. while another one lists the threads.
+func (p *threadImpl) SetName(name string) {
+	p.mutex.Lock()
+	p.name = name
+	p.mutex.Unlock()
+}
 func (p *threadImpl) Stack() string {
 	return p.stack
 }
+
+// Done returns a channel closed when the thread ends.
+func (p *threadImpl) Done() <-chan struct{} {
+	return p.done
+}
+
 func (p *threadImpl) Stopped() bool {
 	return p.stopped_
 }
 
+// SetPauseExempt makes the thread keep running while its owner is paused, see
+// Coroutines.SetPauseFilter.
+func (p *threadImpl) SetPauseExempt(exempt bool) {
+	p.pauseExempt = exempt
+}
+
+func (p *threadImpl) PauseExempt() bool {
+	return p.pauseExempt
+}
+
 // Thread represents a coroutine id.
 type Thread = *threadImpl
 
@@ -112,6 +148,9 @@ type Coroutines struct {
 	goroutineIDs sync.Map // map[int64]bool
 
 	allThreads map[Thread]struct{}
+
+	paused    func(th Thread) bool    // see SetPauseFilter
+	timeScale func(th Thread) float64 // see SetTimeScaleFilter
 }
 
 const (
@@ -127,6 +166,7 @@ const (
 	waitTypeTime
 	waitTypeMainThread
 	waitTypeYield
+	waitTypeUnscaledTime
 )
 
 type WaitJob struct {
@@ -155,14 +195,38 @@ func New(onPanic func(name, stack string)) *Coroutines {
 	return p
 }
 
+// SetPauseFilter sets the function telling the paused threads. The waits of a
+// paused thread don't end, and the time it waits doesn't count, until it's no
+// longer paused.
+func (p *Coroutines) SetPauseFilter(paused func(th Thread) bool) {
+	p.paused = paused
+}
+
+func (p *Coroutines) isPaused(th Thread) bool {
+	return p.paused != nil && th != nil && !th.pauseExempt && p.paused(th)
+}
+
+// SetTimeScaleFilter sets the function telling the time scale of the threads,
+// on top of the game one. The waits of a thread with a time scale of 2 end
+// twice as soon.
+func (p *Coroutines) SetTimeScaleFilter(timeScale func(th Thread) float64) {
+	p.timeScale = timeScale
+}
+
+func (p *Coroutines) threadTimeScale(th Thread) float64 {
+	if p.timeScale == nil || th == nil {
+		return 1
+	}
+	return p.timeScale(th)
+}
+
 func (p *Coroutines) Sched(me Thread) {
 	go func() {
 		p.setWaitStatus(me, waitStatusIdle)
 		p.Resume(me)
 	}()
 	// Mark the thread as blocked and yield control
-	p.setWaitStatus(me, waitStatusBlock)
-	p.Yield(me)
+	p.block(me, "sched")
 }
 func (p *Coroutines) OnRestart() {
 	p.hasInited = false
@@ -220,7 +284,7 @@ func (p *Coroutines) StopIf(filter func(th Thread) bool) {
 
 // CreateAndStart creates and executes the new coroutine.
 func (p *Coroutines) CreateAndStart(start bool, tobj ThreadObj, fn func(me Thread) int) Thread {
-	id := &threadImpl{Obj: tobj, frame: p.frame, id: atomic.AddInt64(&p.curThId, 1), schedFrame: -1}
+	id := &threadImpl{Obj: tobj, frame: p.frame, id: atomic.AddInt64(&p.curThId, 1), schedFrame: -1, startFrame: time.Frame(), done: make(chan struct{})}
 	id.ctx, id.cancelFunc = context.WithCancel(context.Background())
 	name := ""
 
@@ -248,6 +312,8 @@ func (p *Coroutines) CreateAndStart(start bool, tobj ThreadObj, fn func(me Threa
 	p.mutex.Unlock()
 
 	id.cond = sync.NewCond(&id.mutex) // Initialize the thread's condition variable
+	// the thread is active from now on, the frame must not end before it runs
+	p.setWaitStatus(id, waitStatusAdd)
 	go func() {
 		// Track this goroutine ID
 		gid := goid.Get()
@@ -262,6 +328,7 @@ func (p *Coroutines) CreateAndStart(start bool, tobj ThreadObj, fn func(me Threa
 			p.mutex.Unlock()
 			p.setWaitStatus(id, waitStatusDelete)
 			id.Cancel()
+			close(id.done)
 			p.sema.Unlock()
 
 			// Remove goroutine ID from tracking
@@ -277,7 +344,6 @@ func (p *Coroutines) CreateAndStart(start bool, tobj ThreadObj, fn func(me Threa
 				}
 			}
 		}()
-		p.setWaitStatus(id, waitStatusAdd)
 		fn(id)
 	}()
 	if start {
@@ -298,8 +364,7 @@ func (p *Coroutines) WaitYield(me Thread) {
 	}
 
 	p.addWaitJob(job, false)
-	p.setWaitStatus(me, waitStatusBlock)
-	p.Yield(me)
+	p.block(me, "yield")
 }
 
 // Yield suspends a running coroutine.
@@ -328,6 +393,20 @@ func (p *Coroutines) Yield(me Thread) {
 	}
 }
 
+// block marks me as blocked for the reason, and yields it until its wait ends
+func (p *Coroutines) block(me Thread, reason string) {
+	p.mutex.Lock()
+	me.waitReason = reason
+	p.mutex.Unlock()
+
+	p.setWaitStatus(me, waitStatusBlock)
+	p.Yield(me)
+
+	p.mutex.Lock()
+	me.waitReason = ""
+	p.mutex.Unlock()
+}
+
 func (p *Coroutines) isSuspended(me Thread) bool {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
@@ -405,8 +484,28 @@ func (p *Coroutines) Wait(t float64) {
 
 	p.addWaitJob(job, false)
 
-	p.setWaitStatus(me, waitStatusBlock)
-	p.Yield(me)
+	p.block(me, fmt.Sprintf("wait %gs", t))
+}
+
+// WaitUnscaled is like Wait, but t is in real time, whatever the time scale.
+func (p *Coroutines) WaitUnscaled(t float64) {
+	me := p.Current()
+	dstTime := time.UnscaledTimeSinceLevelLoad() + t
+
+	job := &WaitJob{
+		Id:   atomic.AddInt64(&p.curId, 1),
+		Type: waitTypeUnscaledTime,
+		Call: func() {
+			p.setWaitStatus(me, waitStatusIdle)
+			p.Resume(me)
+		},
+		Time: dstTime,
+		Th:   me,
+	}
+
+	p.addWaitJob(job, false)
+
+	p.block(me, fmt.Sprintf("unscaled wait %gs", t))
 }
 
 func (p *Coroutines) WaitNextFrame() {
@@ -426,8 +525,7 @@ func (p *Coroutines) WaitNextFrame() {
 
 	p.addWaitJob(job, false)
 
-	p.setWaitStatus(me, waitStatusBlock)
-	p.Yield(me)
+	p.block(me, "next frame")
 }
 
 func (p *Coroutines) WaitMainThread(call func()) {
@@ -459,8 +557,7 @@ func (p *Coroutines) WaitToDo(fn func()) {
 	}()
 
 	// Mark the thread as blocked and yield control
-	p.setWaitStatus(me, waitStatusBlock)
-	p.Yield(me)
+	p.block(me, "task")
 }
 
 func WaitForChan[T any](p *Coroutines, done chan T, data *T) {
@@ -472,8 +569,7 @@ func WaitForChan[T any](p *Coroutines, done chan T, data *T) {
 		p.Resume(me)
 	}()
 	// Mark the thread as blocked and yield control
-	p.setWaitStatus(me, waitStatusBlock)
-	p.Yield(me)
+	p.block(me, "channel")
 }
 
 func (p *Coroutines) Update() {
@@ -493,6 +589,7 @@ func (p *Coroutines) Update() {
 	nextQueue := p.nextQueue
 	curFrame := time.Frame()
 	curTime := time.TimeSinceLevelLoad()
+	curUnscaledTime := time.UnscaledTimeSinceLevelLoad()
 	debugStartTime := time.RealTimeSinceStart()
 	waitFrameCount := 0
 	waitMainCount := 0
@@ -553,14 +650,30 @@ func (p *Coroutines) Update() {
 
 		switch task.Type {
 		case waitTypeFrame:
-			if task.Frame >= curFrame {
+			if task.Frame >= curFrame || p.isPaused(task.Th) {
 				nextQueue.PushBack(task)
 			} else {
 				task.Call()
 				waitFrameCount++
 			}
 		case waitTypeTime:
-			if task.Time >= curTime {
+			if p.isPaused(task.Th) {
+				task.Time += time.DeltaTime() // the time paused doesn't count
+				nextQueue.PushBack(task)
+			} else {
+				// the time of a thread scaled up or down counts more or less
+				task.Time -= time.DeltaTime() * (p.threadTimeScale(task.Th) - 1)
+				if task.Time >= curTime {
+					nextQueue.PushBack(task)
+				} else {
+					task.Call()
+				}
+			}
+		case waitTypeUnscaledTime:
+			if p.isPaused(task.Th) {
+				task.Time += time.UnscaledDeltaTime()
+				nextQueue.PushBack(task)
+			} else if task.Time >= curUnscaledTime {
 				nextQueue.PushBack(task)
 			} else {
 				task.Call()
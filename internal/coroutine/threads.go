@@ -0,0 +1,68 @@
+package coroutine
+
+import (
+	"slices"
+
+	"github.com/goplus/spx/v2/internal/time"
+)
+
+// ThreadState tells what a thread is doing.
+type ThreadState int
+
+const (
+	ThreadRunning   ThreadState = iota // running now
+	ThreadWaiting                      // waiting for a time, a frame, a task...
+	ThreadSuspended                    // ready to run, or just created
+)
+
+// ThreadInfo describes a thread, see Threads.
+type ThreadInfo struct {
+	Th         Thread
+	State      ThreadState
+	Frames     int64  // number of frames since the thread was created
+	WaitReason string // what the thread waits for, "" if it doesn't
+}
+
+// Threads returns the threads alive, by creation order.
+func (p *Coroutines) Threads() []ThreadInfo {
+	cur := p.Current()
+	frame := time.Frame()
+
+	p.mutex.Lock()
+	infos := make([]ThreadInfo, 0, len(p.allThreads))
+	for th := range p.allThreads {
+		if th.stopped_ {
+			continue
+		}
+		info := ThreadInfo{Th: th, Frames: frame - th.startFrame, WaitReason: th.waitReason}
+		switch {
+		case th == cur && !p.suspended[th]:
+			info.State = ThreadRunning
+		case th.waitReason != "":
+			info.State = ThreadWaiting
+		default:
+			info.State = ThreadSuspended
+		}
+		infos = append(infos, info)
+	}
+	p.mutex.Unlock()
+
+	slices.SortFunc(infos, func(a, b ThreadInfo) int {
+		return int(a.Th.id - b.Th.id)
+	})
+	return infos
+}
+
+// Stop stops th: it's aborted the next time it resumes, or now if it's the
+// current thread.
+func (p *Coroutines) Stop(th Thread) {
+	if th == p.Current() {
+		p.Abort()
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if _, ok := p.allThreads[th]; ok {
+		th.stopped_ = true
+		th.Cancel()
+	}
+}
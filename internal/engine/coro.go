@@ -64,6 +64,12 @@ func Wait(secs float64) float64 {
 	return time.TimeSinceLevelLoad() - startTime
 }
 
+func WaitUnscaled(secs float64) float64 {
+	startTime := time.UnscaledTimeSinceLevelLoad()
+	gco.WaitUnscaled(secs)
+	return time.UnscaledTimeSinceLevelLoad() - startTime
+}
+
 func WaitYield() {
 	gco.WaitYield(gco.Current())
 }
@@ -81,8 +81,9 @@ func ConvertToInt64(val float64) int64 {
 }
 
 type TriggerEvent struct {
-	Src *Sprite
-	Dst *Sprite
+	Src       *Sprite
+	Dst       *Sprite
+	Collision bool // a collision of physics bodies rather than a trigger overlap
 }
 type KeyEvent struct {
 	Id        int64
@@ -91,6 +92,7 @@ type KeyEvent struct {
 
 var (
 	game              IGame
+	started           bool // the engine started, a game may replace the running one
 	triggerEventsTemp []TriggerEvent
 	triggerEvents     []TriggerEvent
 	triggerMutex      sync.Mutex
@@ -100,9 +102,10 @@ var (
 	keyMutex      sync.Mutex
 
 	// time
-	startTimestamp     stime.Time
-	lastTimestamp      stime.Time
-	timeSinceLevelLoad float64
+	lastTimestamp              stime.Time
+	timeSinceLevelLoad         float64
+	unscaledTimeSinceLevelLoad float64
+	frameTimeHook              func(delta, unscaledDelta float64) (float64, float64)
 
 	logicMutex sync.Mutex
 	// statistic info
@@ -127,6 +130,15 @@ type IGame interface {
 }
 
 func Main(g IGame) {
+	if started {
+		// a game replacing the running one, see package hotreload: the engine
+		// is linked and won't start again
+		WaitMainThread(func() {
+			game = g
+		})
+		g.OnEngineStart()
+		return
+	}
 	enginewrap.Init(WaitMainThread)
 	game = g
 	gde.LinkEngine(gdx.EngineCallbackInfo{
@@ -159,8 +171,8 @@ func onStart() {
 		platformMgr.SetTimeScale(scale)
 	})
 
-	startTimestamp = stime.Now()
 	lastTimestamp = stime.Now()
+	started = true
 	game.OnEngineStart()
 }
 
@@ -191,6 +203,7 @@ func onPaused(isPaused bool) {
 }
 
 func onReset() {
+	started = false
 	engine.ClearAllSprites()
 	game.OnEngineReset()
 	gco.AbortAll()
@@ -205,14 +218,24 @@ func onKeyReleased(id int64) {
 	keyEventsTemp = append(keyEventsTemp, KeyEvent{Id: id, IsPressed: false})
 }
 
+// SetFrameTimeHook installs fn to observe or override the timing of each
+// frame before the game sees it, which is how runs are recorded and replayed.
+// Pass nil to remove the hook.
+func SetFrameTimeHook(fn func(delta, unscaledDelta float64) (float64, float64)) {
+	frameTimeHook = fn
+}
+
 func updateTime(delta float64) {
 	deltaTime := delta
-	timeSinceLevelLoad += deltaTime
 
 	curTime := stime.Now()
-	unscaledTimeSinceLevelLoad := curTime.Sub(startTimestamp).Seconds()
 	unscaledDeltaTime := curTime.Sub(lastTimestamp).Seconds()
 	lastTimestamp = curTime
+	if frameTimeHook != nil {
+		deltaTime, unscaledDeltaTime = frameTimeHook(deltaTime, unscaledDeltaTime)
+	}
+	timeSinceLevelLoad += deltaTime
+	unscaledTimeSinceLevelLoad += unscaledDeltaTime
 	timeScale := SyncGetTimeScale()
 	fps = profiler.Calcfps()
 	time.Update(float64(timeScale), unscaledTimeSinceLevelLoad, timeSinceLevelLoad, deltaTime, unscaledDeltaTime, fps)
@@ -66,6 +66,13 @@ func (pself *Sprite) OnTriggerEnter(target gdx.ISpriter) {
 		triggerEventsTemp = append(triggerEventsTemp, TriggerEvent{Src: pself, Dst: sprite})
 	}
 }
+
+func (pself *Sprite) OnCollisionEnter(target gdx.ISpriter) {
+	sprite, ok := target.(*Sprite)
+	if ok {
+		triggerEventsTemp = append(triggerEventsTemp, TriggerEvent{Src: pself, Dst: sprite, Collision: true})
+	}
+}
 func (pself *Sprite) RegisterOnAnimationLooped(f func()) {
 	pself.Sprite.OnAnimationLoopedEvent.Subscribe(f)
 }
@@ -1,6 +1,9 @@
 package engine
 
 import (
+	"math/bits"
+	"sort"
+
 	. "github.com/goplus/spbase/mathf"
 	gdx "github.com/goplus/spx/v2/pkg/gdspx/pkg/engine"
 )
@@ -31,6 +34,56 @@ func SyncGetMousePos() Vec2 {
 	return gdx.InputMgr.GetGlobalMousePos()
 }
 
+// SyncGetMouseState reports whether the mouse button is pressed.
+func SyncGetMouseState(button int64) bool {
+	return gdx.InputMgr.GetMouseState(button)
+}
+
+// SyncGetMouseWheel returns the notches the mouse wheel scrolled during the
+// frame, y up.
+func SyncGetMouseWheel() Vec2 {
+	return gdx.InputMgr.GetMouseWheel()
+}
+
+// Touch is a finger touching the screen, Pos is in the space of the mouse
+// position.
+type Touch struct {
+	Id  int64
+	Pos Vec2
+}
+
+// SyncGetTouches appends the fingers touching the screen to lst, by id.
+func SyncGetTouches(lst []Touch) []Touch {
+	start := len(lst)
+	n := gdx.InputMgr.GetTouchCount()
+	for i := int64(0); i < n; i++ {
+		lst = append(lst, Touch{Id: gdx.InputMgr.GetTouchId(i), Pos: gdx.InputMgr.GetTouchPos(i)})
+	}
+	touches := lst[start:]
+	sort.Slice(touches, func(i, j int) bool { return touches[i].Id < touches[j].Id })
+	return lst
+}
+
+// Gamepad is a connected gamepad, its axes as the engine reports them.
+type Gamepad struct {
+	Id      int64
+	Buttons int64 // bit i is set if button i is pressed
+	Axes    [6]float64
+}
+
+// SyncGetGamepads appends the connected gamepads to lst, by id.
+func SyncGetGamepads(lst []Gamepad) []Gamepad {
+	for devices := gdx.InputMgr.GetConnectedGamepads(); devices != 0; devices &= devices - 1 {
+		id := int64(bits.TrailingZeros64(uint64(devices)))
+		pad := Gamepad{Id: id, Buttons: gdx.InputMgr.GetGamepadButtons(id)}
+		for axis := range pad.Axes {
+			pad.Axes[axis] = gdx.InputMgr.GetGamepadAxis(id, int64(axis))
+		}
+		lst = append(lst, pad)
+	}
+	return lst
+}
+
 func SyncSetCameraPosition(pos Vec2) {
 	gdx.CameraMgr.SetCameraPosition(NewVec2(pos.X, -pos.Y))
 }
@@ -52,3 +105,7 @@ func SyncWorldToScreen(pos Vec2) Vec2 {
 func SyncGetBoundFromAlpha(assetPath string) Rect2 {
 	return gdx.ResMgr.GetBoundFromAlpha(assetPath)
 }
+
+func SyncGetPolygonFromAlpha(assetPath string) Array {
+	return gdx.ResMgr.GetPolygonFromAlpha(assetPath)
+}
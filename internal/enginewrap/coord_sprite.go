@@ -47,3 +47,42 @@ func (pself *Sprite) SetColliderEnabled(isTrigger bool, enabled bool) {
 		pself.Sprite.SetCollisionEnabled(enabled)
 	}
 }
+
+func (pself *Sprite) SetColliderShapePolygon(isTrigger bool, center Vec2, points []float64) {
+	center.Y = -center.Y
+	points = flipPolygon(points)
+	if isTrigger {
+		pself.Sprite.SetTriggerPolygon(center, points)
+	} else {
+		pself.Sprite.SetColliderPolygon(center, points)
+	}
+}
+
+// AddColliderShape adds a shape of the given spx collider type to the
+// collider, making a compound collider of all the shapes added.
+func (pself *Sprite) AddColliderShape(isTrigger bool, shapeType int64, center Vec2, params []float64) {
+	center.Y = -center.Y
+	if shapeType == colliderTypePolygon {
+		params = flipPolygon(params)
+	}
+	if isTrigger {
+		pself.Sprite.AddTriggerShape(shapeType, center, params)
+	} else {
+		pself.Sprite.AddColliderShape(shapeType, center, params)
+	}
+}
+
+// colliderTypePolygon is the polygon collider type of spx
+const colliderTypePolygon = 5
+
+// flipPolygon returns the flattened points with the Y axis inverted
+func flipPolygon(points []float64) []float64 {
+	ret := make([]float64, len(points))
+	for i, v := range points {
+		if i%2 == 1 {
+			v = -v
+		}
+		ret[i] = v
+	}
+	return ret
+}
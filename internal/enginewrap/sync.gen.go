@@ -411,6 +411,55 @@ func (pself *inputMgrImpl) IsActionJustReleased(action string) bool {
 	})
 	return _ret1
 }
+func (pself *inputMgrImpl) GetConnectedGamepads() int64 {
+	var _ret1 int64
+	callInMainThread(func() {
+		_ret1 = gdx.InputMgr.GetConnectedGamepads()
+	})
+	return _ret1
+}
+func (pself *inputMgrImpl) GetGamepadButtons(device int64) int64 {
+	var _ret1 int64
+	callInMainThread(func() {
+		_ret1 = gdx.InputMgr.GetGamepadButtons(device)
+	})
+	return _ret1
+}
+func (pself *inputMgrImpl) GetGamepadAxis(device int64, axis int64) float64 {
+	var _ret1 float64
+	callInMainThread(func() {
+		_ret1 = gdx.InputMgr.GetGamepadAxis(device, axis)
+	})
+	return _ret1
+}
+func (pself *inputMgrImpl) GetTouchCount() int64 {
+	var _ret1 int64
+	callInMainThread(func() {
+		_ret1 = gdx.InputMgr.GetTouchCount()
+	})
+	return _ret1
+}
+func (pself *inputMgrImpl) GetTouchId(index int64) int64 {
+	var _ret1 int64
+	callInMainThread(func() {
+		_ret1 = gdx.InputMgr.GetTouchId(index)
+	})
+	return _ret1
+}
+func (pself *inputMgrImpl) GetTouchPos(index int64) Vec2 {
+	var _ret1 Vec2
+	callInMainThread(func() {
+		_ret1 = gdx.InputMgr.GetTouchPos(index)
+	})
+	return _ret1
+}
+func (pself *inputMgrImpl) GetMouseWheel() Vec2 {
+	var _ret1 Vec2
+	callInMainThread(func() {
+		_ret1 = gdx.InputMgr.GetMouseWheel()
+	})
+	return _ret1
+}
 
 // INavigationMgr
 func (pself *navigationMgrImpl) SetupPathFinderWithSize(grid_size Vec2, cell_size Vec2, with_jump bool, with_debug bool) {
@@ -730,6 +779,13 @@ func (pself *resMgrImpl) GetBoundFromAlpha(p_path string) Rect2 {
 	})
 	return _ret1
 }
+func (pself *resMgrImpl) GetPolygonFromAlpha(p_path string) gdx.Array {
+	var _ret1 gdx.Array
+	callInMainThread(func() {
+		_ret1 = gdx.ResMgr.GetPolygonFromAlpha(p_path)
+	})
+	return _ret1
+}
 func (pself *resMgrImpl) GetImageSize(p_path string) Vec2 {
 	var _ret1 Vec2
 	callInMainThread(func() {
@@ -1478,6 +1534,16 @@ func (pself *spriteMgrImpl) SetColliderCapsule(obj gdx.Object, center Vec2, size
 		gdx.SpriteMgr.SetColliderCapsule(obj, center, size)
 	})
 }
+func (pself *spriteMgrImpl) SetColliderPolygon(obj gdx.Object, center Vec2, points gdx.Array) {
+	callInMainThread(func() {
+		gdx.SpriteMgr.SetColliderPolygon(obj, center, points)
+	})
+}
+func (pself *spriteMgrImpl) AddColliderShape(obj gdx.Object, shape_type int64, center Vec2, params gdx.Array) {
+	callInMainThread(func() {
+		gdx.SpriteMgr.AddColliderShape(obj, shape_type, center, params)
+	})
+}
 func (pself *spriteMgrImpl) SetCollisionEnabled(obj gdx.Object, enabled bool) {
 	callInMainThread(func() {
 		gdx.SpriteMgr.SetCollisionEnabled(obj, enabled)
@@ -1505,6 +1571,16 @@ func (pself *spriteMgrImpl) SetTriggerCapsule(obj gdx.Object, center Vec2, size
 		gdx.SpriteMgr.SetTriggerCapsule(obj, center, size)
 	})
 }
+func (pself *spriteMgrImpl) SetTriggerPolygon(obj gdx.Object, center Vec2, points gdx.Array) {
+	callInMainThread(func() {
+		gdx.SpriteMgr.SetTriggerPolygon(obj, center, points)
+	})
+}
+func (pself *spriteMgrImpl) AddTriggerShape(obj gdx.Object, shape_type int64, center Vec2, params gdx.Array) {
+	callInMainThread(func() {
+		gdx.SpriteMgr.AddTriggerShape(obj, shape_type, center, params)
+	})
+}
 func (pself *spriteMgrImpl) SetTriggerEnabled(obj gdx.Object, trigger bool) {
 	callInMainThread(func() {
 		gdx.SpriteMgr.SetTriggerEnabled(obj, trigger)
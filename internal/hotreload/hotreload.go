@@ -0,0 +1,44 @@
+// Package hotreload applies the changes to the files of a project to the game
+// running it. The launcher watching the project reports the changes, the game
+// registers how to apply them.
+package hotreload
+
+import "errors"
+
+var ErrNoGame = errors.New("hotreload: no game running")
+
+// Handler applies the changes to the running game.
+type Handler interface {
+	// ReloadAssets reloads the files of the assets directory that changed,
+	// given relative to it.
+	ReloadAssets(files []string)
+
+	// ReloadGame replaces the running game by the one started by run, which
+	// calls the main function of the new game. The state of the running game
+	// is kept where possible. Once the scripts of the replaced game have all
+	// ended, release is called to free what they used, e.g. their interpreter.
+	ReloadGame(run func() error, release func()) error
+}
+
+var handler Handler
+
+// SetHandler sets the handler of the running game.
+func SetHandler(h Handler) {
+	handler = h
+}
+
+// ReloadAssets reloads the files of the assets directory that changed.
+func ReloadAssets(files []string) {
+	if handler != nil {
+		handler.ReloadAssets(files)
+	}
+}
+
+// ReloadGame replaces the running game by the one started by run, then calls
+// release once the scripts of the replaced game have all ended.
+func ReloadGame(run func() error, release func()) error {
+	if handler == nil {
+		return ErrNoGame
+	}
+	return handler.ReloadGame(run, release)
+}
@@ -0,0 +1,153 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// Node is a value of a JSON document, with the line it starts at.
+type Node struct {
+	Line    int
+	Kind    string // "object", "array", "string", "number", "boolean" or "null"
+	Members []*Member
+	Items   []*Node
+	Str     string
+	Num     json.Number
+}
+
+// Member is a key of a JSON object with its value.
+type Member struct {
+	Key   string
+	Line  int
+	Value *Node
+}
+
+// Get returns the value of key in the object n, matched as the loader does,
+// or nil if there is none.
+func (n *Node) Get(key string) *Node {
+	if m := n.member(key); m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (n *Node) member(key string) *Member {
+	if n == nil {
+		return nil
+	}
+	var fold *Member
+	for _, m := range n.Members {
+		if m.Key == key {
+			return m
+		}
+		if fold == nil && strings.EqualFold(m.Key, key) {
+			fold = m
+		}
+	}
+	return fold
+}
+
+// Array returns the items of n, or nil if n isn't an array.
+func (n *Node) Array() []*Node {
+	if n == nil {
+		return nil
+	}
+	return n.Items
+}
+
+// Object returns the members of n, or nil if n isn't an object.
+func (n *Node) Object() []*Member {
+	if n == nil {
+		return nil
+	}
+	return n.Members
+}
+
+// String returns the string value of n, or "" if n isn't a string.
+func (n *Node) String() string {
+	if n == nil {
+		return ""
+	}
+	return n.Str
+}
+
+// Parse parses a JSON document.
+func Parse(data []byte) (*Node, error) {
+	p := &parser{data: data, dec: json.NewDecoder(bytes.NewReader(data))}
+	p.dec.UseNumber()
+	n, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = p.dec.Token(); err == nil {
+		return nil, errors.New("invalid JSON: data after the top-level value")
+	}
+	return n, nil
+}
+
+type parser struct {
+	data  []byte
+	dec   *json.Decoder
+	off   int
+	lines int
+}
+
+// line returns the line of the last token read
+func (p *parser) line() int {
+	off := int(p.dec.InputOffset())
+	p.lines += bytes.Count(p.data[p.off:off], []byte{'\n'})
+	p.off = off
+	return p.lines + 1
+}
+
+func (p *parser) value() (*Node, error) {
+	tok, err := p.dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	return p.valueOf(tok)
+}
+
+func (p *parser) valueOf(tok json.Token) (*Node, error) {
+	n := &Node{Line: p.line()}
+	switch v := tok.(type) {
+	case json.Delim:
+		if v == '{' {
+			n.Kind = "object"
+			for p.dec.More() {
+				key, err := p.dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				m := &Member{Key: key.(string), Line: p.line()}
+				if m.Value, err = p.value(); err != nil {
+					return nil, err
+				}
+				n.Members = append(n.Members, m)
+			}
+		} else {
+			n.Kind = "array"
+			for p.dec.More() {
+				item, err := p.value()
+				if err != nil {
+					return nil, err
+				}
+				n.Items = append(n.Items, item)
+			}
+		}
+		if _, err := p.dec.Token(); err != nil { // closing delimiter
+			return nil, err
+		}
+	case string:
+		n.Kind, n.Str = "string", v
+	case json.Number:
+		n.Kind, n.Num = "number", v
+	case bool:
+		n.Kind = "boolean"
+	default:
+		n.Kind = "null"
+	}
+	return n, nil
+}
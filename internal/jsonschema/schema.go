@@ -0,0 +1,152 @@
+// Package jsonschema generates the JSON Schema of the project files from the
+// structs they are decoded into, and validates documents against it. Only the
+// subset of JSON Schema needed by those structs is supported: types,
+// properties, additionalProperties, items and enum.
+//
+// The loader of spx ignores unknown keys and matches keys case-insensitively,
+// as encoding/json does. The validator matches keys the same way, so that it
+// only reports what the loader would silently drop.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Draft is the JSON Schema version of the generated schemas.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema. A Schema with False set is the schema false, which
+// no value matches.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+
+	False bool `json:"-"`
+}
+
+type schemaObj Schema
+
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	if s.False {
+		return []byte("false"), nil
+	}
+	return json.Marshal((*schemaObj)(s))
+}
+
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	switch string(bytes.TrimSpace(data)) {
+	case "false":
+		*s = Schema{False: true}
+		return nil
+	case "true":
+		*s = Schema{}
+		return nil
+	}
+	return json.Unmarshal(data, (*schemaObj)(s))
+}
+
+// -----------------------------------------------------------------------------
+
+var registry = make(map[string]reflect.Type)
+
+// Register registers t as the type the project files of the given kind are
+// decoded into.
+func Register(kind string, t reflect.Type) {
+	registry[kind] = t
+}
+
+// Kinds returns the registered kinds of project files, sorted.
+func Kinds() []string {
+	kinds := make([]string, 0, len(registry))
+	for kind := range registry {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// Generate returns the schema of the project files of the given kind, or nil
+// if the kind isn't registered.
+func Generate(kind string) *Schema {
+	t, ok := registry[kind]
+	if !ok {
+		return nil
+	}
+	s := generate(t, make(map[reflect.Type]bool))
+	s.Schema = Draft
+	s.Title = kind
+	if s.Properties != nil {
+		s.Properties["$schema"] = &Schema{Type: "string"}
+	}
+	return s
+}
+
+func generate(t reflect.Type, visiting map[reflect.Type]bool) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: generate(t.Elem(), visiting)}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: generate(t.Elem(), visiting)}
+	case reflect.Struct:
+		if visiting[t] {
+			return &Schema{} // recursive type
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		s := &Schema{
+			Type:                 "object",
+			Properties:           make(map[string]*Schema),
+			AdditionalProperties: &Schema{False: true},
+		}
+		addFields(s, t, visiting)
+		return s
+	}
+	return &Schema{} // any
+}
+
+func addFields(s *Schema, t reflect.Type, visiting map[reflect.Type]bool) {
+	for i, n := 0, t.NumField(); i < n; i++ {
+		fld := t.Field(i)
+		tag := fld.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if fld.Anonymous && name == "" && fld.Type.Kind() == reflect.Struct {
+			addFields(s, fld.Type, visiting)
+			continue
+		}
+		if !fld.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = fld.Name
+		}
+		prop := generate(fld.Type, visiting)
+		if enum := fld.Tag.Get("enum"); enum != "" {
+			prop.Enum = strings.Split(enum, ",")
+		}
+		s.Properties[name] = prop
+	}
+}
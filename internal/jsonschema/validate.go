@@ -0,0 +1,141 @@
+package jsonschema
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Issue is a value of a document not matching its schema.
+type Issue struct {
+	Line int
+	Path string // path of the value, such as "costumes[0].path"
+	Msg  string
+}
+
+func (p *Issue) String() string {
+	if p.Path == "" {
+		return fmt.Sprintf("%d: %s", p.Line, p.Msg)
+	}
+	return fmt.Sprintf("%d: %s: %s", p.Line, p.Path, p.Msg)
+}
+
+// Validate validates the document n against the schema s. A null matches any
+// schema, as the loader leaves the value unset.
+func Validate(n *Node, s *Schema) []*Issue {
+	var issues []*Issue
+	validate(n, s, "", &issues)
+	return issues
+}
+
+func validate(n *Node, s *Schema, path string, issues *[]*Issue) {
+	if s == nil || n.Kind == "null" {
+		return
+	}
+	report := func(line int, path, format string, args ...any) {
+		*issues = append(*issues, &Issue{Line: line, Path: path, Msg: fmt.Sprintf(format, args...)})
+	}
+	if !matchType(n, s.Type) {
+		report(n.Line, path, "expected %s, got %s", s.Type, n.Kind)
+		return
+	}
+	if len(s.Enum) > 0 && !slices.Contains(s.Enum, n.Str) {
+		report(n.Line, path, "invalid value %q, expected one of %s", n.Str, quoteAll(s.Enum))
+	}
+	switch n.Kind {
+	case "object":
+		for _, m := range n.Members {
+			sub := join(path, m.Key)
+			if prop := property(s, m.Key); prop != nil {
+				validate(m.Value, prop, sub, issues)
+			} else if s.AdditionalProperties == nil || !s.AdditionalProperties.False {
+				validate(m.Value, s.AdditionalProperties, sub, issues)
+			} else if guess := closest(s, m.Key); guess != "" {
+				report(m.Line, path, "unknown key %q, did you mean %q?", m.Key, guess)
+			} else {
+				report(m.Line, path, "unknown key %q", m.Key)
+			}
+		}
+	case "array":
+		for i, item := range n.Items {
+			validate(item, s.Items, fmt.Sprintf("%s[%d]", path, i), issues)
+		}
+	}
+}
+
+func matchType(n *Node, typ string) bool {
+	switch typ {
+	case "":
+		return true
+	case "integer":
+		return n.Kind == "number" && !strings.ContainsAny(n.Num.String(), ".eE")
+	}
+	return n.Kind == typ
+}
+
+// property returns the schema of key, matched as the loader does
+func property(s *Schema, key string) *Schema {
+	if prop, ok := s.Properties[key]; ok {
+		return prop
+	}
+	for name, prop := range s.Properties {
+		if strings.EqualFold(name, key) {
+			return prop
+		}
+	}
+	return nil
+}
+
+// closest returns the property of s closest to the unknown key, if close
+// enough to be a typo
+func closest(s *Schema, key string) (guess string) {
+	best := len(key)/3 + 1
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if d := distance(strings.ToLower(key), strings.ToLower(name)); d < best {
+			best, guess = d, name
+		}
+	}
+	return
+}
+
+// distance returns the Levenshtein distance between a and b
+func distance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func quoteAll(list []string) string {
+	quoted := make([]string, len(list))
+	for i, v := range list {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,41 @@
+package jsonschema_test
+
+import (
+	"slices"
+	"testing"
+
+	_ "github.com/goplus/spx/v2" // registers the config types
+	"github.com/goplus/spx/v2/internal/jsonschema"
+)
+
+const sprite = `{
+  "costumes": [
+    {"name": "a", "path": "a.png"}
+  ],
+  "collisionShapeType": "rectangle",
+  "physicsMode": "dynamic ",
+  "fAnimation": {},
+  "Heading": 90,
+  "size": "big",
+  "x": null
+}`
+
+func TestValidate(t *testing.T) {
+	doc, err := jsonschema.Parse([]byte(sprite))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, issue := range jsonschema.Validate(doc, jsonschema.Generate("sprite")) {
+		got = append(got, issue.String())
+	}
+	want := []string{
+		`5: collisionShapeType: invalid value "rectangle", expected one of "", "none", "auto", "circle", "rect", "capsule", "polygon", "outline"`,
+		`6: physicsMode: invalid value "dynamic ", expected one of "", "no", "static", "kinematic", "dynamic"`,
+		`7: unknown key "fAnimation", did you mean "fAnimations"?`,
+		`9: size: expected number, got string`,
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("issues:\n%q\nwant:\n%q", got, want)
+	}
+}
@@ -0,0 +1,439 @@
+// Package record reads and writes .spxrec files, which capture everything a
+// run depends on besides the game itself: the random seed, the timing of every
+// frame, the input events and the state of the mouse buttons, the wheel, the
+// gamepads and the touches. Feeding a recording back to the game replays the
+// run frame by frame.
+//
+// A file is a gzip stream made of a header (magic, version, seed) followed by
+// one record per frame. Writers flush regularly, so the recording of a crashed
+// run is still readable up to the last flushed frame.
+package record
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+)
+
+const (
+	magic   = "SPXREC"
+	version = 2
+
+	// number of frames between two flushes of the writer
+	flushInterval = 60
+)
+
+const (
+	frameFlagMouse  = 1 << 0
+	frameFlagEvents = 1 << 1
+
+	frameFlagGamepads = 1 << 2 // since version 2
+	frameFlagTouches  = 1 << 3 // since version 2
+	frameFlagButtons  = 1 << 4 // since version 2
+	frameFlagWheel    = 1 << 5 // since version 2
+)
+
+var ErrInvalidFormat = errors.New("record: invalid spxrec file")
+
+type EventKind uint8
+
+const (
+	EventKeyDown EventKind = iota + 1
+	EventKeyUp
+	EventMouseDown
+	EventMouseUp
+)
+
+// Event is an input event fired into the game.
+// Key is set for key events, X and Y for mouse events.
+type Event struct {
+	Kind EventKind
+	Key  int64
+	X, Y float64
+}
+
+// GamepadAxes is the number of axes of a gamepad.
+const GamepadAxes = 6
+
+// Gamepad is the state of a connected gamepad.
+type Gamepad struct {
+	Id      int64
+	Buttons int64 // bit i is set if button i is pressed
+	Axes    [GamepadAxes]float64
+}
+
+// Touch is a finger touching the screen.
+type Touch struct {
+	Id   int64
+	X, Y float64
+}
+
+// Frame holds what the game received during one engine frame.
+type Frame struct {
+	Delta         float64
+	UnscaledDelta float64
+	MouseX        float64
+	MouseY        float64
+	MouseButtons  int64 // bit i is set if button i is pressed, but the left one
+	WheelX        float64
+	WheelY        float64
+	Events        []Event
+	Gamepads      []Gamepad // the connected ones, by id
+	Touches       []Touch   // by id
+}
+
+// -----------------------------------------------------------------------------
+
+// Writer writes a recording to a file.
+type Writer struct {
+	file   *os.File
+	gz     *gzip.Writer
+	w      *bufio.Writer
+	buf    []byte
+	frames int
+	mouseX float64
+	mouseY float64
+
+	buttons  int64
+	gamepads []Gamepad
+	touches  []Touch
+}
+
+// Create creates the recording file path for a run using seed.
+func Create(path string, seed int64) (*Writer, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(f)
+	p := &Writer{file: f, gz: gz, w: bufio.NewWriter(gz)}
+	p.w.WriteString(magic)
+	p.w.WriteByte(version)
+	p.putVarint(seed)
+	if err = p.flush(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *Writer) putUvarint(v uint64) {
+	p.buf = binary.AppendUvarint(p.buf[:0], v)
+	p.w.Write(p.buf)
+}
+
+func (p *Writer) putVarint(v int64) {
+	p.buf = binary.AppendVarint(p.buf[:0], v)
+	p.w.Write(p.buf)
+}
+
+func (p *Writer) putFloat(v float64) {
+	p.buf = binary.LittleEndian.AppendUint64(p.buf[:0], math.Float64bits(v))
+	p.w.Write(p.buf)
+}
+
+func (p *Writer) flush() error {
+	if err := p.w.Flush(); err != nil {
+		return err
+	}
+	return p.gz.Flush()
+}
+
+// WriteFrame appends a frame to the recording.
+func (p *Writer) WriteFrame(frame *Frame) error {
+	var flags byte
+	if frame.MouseX != p.mouseX || frame.MouseY != p.mouseY {
+		flags |= frameFlagMouse
+		p.mouseX, p.mouseY = frame.MouseX, frame.MouseY
+	}
+	if len(frame.Events) > 0 {
+		flags |= frameFlagEvents
+	}
+	if frame.MouseButtons != p.buttons {
+		flags |= frameFlagButtons
+		p.buttons = frame.MouseButtons
+	}
+	if frame.WheelX != 0 || frame.WheelY != 0 {
+		flags |= frameFlagWheel
+	}
+	if !slices.Equal(frame.Gamepads, p.gamepads) {
+		flags |= frameFlagGamepads
+		p.gamepads = append(p.gamepads[:0], frame.Gamepads...)
+	}
+	if !slices.Equal(frame.Touches, p.touches) {
+		flags |= frameFlagTouches
+		p.touches = append(p.touches[:0], frame.Touches...)
+	}
+	p.w.WriteByte(flags)
+	p.putFloat(frame.Delta)
+	p.putFloat(frame.UnscaledDelta)
+	if flags&frameFlagMouse != 0 {
+		p.putFloat(frame.MouseX)
+		p.putFloat(frame.MouseY)
+	}
+	if flags&frameFlagEvents != 0 {
+		p.putUvarint(uint64(len(frame.Events)))
+		for _, ev := range frame.Events {
+			p.w.WriteByte(byte(ev.Kind))
+			switch ev.Kind {
+			case EventKeyDown, EventKeyUp:
+				p.putVarint(ev.Key)
+			default:
+				p.putFloat(ev.X)
+				p.putFloat(ev.Y)
+			}
+		}
+	}
+	if flags&frameFlagButtons != 0 {
+		p.putVarint(frame.MouseButtons)
+	}
+	if flags&frameFlagWheel != 0 {
+		p.putFloat(frame.WheelX)
+		p.putFloat(frame.WheelY)
+	}
+	if flags&frameFlagGamepads != 0 {
+		p.putUvarint(uint64(len(frame.Gamepads)))
+		for _, pad := range frame.Gamepads {
+			p.putVarint(pad.Id)
+			p.putVarint(pad.Buttons)
+			for _, v := range pad.Axes {
+				p.putFloat(v)
+			}
+		}
+	}
+	if flags&frameFlagTouches != 0 {
+		p.putUvarint(uint64(len(frame.Touches)))
+		for _, t := range frame.Touches {
+			p.putVarint(t.Id)
+			p.putFloat(t.X)
+			p.putFloat(t.Y)
+		}
+	}
+	p.frames++
+	if p.frames%flushInterval == 0 {
+		return p.flush()
+	}
+	return nil
+}
+
+// Close flushes the pending frames and closes the file.
+func (p *Writer) Close() error {
+	err := p.w.Flush()
+	if e := p.gz.Close(); err == nil {
+		err = e
+	}
+	if e := p.file.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+// -----------------------------------------------------------------------------
+
+// Reader reads a recording from a file.
+type Reader struct {
+	file   *os.File
+	gz     *gzip.Reader
+	r      *bufio.Reader
+	seed   int64
+	mouseX float64
+	mouseY float64
+
+	buttons  int64
+	gamepads []Gamepad
+	touches  []Touch
+}
+
+// Open opens the recording file path.
+func Open(path string) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFormat, err)
+	}
+	p := &Reader{file: f, gz: gz, r: bufio.NewReader(gz)}
+	head := make([]byte, len(magic)+1)
+	if _, err = io.ReadFull(p.r, head); err != nil || string(head[:len(magic)]) != magic {
+		p.Close()
+		return nil, ErrInvalidFormat
+	}
+	if v := head[len(magic)]; v < 1 || v > version {
+		p.Close()
+		return nil, fmt.Errorf("record: unsupported spxrec version %d", head[len(magic)])
+	}
+	if p.seed, err = binary.ReadVarint(p.r); err != nil {
+		p.Close()
+		return nil, ErrInvalidFormat
+	}
+	return p, nil
+}
+
+// Seed returns the random seed of the recorded run.
+func (p *Reader) Seed() int64 {
+	return p.seed
+}
+
+func (p *Reader) getFloat() (float64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(p.r, b[:]); err != nil {
+		return 0, err
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b[:])), nil
+}
+
+// ReadFrame reads the next frame into frame, reusing its event slice.
+// It returns io.EOF at the end of the recording. A truncated last frame, as
+// left by a crashed run, is reported as io.EOF too.
+func (p *Reader) ReadFrame(frame *Frame) (err error) {
+	defer func() {
+		if err == io.ErrUnexpectedEOF {
+			err = io.EOF
+		}
+	}()
+	flags, err := p.r.ReadByte()
+	if err != nil {
+		return
+	}
+	if frame.Delta, err = p.getFloat(); err != nil {
+		return
+	}
+	if frame.UnscaledDelta, err = p.getFloat(); err != nil {
+		return
+	}
+	if flags&frameFlagMouse != 0 {
+		if p.mouseX, err = p.getFloat(); err != nil {
+			return
+		}
+		if p.mouseY, err = p.getFloat(); err != nil {
+			return
+		}
+	}
+	frame.MouseX, frame.MouseY = p.mouseX, p.mouseY
+	frame.Events = frame.Events[:0]
+	if flags&frameFlagEvents != 0 {
+		if err = p.readEvents(frame); err != nil {
+			return
+		}
+	}
+	if flags&frameFlagButtons != 0 {
+		if p.buttons, err = binary.ReadVarint(p.r); err != nil {
+			return
+		}
+	}
+	frame.MouseButtons = p.buttons
+	frame.WheelX, frame.WheelY = 0, 0
+	if flags&frameFlagWheel != 0 {
+		if frame.WheelX, err = p.getFloat(); err != nil {
+			return
+		}
+		if frame.WheelY, err = p.getFloat(); err != nil {
+			return
+		}
+	}
+	if flags&frameFlagGamepads != 0 {
+		if err = p.readGamepads(); err != nil {
+			return
+		}
+	}
+	frame.Gamepads = append(frame.Gamepads[:0], p.gamepads...)
+	if flags&frameFlagTouches != 0 {
+		if err = p.readTouches(); err != nil {
+			return
+		}
+	}
+	frame.Touches = append(frame.Touches[:0], p.touches...)
+	return nil
+}
+
+func (p *Reader) readEvents(frame *Frame) (err error) {
+	n, err := binary.ReadUvarint(p.r)
+	if err != nil {
+		return
+	}
+	for i := uint64(0); i < n; i++ {
+		var kind byte
+		if kind, err = p.r.ReadByte(); err != nil {
+			return
+		}
+		ev := Event{Kind: EventKind(kind)}
+		switch ev.Kind {
+		case EventKeyDown, EventKeyUp:
+			if ev.Key, err = binary.ReadVarint(p.r); err != nil {
+				return
+			}
+		case EventMouseDown, EventMouseUp:
+			if ev.X, err = p.getFloat(); err != nil {
+				return
+			}
+			if ev.Y, err = p.getFloat(); err != nil {
+				return
+			}
+		default:
+			return ErrInvalidFormat
+		}
+		frame.Events = append(frame.Events, ev)
+	}
+	return nil
+}
+
+func (p *Reader) readGamepads() (err error) {
+	n, err := binary.ReadUvarint(p.r)
+	if err != nil {
+		return
+	}
+	p.gamepads = p.gamepads[:0]
+	for i := uint64(0); i < n; i++ {
+		var pad Gamepad
+		if pad.Id, err = binary.ReadVarint(p.r); err != nil {
+			return
+		}
+		if pad.Buttons, err = binary.ReadVarint(p.r); err != nil {
+			return
+		}
+		for j := range pad.Axes {
+			if pad.Axes[j], err = p.getFloat(); err != nil {
+				return
+			}
+		}
+		p.gamepads = append(p.gamepads, pad)
+	}
+	return nil
+}
+
+func (p *Reader) readTouches() (err error) {
+	n, err := binary.ReadUvarint(p.r)
+	if err != nil {
+		return
+	}
+	p.touches = p.touches[:0]
+	for i := uint64(0); i < n; i++ {
+		var t Touch
+		if t.Id, err = binary.ReadVarint(p.r); err != nil {
+			return
+		}
+		if t.X, err = p.getFloat(); err != nil {
+			return
+		}
+		if t.Y, err = p.getFloat(); err != nil {
+			return
+		}
+		p.touches = append(p.touches, t)
+	}
+	return nil
+}
+
+// Close closes the file.
+func (p *Reader) Close() error {
+	p.gz.Close()
+	return p.file.Close()
+}
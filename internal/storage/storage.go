@@ -0,0 +1,34 @@
+// Package storage keeps the save data of a game across runs. The data is
+// organized in named slots, each holding one opaque blob:
+//
+//   - desktop: a file per slot in the persistent data directory
+//   - web: a localStorage item per slot
+//   - headless engine: an in-memory map, which lasts as long as the process
+package storage
+
+import (
+	"errors"
+	"regexp"
+)
+
+var ErrInvalidSlot = errors.New("storage: invalid slot name")
+
+var slotNameRE = regexp.MustCompile(`^[A-Za-z0-9_\-.]{1,64}$`)
+
+// ValidSlot reports whether name can be used as a slot name: 1 to 64 letters,
+// digits, '_', '-' or '.', not starting with '.'.
+func ValidSlot(name string) bool {
+	return slotNameRE.MatchString(name) && name[0] != '.'
+}
+
+// Store stores the slots of a game.
+type Store interface {
+	// Read returns the blob of slot, or nil if the slot doesn't exist.
+	Read(slot string) ([]byte, error)
+	// Write replaces the blob of slot.
+	Write(slot string, data []byte) error
+	// Delete removes slot. Deleting a missing slot is not an error.
+	Delete(slot string) error
+	// Slots returns the names of the existing slots, sorted.
+	Slots() ([]string, error)
+}
@@ -0,0 +1,93 @@
+//go:build !js && !pure_engine
+// +build !js,!pure_engine
+
+package storage
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const slotExt = ".json"
+
+type fileStore struct {
+	dir string
+}
+
+// Open opens the store kept in the saves folder of dir, the persistent data
+// directory of the game. The folder is created on the first write.
+func Open(dir string) Store {
+	return &fileStore{dir: filepath.Join(dir, "saves")}
+}
+
+func (p *fileStore) path(slot string) (string, error) {
+	if !ValidSlot(slot) {
+		return "", ErrInvalidSlot
+	}
+	return filepath.Join(p.dir, slot+slotExt), nil
+}
+
+func (p *fileStore) Read(slot string) ([]byte, error) {
+	path, err := p.path(slot)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// Write writes to a temporary file first, so a crash never leaves a slot half
+// written.
+func (p *fileStore) Write(slot string, data []byte) error {
+	path, err := p.path(slot)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(p.dir, 0755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (p *fileStore) Delete(slot string) error {
+	path, err := p.path(slot)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (p *fileStore) Slots() ([]string, error) {
+	entries, err := os.ReadDir(p.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var slots []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.Type().IsRegular() && strings.HasSuffix(name, slotExt) {
+			if slot := strings.TrimSuffix(name, slotExt); ValidSlot(slot) {
+				slots = append(slots, slot)
+			}
+		}
+	}
+	sort.Strings(slots)
+	return slots, nil
+}
@@ -0,0 +1,92 @@
+//go:build js && !pure_engine
+// +build js,!pure_engine
+
+package storage
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"syscall/js"
+)
+
+const keyPrefix = "spx.saves/"
+
+type webStore struct {
+	prefix string
+}
+
+// Open opens the store kept in the localStorage of the page. Each game gets
+// its own items, namespaced by dir.
+func Open(dir string) Store {
+	return &webStore{prefix: keyPrefix + dir + "/"}
+}
+
+func localStorage() (js.Value, error) {
+	ls := js.Global().Get("localStorage")
+	if ls.IsUndefined() || ls.IsNull() {
+		return js.Value{}, errors.New("storage: localStorage is not available")
+	}
+	return ls, nil
+}
+
+func (p *webStore) Read(slot string) (data []byte, err error) {
+	if !ValidSlot(slot) {
+		return nil, ErrInvalidSlot
+	}
+	ls, err := localStorage()
+	if err != nil {
+		return
+	}
+	v := ls.Call("getItem", p.prefix+slot)
+	if v.IsNull() {
+		return nil, nil
+	}
+	return []byte(v.String()), nil
+}
+
+func (p *webStore) Write(slot string, data []byte) (err error) {
+	if !ValidSlot(slot) {
+		return ErrInvalidSlot
+	}
+	ls, err := localStorage()
+	if err != nil {
+		return
+	}
+	defer func() {
+		// setItem throws when the quota is exceeded
+		if e := recover(); e != nil {
+			err = errors.New("storage: failed to write slot " + slot)
+		}
+	}()
+	ls.Call("setItem", p.prefix+slot, string(data))
+	return nil
+}
+
+func (p *webStore) Delete(slot string) error {
+	if !ValidSlot(slot) {
+		return ErrInvalidSlot
+	}
+	ls, err := localStorage()
+	if err != nil {
+		return err
+	}
+	ls.Call("removeItem", p.prefix+slot)
+	return nil
+}
+
+func (p *webStore) Slots() ([]string, error) {
+	ls, err := localStorage()
+	if err != nil {
+		return nil, err
+	}
+	var slots []string
+	for i, n := 0, ls.Get("length").Int(); i < n; i++ {
+		key := ls.Call("key", i).String()
+		if slot, ok := strings.CutPrefix(key, p.prefix); ok && ValidSlot(slot) {
+			slots = append(slots, slot)
+		}
+	}
+	sort.Strings(slots)
+	return slots, nil
+}
@@ -0,0 +1,71 @@
+//go:build pure_engine
+// +build pure_engine
+
+package storage
+
+import (
+	"sort"
+	"sync"
+)
+
+var (
+	memMu     sync.Mutex
+	memStores = make(map[string]map[string][]byte)
+)
+
+type memStore struct {
+	slots map[string][]byte
+}
+
+// Open opens the in-memory store of dir. The data lasts as long as the
+// process, so a game reloaded in the same process finds its saves back.
+func Open(dir string) Store {
+	memMu.Lock()
+	defer memMu.Unlock()
+	slots, ok := memStores[dir]
+	if !ok {
+		slots = make(map[string][]byte)
+		memStores[dir] = slots
+	}
+	return &memStore{slots: slots}
+}
+
+func (p *memStore) Read(slot string) ([]byte, error) {
+	if !ValidSlot(slot) {
+		return nil, ErrInvalidSlot
+	}
+	memMu.Lock()
+	defer memMu.Unlock()
+	return p.slots[slot], nil
+}
+
+func (p *memStore) Write(slot string, data []byte) error {
+	if !ValidSlot(slot) {
+		return ErrInvalidSlot
+	}
+	memMu.Lock()
+	defer memMu.Unlock()
+	p.slots[slot] = append([]byte(nil), data...)
+	return nil
+}
+
+func (p *memStore) Delete(slot string) error {
+	if !ValidSlot(slot) {
+		return ErrInvalidSlot
+	}
+	memMu.Lock()
+	defer memMu.Unlock()
+	delete(p.slots, slot)
+	return nil
+}
+
+func (p *memStore) Slots() ([]string, error) {
+	memMu.Lock()
+	defer memMu.Unlock()
+	slots := make([]string, 0, len(p.slots))
+	for slot := range p.slots {
+		slots = append(slots, slot)
+	}
+	sort.Strings(slots)
+	return slots, nil
+}
@@ -18,6 +18,17 @@ func ResetTimer() {
 	nextTimerIndex = 0
 }
 
+// SetTimer sets the game timer to timer. The timer events after it will fire
+// again.
+func SetTimer(timer float64) {
+	gameTimer = timer
+	now := int64(timer * TIME_PERCISION)
+	nextTimerIndex = 0
+	for nextTimerIndex < len(timestamps) && timestamps[nextTimerIndex] <= now {
+		nextTimerIndex++
+	}
+}
+
 func OnReload() {
 	ResetTimer()
 	timestamps = timestamps[:0]
@@ -0,0 +1,130 @@
+// Package watch reports the changes to the files of a directory tree. It polls
+// the tree instead of relying on the notifications of the OS, which differ
+// from one platform to another and miss the changes of some editors that
+// replace the files they save.
+package watch
+
+import (
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultInterval is the polling interval used when none is given.
+const DefaultInterval = 300 * time.Millisecond
+
+type fileStat struct {
+	size    int64
+	modTime time.Time
+}
+
+// Watcher polls a directory tree for changes.
+type Watcher struct {
+	root     string
+	interval time.Duration
+	skip     func(rel string, dir bool) bool
+
+	files   map[string]fileStat
+	pending map[string]bool // changed during the last poll, reported when stable
+	stop    chan struct{}
+}
+
+// New returns a watcher of the tree rooted at root. Files and directories for
+// which skip returns true are ignored, as well as the hidden ones. The paths
+// passed to skip are relative to root, with slashes.
+func New(root string, interval time.Duration, skip func(rel string, dir bool) bool) *Watcher {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Watcher{root: root, interval: interval, skip: skip}
+}
+
+// Start takes the initial state of the tree and calls onChange from another
+// goroutine each time files are added, modified or removed, with their paths
+// relative to root, sorted. A file is reported once it stopped changing for
+// an interval, so that a file being written is reported when complete.
+func (p *Watcher) Start(onChange func(files []string)) {
+	p.files = p.scan()
+	p.pending = make(map[string]bool)
+	stop := make(chan struct{})
+	p.stop = stop
+	go func() {
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if files := p.poll(); len(files) > 0 {
+					onChange(files)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops watching.
+func (p *Watcher) Stop() {
+	if p.stop != nil {
+		close(p.stop)
+		p.stop = nil
+	}
+}
+
+// poll compares the tree with its last state. It returns the files which
+// changed during the previous poll but not since.
+func (p *Watcher) poll() (files []string) {
+	cur := p.scan()
+	changed := make(map[string]bool)
+	for rel, st := range cur {
+		if old, ok := p.files[rel]; !ok || old != st {
+			changed[rel] = true
+		}
+	}
+	for rel := range p.files {
+		if _, ok := cur[rel]; !ok {
+			changed[rel] = true
+		}
+	}
+	p.files = cur
+
+	for rel := range p.pending {
+		if !changed[rel] {
+			files = append(files, rel)
+		}
+	}
+	sort.Strings(files)
+	p.pending = changed
+	return
+}
+
+func (p *Watcher) scan() map[string]fileStat {
+	files := make(map[string]fileStat)
+	filepath.WalkDir(p.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || path == p.root {
+			return nil
+		}
+		rel, err := filepath.Rel(p.root, path)
+		if err != nil {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if strings.HasPrefix(d.Name(), ".") || (p.skip != nil && p.skip(rel, d.IsDir())) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if fi, err := d.Info(); err == nil {
+			files[rel] = fileStat{size: fi.Size(), modTime: fi.ModTime()}
+		}
+		return nil
+	})
+	return files
+}
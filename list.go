@@ -18,7 +18,6 @@ package spx
 
 import (
 	"fmt"
-	"math/rand"
 	"reflect"
 	"strconv"
 	"strings"
@@ -215,7 +214,7 @@ func getListPos(i Pos, n int) int {
 		if n == 0 {
 			return 0
 		}
-		return int(rand.Int31n(int32(n)))
+		return curRand().Intn(n)
 	}
 	return int(i)
 }
@@ -26,12 +26,14 @@ import (
 )
 
 const (
-	physicsColliderNone    = 0x00
-	physicsColliderAuto    = 0x01
-	physicsColliderCircle  = 0x02
-	physicsColliderRect    = 0x03
-	physicsColliderCapsule = 0x04
-	physicsColliderPolygon = 0x05
+	physicsColliderNone     = 0x00
+	physicsColliderAuto     = 0x01
+	physicsColliderCircle   = 0x02
+	physicsColliderRect     = 0x03
+	physicsColliderCapsule  = 0x04
+	physicsColliderPolygon  = 0x05
+	physicsColliderCompound = 0x06
+	physicsColliderOutline  = 0x07
 )
 
 type rayCastResult struct {
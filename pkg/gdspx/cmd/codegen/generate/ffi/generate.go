@@ -405,56 +405,12 @@ func GetGdxFuncParamTypeString(typeName string) string {
 	}
 	return name
 }
-func genSyncPureApiWrapFunction(function *clang.TypedefFunction) string {
-	/*
-		func syncUiGetFlip(obj Object, horizontal bool) bool {
-			var _ret1 bool
-			WaitMainThread(func() {
-				_ret1 = UiMgr.GetFlip(obj, horizontal)
-			})
-			return _ret1
-		}
-	*/
 
-	prefix := "GDExtensionSpx"
-	sb := strings.Builder{}
-	mgrName := strcase.ToCamel(GetManagerName(function.Name))
-	pureFuncName := function.Name[len(prefix)+len(mgrName):]
-	//funcName := function.Name[len(prefix):]
-	mgrTypeName := strcase.ToLowerCamel(GetManagerName(function.Name)) + "Mgr"
-
-	sb.WriteString(fmt.Sprintf("func (pself *%s) ", mgrTypeName+"Impl"))
-	sb.WriteString(pureFuncName)
-	sb.WriteString("(")
-	count := len(function.Arguments)
-	for i, arg := range function.Arguments {
-		sb.WriteString(arg.Name)
-		sb.WriteString(" ")
-		typeName := GetGdxFuncParamTypeString(arg.Type.Primative.Name)
-		sb.WriteString(typeName)
-		if i != count-1 {
-			sb.WriteString(", ")
-		}
-	}
-	sb.WriteString(")")
-
-	if function.ReturnType.Name != "void" {
-		typeName := GetGdxFuncParamTypeString(function.ReturnType.Name)
-		sb.WriteString(" " + typeName)
-	}
-	sb.WriteString(" {")
-	prefixStr := "\t"
-	// body
-	if function.ReturnType.Name != "void" {
-		typeName := GetGdxFuncParamTypeString(function.ReturnType.Name)
-		sb.WriteString("\n" + prefixStr + "var _ret1 " + typeName + "\n")
-	}
-
-	if function.ReturnType.Name != "void" {
-		sb.WriteString(prefixStr + "return _ret1 \n")
-	}
-	sb.WriteString("}")
-	return sb.String()
+// genSyncPureApiWrapFunction wraps the managers of pure engine builds. The
+// headless backend implements every manager, so the wrappers are the same as
+// the ones of the Godot runtime.
+func genSyncPureApiWrapFunction(function *clang.TypedefFunction) string {
+	return genSyncApiWrapFunction(function)
 }
 func genSyncApiWrapFunction(function *clang.TypedefFunction) string {
 	/*
@@ -542,40 +498,9 @@ func (arr ByName) Less(i, j int) bool {
 	return arr[i].Name < arr[j].Name
 }
 func getManagerImplPure(function *clang.TypedefFunction, clsName string) string {
-	prefix := "GDExtensionSpx"
-	sb := strings.Builder{}
-	lowcaseMgr := GetManagerName(function.Name)
-	mgrName := string(unicode.ToUpper(rune(lowcaseMgr[0]))) + lowcaseMgr[1:]
-	funcName := function.Name[len(prefix)+len(mgrName):]
-	sb.WriteString("func (pself *" + clsName + ") " + funcName + "(")
-	count := len(function.Arguments)
-	for i, arg := range function.Arguments {
-		if i == 0 && arg.Name == "obj" {
-			continue
-		}
-		sb.WriteString(arg.Name)
-		sb.WriteString(" ")
-		typeName := GetFuncParamTypeString(arg.Type.Primative.Name)
-		sb.WriteString(typeName)
-		if i != count-1 {
-			sb.WriteString(", ")
-		}
-	}
-	sb.WriteString(") ")
-	anyRet := function.ReturnType.Name != "void"
-	if anyRet {
-		typeName := GetFuncParamTypeString(function.ReturnType.Name)
-		sb.WriteString(typeName + " ")
-	}
-	sb.WriteString("{\n")
-	if anyRet {
-		typeName := GetFuncParamTypeString(function.ReturnType.Name)
-		sb.WriteString("\tvar _val " + typeName + " \n")
-		sb.WriteString("\treturn _val;\n")
-	}
-	sb.WriteString("}\n")
-	return sb.String()
+	return getManagerImpl(function, clsName)
 }
+
 func getManagerImpl(function *clang.TypedefFunction, clsName string) string {
 	prefix := "GDExtensionSpx"
 	sb := strings.Builder{}
@@ -111,7 +111,12 @@ func onAxisChanged(name string, value float64) {
 
 // physic
 func onCollisionEnter(id int64, oid int64) {
-	spxlog.Debug("OnCollisionEnter %d %d", id, oid)
+	if sprite, ok := Id2Sprites[Object(id)]; ok {
+		if other, ok2 := Id2Sprites[Object(oid)]; ok2 {
+			sprite.V_OnCollisionEnter(other)
+			sprite.OnCollisionEnter(other)
+		}
+	}
 }
 func onCollisionStay(id int64, oid int64) {
 	spxlog.Debug("OnCollisionStay %d %d", id, oid)
@@ -65,6 +65,13 @@ type GDExtensionInterface struct {
 	SpxInputIsActionPressed                  GDExtensionSpxInputIsActionPressed
 	SpxInputIsActionJustPressed              GDExtensionSpxInputIsActionJustPressed
 	SpxInputIsActionJustReleased             GDExtensionSpxInputIsActionJustReleased
+	SpxInputGetConnectedGamepads             GDExtensionSpxInputGetConnectedGamepads
+	SpxInputGetGamepadButtons                GDExtensionSpxInputGetGamepadButtons
+	SpxInputGetGamepadAxis                   GDExtensionSpxInputGetGamepadAxis
+	SpxInputGetTouchCount                    GDExtensionSpxInputGetTouchCount
+	SpxInputGetTouchId                       GDExtensionSpxInputGetTouchId
+	SpxInputGetTouchPos                      GDExtensionSpxInputGetTouchPos
+	SpxInputGetMouseWheel                    GDExtensionSpxInputGetMouseWheel
 	SpxNavigationSetupPathFinderWithSize     GDExtensionSpxNavigationSetupPathFinderWithSize
 	SpxNavigationSetupPathFinder             GDExtensionSpxNavigationSetupPathFinder
 	SpxNavigationSetObstacle                 GDExtensionSpxNavigationSetObstacle
@@ -118,6 +125,7 @@ type GDExtensionInterface struct {
 	SpxResSetLoadMode                        GDExtensionSpxResSetLoadMode
 	SpxResGetLoadMode                        GDExtensionSpxResGetLoadMode
 	SpxResGetBoundFromAlpha                  GDExtensionSpxResGetBoundFromAlpha
+	SpxResGetPolygonFromAlpha                GDExtensionSpxResGetPolygonFromAlpha
 	SpxResGetImageSize                       GDExtensionSpxResGetImageSize
 	SpxResReadAllText                        GDExtensionSpxResReadAllText
 	SpxResHasFile                            GDExtensionSpxResHasFile
@@ -242,11 +250,15 @@ type GDExtensionInterface struct {
 	SpxSpriteSetColliderRect                 GDExtensionSpxSpriteSetColliderRect
 	SpxSpriteSetColliderCircle               GDExtensionSpxSpriteSetColliderCircle
 	SpxSpriteSetColliderCapsule              GDExtensionSpxSpriteSetColliderCapsule
+	SpxSpriteSetColliderPolygon              GDExtensionSpxSpriteSetColliderPolygon
+	SpxSpriteAddColliderShape                GDExtensionSpxSpriteAddColliderShape
 	SpxSpriteSetCollisionEnabled             GDExtensionSpxSpriteSetCollisionEnabled
 	SpxSpriteIsCollisionEnabled              GDExtensionSpxSpriteIsCollisionEnabled
 	SpxSpriteSetTriggerRect                  GDExtensionSpxSpriteSetTriggerRect
 	SpxSpriteSetTriggerCircle                GDExtensionSpxSpriteSetTriggerCircle
 	SpxSpriteSetTriggerCapsule               GDExtensionSpxSpriteSetTriggerCapsule
+	SpxSpriteSetTriggerPolygon               GDExtensionSpxSpriteSetTriggerPolygon
+	SpxSpriteAddTriggerShape                 GDExtensionSpxSpriteAddTriggerShape
 	SpxSpriteSetTriggerEnabled               GDExtensionSpxSpriteSetTriggerEnabled
 	SpxSpriteIsTriggerEnabled                GDExtensionSpxSpriteIsTriggerEnabled
 	SpxSpriteCheckCollisionByColor           GDExtensionSpxSpriteCheckCollisionByColor
@@ -361,6 +373,13 @@ func (x *GDExtensionInterface) loadProcAddresses() {
 	x.SpxInputIsActionPressed = (GDExtensionSpxInputIsActionPressed)(dlsymGD("spx_input_is_action_pressed"))
 	x.SpxInputIsActionJustPressed = (GDExtensionSpxInputIsActionJustPressed)(dlsymGD("spx_input_is_action_just_pressed"))
 	x.SpxInputIsActionJustReleased = (GDExtensionSpxInputIsActionJustReleased)(dlsymGD("spx_input_is_action_just_released"))
+	x.SpxInputGetConnectedGamepads = (GDExtensionSpxInputGetConnectedGamepads)(dlsymGD("spx_input_get_connected_gamepads"))
+	x.SpxInputGetGamepadButtons = (GDExtensionSpxInputGetGamepadButtons)(dlsymGD("spx_input_get_gamepad_buttons"))
+	x.SpxInputGetGamepadAxis = (GDExtensionSpxInputGetGamepadAxis)(dlsymGD("spx_input_get_gamepad_axis"))
+	x.SpxInputGetTouchCount = (GDExtensionSpxInputGetTouchCount)(dlsymGD("spx_input_get_touch_count"))
+	x.SpxInputGetTouchId = (GDExtensionSpxInputGetTouchId)(dlsymGD("spx_input_get_touch_id"))
+	x.SpxInputGetTouchPos = (GDExtensionSpxInputGetTouchPos)(dlsymGD("spx_input_get_touch_pos"))
+	x.SpxInputGetMouseWheel = (GDExtensionSpxInputGetMouseWheel)(dlsymGD("spx_input_get_mouse_wheel"))
 	x.SpxNavigationSetupPathFinderWithSize = (GDExtensionSpxNavigationSetupPathFinderWithSize)(dlsymGD("spx_navigation_setup_path_finder_with_size"))
 	x.SpxNavigationSetupPathFinder = (GDExtensionSpxNavigationSetupPathFinder)(dlsymGD("spx_navigation_setup_path_finder"))
 	x.SpxNavigationSetObstacle = (GDExtensionSpxNavigationSetObstacle)(dlsymGD("spx_navigation_set_obstacle"))
@@ -414,6 +433,7 @@ func (x *GDExtensionInterface) loadProcAddresses() {
 	x.SpxResSetLoadMode = (GDExtensionSpxResSetLoadMode)(dlsymGD("spx_res_set_load_mode"))
 	x.SpxResGetLoadMode = (GDExtensionSpxResGetLoadMode)(dlsymGD("spx_res_get_load_mode"))
 	x.SpxResGetBoundFromAlpha = (GDExtensionSpxResGetBoundFromAlpha)(dlsymGD("spx_res_get_bound_from_alpha"))
+	x.SpxResGetPolygonFromAlpha = (GDExtensionSpxResGetPolygonFromAlpha)(dlsymGD("spx_res_get_polygon_from_alpha"))
 	x.SpxResGetImageSize = (GDExtensionSpxResGetImageSize)(dlsymGD("spx_res_get_image_size"))
 	x.SpxResReadAllText = (GDExtensionSpxResReadAllText)(dlsymGD("spx_res_read_all_text"))
 	x.SpxResHasFile = (GDExtensionSpxResHasFile)(dlsymGD("spx_res_has_file"))
@@ -538,11 +558,15 @@ func (x *GDExtensionInterface) loadProcAddresses() {
 	x.SpxSpriteSetColliderRect = (GDExtensionSpxSpriteSetColliderRect)(dlsymGD("spx_sprite_set_collider_rect"))
 	x.SpxSpriteSetColliderCircle = (GDExtensionSpxSpriteSetColliderCircle)(dlsymGD("spx_sprite_set_collider_circle"))
 	x.SpxSpriteSetColliderCapsule = (GDExtensionSpxSpriteSetColliderCapsule)(dlsymGD("spx_sprite_set_collider_capsule"))
+	x.SpxSpriteSetColliderPolygon = (GDExtensionSpxSpriteSetColliderPolygon)(dlsymGD("spx_sprite_set_collider_polygon"))
+	x.SpxSpriteAddColliderShape = (GDExtensionSpxSpriteAddColliderShape)(dlsymGD("spx_sprite_add_collider_shape"))
 	x.SpxSpriteSetCollisionEnabled = (GDExtensionSpxSpriteSetCollisionEnabled)(dlsymGD("spx_sprite_set_collision_enabled"))
 	x.SpxSpriteIsCollisionEnabled = (GDExtensionSpxSpriteIsCollisionEnabled)(dlsymGD("spx_sprite_is_collision_enabled"))
 	x.SpxSpriteSetTriggerRect = (GDExtensionSpxSpriteSetTriggerRect)(dlsymGD("spx_sprite_set_trigger_rect"))
 	x.SpxSpriteSetTriggerCircle = (GDExtensionSpxSpriteSetTriggerCircle)(dlsymGD("spx_sprite_set_trigger_circle"))
 	x.SpxSpriteSetTriggerCapsule = (GDExtensionSpxSpriteSetTriggerCapsule)(dlsymGD("spx_sprite_set_trigger_capsule"))
+	x.SpxSpriteSetTriggerPolygon = (GDExtensionSpxSpriteSetTriggerPolygon)(dlsymGD("spx_sprite_set_trigger_polygon"))
+	x.SpxSpriteAddTriggerShape = (GDExtensionSpxSpriteAddTriggerShape)(dlsymGD("spx_sprite_add_trigger_shape"))
 	x.SpxSpriteSetTriggerEnabled = (GDExtensionSpxSpriteSetTriggerEnabled)(dlsymGD("spx_sprite_set_trigger_enabled"))
 	x.SpxSpriteIsTriggerEnabled = (GDExtensionSpxSpriteIsTriggerEnabled)(dlsymGD("spx_sprite_is_trigger_enabled"))
 	x.SpxSpriteCheckCollisionByColor = (GDExtensionSpxSpriteCheckCollisionByColor)(dlsymGD("spx_sprite_check_collision_by_color"))
@@ -132,6 +132,13 @@ type GDExtensionSpxInputGetAxis C.GDExtensionSpxInputGetAxis
 type GDExtensionSpxInputIsActionPressed C.GDExtensionSpxInputIsActionPressed
 type GDExtensionSpxInputIsActionJustPressed C.GDExtensionSpxInputIsActionJustPressed
 type GDExtensionSpxInputIsActionJustReleased C.GDExtensionSpxInputIsActionJustReleased
+type GDExtensionSpxInputGetConnectedGamepads C.GDExtensionSpxInputGetConnectedGamepads
+type GDExtensionSpxInputGetGamepadButtons C.GDExtensionSpxInputGetGamepadButtons
+type GDExtensionSpxInputGetGamepadAxis C.GDExtensionSpxInputGetGamepadAxis
+type GDExtensionSpxInputGetTouchCount C.GDExtensionSpxInputGetTouchCount
+type GDExtensionSpxInputGetTouchId C.GDExtensionSpxInputGetTouchId
+type GDExtensionSpxInputGetTouchPos C.GDExtensionSpxInputGetTouchPos
+type GDExtensionSpxInputGetMouseWheel C.GDExtensionSpxInputGetMouseWheel
 type GDExtensionSpxNavigationSetupPathFinderWithSize C.GDExtensionSpxNavigationSetupPathFinderWithSize
 type GDExtensionSpxNavigationSetupPathFinder C.GDExtensionSpxNavigationSetupPathFinder
 type GDExtensionSpxNavigationSetObstacle C.GDExtensionSpxNavigationSetObstacle
@@ -185,6 +192,7 @@ type GDExtensionSpxResCreateAnimation C.GDExtensionSpxResCreateAnimation
 type GDExtensionSpxResSetLoadMode C.GDExtensionSpxResSetLoadMode
 type GDExtensionSpxResGetLoadMode C.GDExtensionSpxResGetLoadMode
 type GDExtensionSpxResGetBoundFromAlpha C.GDExtensionSpxResGetBoundFromAlpha
+type GDExtensionSpxResGetPolygonFromAlpha C.GDExtensionSpxResGetPolygonFromAlpha
 type GDExtensionSpxResGetImageSize C.GDExtensionSpxResGetImageSize
 type GDExtensionSpxResReadAllText C.GDExtensionSpxResReadAllText
 type GDExtensionSpxResHasFile C.GDExtensionSpxResHasFile
@@ -309,11 +317,15 @@ type GDExtensionSpxSpriteGetTriggerMask C.GDExtensionSpxSpriteGetTriggerMask
 type GDExtensionSpxSpriteSetColliderRect C.GDExtensionSpxSpriteSetColliderRect
 type GDExtensionSpxSpriteSetColliderCircle C.GDExtensionSpxSpriteSetColliderCircle
 type GDExtensionSpxSpriteSetColliderCapsule C.GDExtensionSpxSpriteSetColliderCapsule
+type GDExtensionSpxSpriteSetColliderPolygon C.GDExtensionSpxSpriteSetColliderPolygon
+type GDExtensionSpxSpriteAddColliderShape C.GDExtensionSpxSpriteAddColliderShape
 type GDExtensionSpxSpriteSetCollisionEnabled C.GDExtensionSpxSpriteSetCollisionEnabled
 type GDExtensionSpxSpriteIsCollisionEnabled C.GDExtensionSpxSpriteIsCollisionEnabled
 type GDExtensionSpxSpriteSetTriggerRect C.GDExtensionSpxSpriteSetTriggerRect
 type GDExtensionSpxSpriteSetTriggerCircle C.GDExtensionSpxSpriteSetTriggerCircle
 type GDExtensionSpxSpriteSetTriggerCapsule C.GDExtensionSpxSpriteSetTriggerCapsule
+type GDExtensionSpxSpriteSetTriggerPolygon C.GDExtensionSpxSpriteSetTriggerPolygon
+type GDExtensionSpxSpriteAddTriggerShape C.GDExtensionSpxSpriteAddTriggerShape
 type GDExtensionSpxSpriteSetTriggerEnabled C.GDExtensionSpxSpriteSetTriggerEnabled
 type GDExtensionSpxSpriteIsTriggerEnabled C.GDExtensionSpxSpriteIsTriggerEnabled
 type GDExtensionSpxSpriteCheckCollisionByColor C.GDExtensionSpxSpriteCheckCollisionByColor
@@ -813,6 +825,66 @@ func CallInputIsActionJustReleased(
 
 	return (GdBool)(ret_val)
 }
+func CallInputGetConnectedGamepads() GdInt {
+	arg0 := (C.GDExtensionSpxInputGetConnectedGamepads)(api.SpxInputGetConnectedGamepads)
+	var ret_val C.GdInt
+	C.cgo_callfn_GDExtensionSpxInputGetConnectedGamepads(arg0, &ret_val)
+	return (GdInt)(ret_val)
+}
+func CallInputGetGamepadButtons(
+	device GdInt,
+) GdInt {
+	arg0 := (C.GDExtensionSpxInputGetGamepadButtons)(api.SpxInputGetGamepadButtons)
+	arg1GdInt := (C.GdInt)(device)
+	var ret_val C.GdInt
+	C.cgo_callfn_GDExtensionSpxInputGetGamepadButtons(arg0, arg1GdInt, &ret_val)
+
+	return (GdInt)(ret_val)
+}
+func CallInputGetGamepadAxis(
+	device GdInt,
+	axis GdInt,
+) GdFloat {
+	arg0 := (C.GDExtensionSpxInputGetGamepadAxis)(api.SpxInputGetGamepadAxis)
+	arg1GdInt := (C.GdInt)(device)
+	arg2GdInt := (C.GdInt)(axis)
+	var ret_val C.GdFloat
+	C.cgo_callfn_GDExtensionSpxInputGetGamepadAxis(arg0, arg1GdInt, arg2GdInt, &ret_val)
+
+	return (GdFloat)(ret_val)
+}
+func CallInputGetTouchCount() GdInt {
+	arg0 := (C.GDExtensionSpxInputGetTouchCount)(api.SpxInputGetTouchCount)
+	var ret_val C.GdInt
+	C.cgo_callfn_GDExtensionSpxInputGetTouchCount(arg0, &ret_val)
+	return (GdInt)(ret_val)
+}
+func CallInputGetTouchId(
+	index GdInt,
+) GdInt {
+	arg0 := (C.GDExtensionSpxInputGetTouchId)(api.SpxInputGetTouchId)
+	arg1GdInt := (C.GdInt)(index)
+	var ret_val C.GdInt
+	C.cgo_callfn_GDExtensionSpxInputGetTouchId(arg0, arg1GdInt, &ret_val)
+
+	return (GdInt)(ret_val)
+}
+func CallInputGetTouchPos(
+	index GdInt,
+) GdVec2 {
+	arg0 := (C.GDExtensionSpxInputGetTouchPos)(api.SpxInputGetTouchPos)
+	arg1GdInt := (C.GdInt)(index)
+	var ret_val C.GdVec2
+	C.cgo_callfn_GDExtensionSpxInputGetTouchPos(arg0, arg1GdInt, &ret_val)
+
+	return (GdVec2)(ret_val)
+}
+func CallInputGetMouseWheel() GdVec2 {
+	arg0 := (C.GDExtensionSpxInputGetMouseWheel)(api.SpxInputGetMouseWheel)
+	var ret_val C.GdVec2
+	C.cgo_callfn_GDExtensionSpxInputGetMouseWheel(arg0, &ret_val)
+	return (GdVec2)(ret_val)
+}
 func CallNavigationSetupPathFinderWithSize(
 	grid_size GdVec2,
 	cell_size GdVec2,
@@ -1338,6 +1410,16 @@ func CallResGetBoundFromAlpha(
 
 	return (GdRect2)(ret_val)
 }
+func CallResGetPolygonFromAlpha(
+	p_path GdString,
+) GdArray {
+	arg0 := (C.GDExtensionSpxResGetPolygonFromAlpha)(api.SpxResGetPolygonFromAlpha)
+	arg1GdString := (C.GdString)(p_path)
+	var ret_val C.GdArray
+	C.cgo_callfn_GDExtensionSpxResGetPolygonFromAlpha(arg0, arg1GdString, &ret_val)
+
+	return GdArray(ret_val)
+}
 func CallResGetImageSize(
 	p_path GdString,
 ) GdVec2 {
@@ -2687,6 +2769,34 @@ func CallSpriteSetColliderCapsule(
 
 	C.cgo_callfn_GDExtensionSpxSpriteSetColliderCapsule(arg0, arg1GdObj, arg2GdVec2, arg3GdVec2)
 
+}
+func CallSpriteSetColliderPolygon(
+	obj GdObj,
+	center GdVec2,
+	points GdArray,
+) {
+	arg0 := (C.GDExtensionSpxSpriteSetColliderPolygon)(api.SpxSpriteSetColliderPolygon)
+	arg1GdObj := (C.GdObj)(obj)
+	arg2GdVec2 := (C.GdVec2)(center)
+	arg3GdArray := (C.GdArray)(points)
+
+	C.cgo_callfn_GDExtensionSpxSpriteSetColliderPolygon(arg0, arg1GdObj, arg2GdVec2, arg3GdArray)
+
+}
+func CallSpriteAddColliderShape(
+	obj GdObj,
+	shape_type GdInt,
+	center GdVec2,
+	params GdArray,
+) {
+	arg0 := (C.GDExtensionSpxSpriteAddColliderShape)(api.SpxSpriteAddColliderShape)
+	arg1GdObj := (C.GdObj)(obj)
+	arg2GdInt := (C.GdInt)(shape_type)
+	arg3GdVec2 := (C.GdVec2)(center)
+	arg4GdArray := (C.GdArray)(params)
+
+	C.cgo_callfn_GDExtensionSpxSpriteAddColliderShape(arg0, arg1GdObj, arg2GdInt, arg3GdVec2, arg4GdArray)
+
 }
 func CallSpriteSetCollisionEnabled(
 	obj GdObj,
@@ -2747,6 +2857,34 @@ func CallSpriteSetTriggerCapsule(
 
 	C.cgo_callfn_GDExtensionSpxSpriteSetTriggerCapsule(arg0, arg1GdObj, arg2GdVec2, arg3GdVec2)
 
+}
+func CallSpriteSetTriggerPolygon(
+	obj GdObj,
+	center GdVec2,
+	points GdArray,
+) {
+	arg0 := (C.GDExtensionSpxSpriteSetTriggerPolygon)(api.SpxSpriteSetTriggerPolygon)
+	arg1GdObj := (C.GdObj)(obj)
+	arg2GdVec2 := (C.GdVec2)(center)
+	arg3GdArray := (C.GdArray)(points)
+
+	C.cgo_callfn_GDExtensionSpxSpriteSetTriggerPolygon(arg0, arg1GdObj, arg2GdVec2, arg3GdArray)
+
+}
+func CallSpriteAddTriggerShape(
+	obj GdObj,
+	shape_type GdInt,
+	center GdVec2,
+	params GdArray,
+) {
+	arg0 := (C.GDExtensionSpxSpriteAddTriggerShape)(api.SpxSpriteAddTriggerShape)
+	arg1GdObj := (C.GdObj)(obj)
+	arg2GdInt := (C.GdInt)(shape_type)
+	arg3GdVec2 := (C.GdVec2)(center)
+	arg4GdArray := (C.GdArray)(params)
+
+	C.cgo_callfn_GDExtensionSpxSpriteAddTriggerShape(arg0, arg1GdObj, arg2GdInt, arg3GdVec2, arg4GdArray)
+
 }
 func CallSpriteSetTriggerEnabled(
 	obj GdObj,
@@ -153,6 +153,27 @@ void cgo_callfn_GDExtensionSpxInputIsActionJustPressed(const GDExtensionSpxInput
 void cgo_callfn_GDExtensionSpxInputIsActionJustReleased(const GDExtensionSpxInputIsActionJustReleased fn, GdString action, GdBool* ret_val) {
 	fn(action,ret_val);
 }
+void cgo_callfn_GDExtensionSpxInputGetConnectedGamepads(const GDExtensionSpxInputGetConnectedGamepads fn, GdInt* ret_val) {
+	fn(ret_val);
+}
+void cgo_callfn_GDExtensionSpxInputGetGamepadButtons(const GDExtensionSpxInputGetGamepadButtons fn, GdInt device, GdInt* ret_val) {
+	fn(device,ret_val);
+}
+void cgo_callfn_GDExtensionSpxInputGetGamepadAxis(const GDExtensionSpxInputGetGamepadAxis fn, GdInt device, GdInt axis, GdFloat* ret_val) {
+	fn(device, axis,ret_val);
+}
+void cgo_callfn_GDExtensionSpxInputGetTouchCount(const GDExtensionSpxInputGetTouchCount fn, GdInt* ret_val) {
+	fn(ret_val);
+}
+void cgo_callfn_GDExtensionSpxInputGetTouchId(const GDExtensionSpxInputGetTouchId fn, GdInt index, GdInt* ret_val) {
+	fn(index,ret_val);
+}
+void cgo_callfn_GDExtensionSpxInputGetTouchPos(const GDExtensionSpxInputGetTouchPos fn, GdInt index, GdVec2* ret_val) {
+	fn(index,ret_val);
+}
+void cgo_callfn_GDExtensionSpxInputGetMouseWheel(const GDExtensionSpxInputGetMouseWheel fn, GdVec2* ret_val) {
+	fn(ret_val);
+}
 void cgo_callfn_GDExtensionSpxNavigationSetupPathFinderWithSize(const GDExtensionSpxNavigationSetupPathFinderWithSize fn, GdVec2 grid_size, GdVec2 cell_size, GdBool with_jump, GdBool with_debug) {
 	fn(grid_size, cell_size, with_jump, with_debug);
 }
@@ -312,6 +333,9 @@ void cgo_callfn_GDExtensionSpxResGetLoadMode(const GDExtensionSpxResGetLoadMode
 void cgo_callfn_GDExtensionSpxResGetBoundFromAlpha(const GDExtensionSpxResGetBoundFromAlpha fn, GdString p_path, GdRect2* ret_val) {
 	fn(p_path,ret_val);
 }
+void cgo_callfn_GDExtensionSpxResGetPolygonFromAlpha(const GDExtensionSpxResGetPolygonFromAlpha fn, GdString p_path, GdArray* ret_val) {
+	fn(p_path,ret_val);
+}
 void cgo_callfn_GDExtensionSpxResGetImageSize(const GDExtensionSpxResGetImageSize fn, GdString p_path, GdVec2* ret_val) {
 	fn(p_path,ret_val);
 }
@@ -684,6 +708,12 @@ void cgo_callfn_GDExtensionSpxSpriteSetColliderCircle(const GDExtensionSpxSprite
 void cgo_callfn_GDExtensionSpxSpriteSetColliderCapsule(const GDExtensionSpxSpriteSetColliderCapsule fn, GdObj obj, GdVec2 center, GdVec2 size) {
 	fn(obj, center, size);
 }
+void cgo_callfn_GDExtensionSpxSpriteSetColliderPolygon(const GDExtensionSpxSpriteSetColliderPolygon fn, GdObj obj, GdVec2 center, GdArray points) {
+	fn(obj, center, points);
+}
+void cgo_callfn_GDExtensionSpxSpriteAddColliderShape(const GDExtensionSpxSpriteAddColliderShape fn, GdObj obj, GdInt shape_type, GdVec2 center, GdArray params) {
+	fn(obj, shape_type, center, params);
+}
 void cgo_callfn_GDExtensionSpxSpriteSetCollisionEnabled(const GDExtensionSpxSpriteSetCollisionEnabled fn, GdObj obj, GdBool enabled) {
 	fn(obj, enabled);
 }
@@ -699,6 +729,12 @@ void cgo_callfn_GDExtensionSpxSpriteSetTriggerCircle(const GDExtensionSpxSpriteS
 void cgo_callfn_GDExtensionSpxSpriteSetTriggerCapsule(const GDExtensionSpxSpriteSetTriggerCapsule fn, GdObj obj, GdVec2 center, GdVec2 size) {
 	fn(obj, center, size);
 }
+void cgo_callfn_GDExtensionSpxSpriteSetTriggerPolygon(const GDExtensionSpxSpriteSetTriggerPolygon fn, GdObj obj, GdVec2 center, GdArray points) {
+	fn(obj, center, points);
+}
+void cgo_callfn_GDExtensionSpxSpriteAddTriggerShape(const GDExtensionSpxSpriteAddTriggerShape fn, GdObj obj, GdInt shape_type, GdVec2 center, GdArray params) {
+	fn(obj, shape_type, center, params);
+}
 void cgo_callfn_GDExtensionSpxSpriteSetTriggerEnabled(const GDExtensionSpxSpriteSetTriggerEnabled fn, GdObj obj, GdBool trigger) {
 	fn(obj, trigger);
 }
@@ -268,6 +268,13 @@ typedef void (*GDExtensionSpxInputGetAxis)(GdString neg_action, GdString pos_act
 typedef void (*GDExtensionSpxInputIsActionPressed)(GdString action, GdBool *ret_value);
 typedef void (*GDExtensionSpxInputIsActionJustPressed)(GdString action, GdBool *ret_value);
 typedef void (*GDExtensionSpxInputIsActionJustReleased)(GdString action, GdBool *ret_value);
+typedef void (*GDExtensionSpxInputGetConnectedGamepads)(GdInt *ret_value);
+typedef void (*GDExtensionSpxInputGetGamepadButtons)(GdInt device, GdInt *ret_value);
+typedef void (*GDExtensionSpxInputGetGamepadAxis)(GdInt device, GdInt axis, GdFloat *ret_value);
+typedef void (*GDExtensionSpxInputGetTouchCount)(GdInt *ret_value);
+typedef void (*GDExtensionSpxInputGetTouchId)(GdInt index, GdInt *ret_value);
+typedef void (*GDExtensionSpxInputGetTouchPos)(GdInt index, GdVec2 *ret_value);
+typedef void (*GDExtensionSpxInputGetMouseWheel)(GdVec2 *ret_value);
 // SpxNavigation
 typedef void (*GDExtensionSpxNavigationSetupPathFinderWithSize)(GdVec2 grid_size, GdVec2 cell_size, GdBool with_jump, GdBool with_debug);
 typedef void (*GDExtensionSpxNavigationSetupPathFinder)(GdBool with_jump);
@@ -326,6 +333,7 @@ typedef void (*GDExtensionSpxResCreateAnimation)(GdString p_sprite_type, GdStrin
 typedef void (*GDExtensionSpxResSetLoadMode)(GdBool is_direct_mode);
 typedef void (*GDExtensionSpxResGetLoadMode)(GdBool *ret_value);
 typedef void (*GDExtensionSpxResGetBoundFromAlpha)(GdString p_path, GdRect2 *ret_value);
+typedef void (*GDExtensionSpxResGetPolygonFromAlpha)(GdString p_path, GdArray *ret_value);
 typedef void (*GDExtensionSpxResGetImageSize)(GdString p_path, GdVec2 *ret_value);
 typedef void (*GDExtensionSpxResReadAllText)(GdString p_path, GdString *ret_value);
 typedef void (*GDExtensionSpxResHasFile)(GdString p_path, GdBool *ret_value);
@@ -452,11 +460,15 @@ typedef void (*GDExtensionSpxSpriteGetTriggerMask)(GdObj obj, GdInt *ret_value);
 typedef void (*GDExtensionSpxSpriteSetColliderRect)(GdObj obj, GdVec2 center, GdVec2 size);
 typedef void (*GDExtensionSpxSpriteSetColliderCircle)(GdObj obj, GdVec2 center, GdFloat radius);
 typedef void (*GDExtensionSpxSpriteSetColliderCapsule)(GdObj obj, GdVec2 center, GdVec2 size);
+typedef void (*GDExtensionSpxSpriteSetColliderPolygon)(GdObj obj, GdVec2 center, GdArray points);
+typedef void (*GDExtensionSpxSpriteAddColliderShape)(GdObj obj, GdInt shape_type, GdVec2 center, GdArray params);
 typedef void (*GDExtensionSpxSpriteSetCollisionEnabled)(GdObj obj, GdBool enabled);
 typedef void (*GDExtensionSpxSpriteIsCollisionEnabled)(GdObj obj, GdBool *ret_value);
 typedef void (*GDExtensionSpxSpriteSetTriggerRect)(GdObj obj, GdVec2 center, GdVec2 size);
 typedef void (*GDExtensionSpxSpriteSetTriggerCircle)(GdObj obj, GdVec2 center, GdFloat radius);
 typedef void (*GDExtensionSpxSpriteSetTriggerCapsule)(GdObj obj, GdVec2 center, GdVec2 size);
+typedef void (*GDExtensionSpxSpriteSetTriggerPolygon)(GdObj obj, GdVec2 center, GdArray points);
+typedef void (*GDExtensionSpxSpriteAddTriggerShape)(GdObj obj, GdInt shape_type, GdVec2 center, GdArray params);
 typedef void (*GDExtensionSpxSpriteSetTriggerEnabled)(GdObj obj, GdBool trigger);
 typedef void (*GDExtensionSpxSpriteIsTriggerEnabled)(GdObj obj, GdBool *ret_value);
 typedef void (*GDExtensionSpxSpriteCheckCollisionByColor)(GdObj obj, GdColor color, GdFloat color_threshold, GdFloat alpha_threshold, GdBool *ret_value);
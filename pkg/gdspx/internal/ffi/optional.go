@@ -0,0 +1,19 @@
+package ffi
+
+import "sync"
+
+// Engines older than the spx runtime may lack some of its entry points, their
+// pointers are then left nil. The wrappers of those optional entry points
+// check HasProc and degrade gracefully rather than calling a nil pointer.
+
+var procs sync.Map // name => bool
+
+// HasProc reports whether the engine exports the entry point name.
+func HasProc(name string) bool {
+	if has, ok := procs.Load(name); ok {
+		return has.(bool)
+	}
+	has := dlsymGD != nil && dlsymGD(name) != nil
+	procs.Store(name, has)
+	return has
+}
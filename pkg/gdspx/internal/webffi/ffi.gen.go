@@ -69,6 +69,13 @@ type GDExtensionInterface struct {
 	SpxInputIsActionPressed                  js.Value
 	SpxInputIsActionJustPressed              js.Value
 	SpxInputIsActionJustReleased             js.Value
+	SpxInputGetConnectedGamepads             js.Value
+	SpxInputGetGamepadButtons                js.Value
+	SpxInputGetGamepadAxis                   js.Value
+	SpxInputGetTouchCount                    js.Value
+	SpxInputGetTouchId                       js.Value
+	SpxInputGetTouchPos                      js.Value
+	SpxInputGetMouseWheel                    js.Value
 	SpxNavigationSetupPathFinderWithSize     js.Value
 	SpxNavigationSetupPathFinder             js.Value
 	SpxNavigationSetObstacle                 js.Value
@@ -122,6 +129,7 @@ type GDExtensionInterface struct {
 	SpxResSetLoadMode                        js.Value
 	SpxResGetLoadMode                        js.Value
 	SpxResGetBoundFromAlpha                  js.Value
+	SpxResGetPolygonFromAlpha                js.Value
 	SpxResGetImageSize                       js.Value
 	SpxResReadAllText                        js.Value
 	SpxResHasFile                            js.Value
@@ -246,11 +254,15 @@ type GDExtensionInterface struct {
 	SpxSpriteSetColliderRect                 js.Value
 	SpxSpriteSetColliderCircle               js.Value
 	SpxSpriteSetColliderCapsule              js.Value
+	SpxSpriteSetColliderPolygon              js.Value
+	SpxSpriteAddColliderShape                js.Value
 	SpxSpriteSetCollisionEnabled             js.Value
 	SpxSpriteIsCollisionEnabled              js.Value
 	SpxSpriteSetTriggerRect                  js.Value
 	SpxSpriteSetTriggerCircle                js.Value
 	SpxSpriteSetTriggerCapsule               js.Value
+	SpxSpriteSetTriggerPolygon               js.Value
+	SpxSpriteAddTriggerShape                 js.Value
 	SpxSpriteSetTriggerEnabled               js.Value
 	SpxSpriteIsTriggerEnabled                js.Value
 	SpxSpriteCheckCollisionByColor           js.Value
@@ -365,6 +377,13 @@ func (x *GDExtensionInterface) loadProcAddresses() {
 	x.SpxInputIsActionPressed = dlsymGD("gdspx_input_is_action_pressed")
 	x.SpxInputIsActionJustPressed = dlsymGD("gdspx_input_is_action_just_pressed")
 	x.SpxInputIsActionJustReleased = dlsymGD("gdspx_input_is_action_just_released")
+	x.SpxInputGetConnectedGamepads = dlsymOptionalGD("gdspx_input_get_connected_gamepads")
+	x.SpxInputGetGamepadButtons = dlsymOptionalGD("gdspx_input_get_gamepad_buttons")
+	x.SpxInputGetGamepadAxis = dlsymOptionalGD("gdspx_input_get_gamepad_axis")
+	x.SpxInputGetTouchCount = dlsymOptionalGD("gdspx_input_get_touch_count")
+	x.SpxInputGetTouchId = dlsymOptionalGD("gdspx_input_get_touch_id")
+	x.SpxInputGetTouchPos = dlsymOptionalGD("gdspx_input_get_touch_pos")
+	x.SpxInputGetMouseWheel = dlsymOptionalGD("gdspx_input_get_mouse_wheel")
 	x.SpxNavigationSetupPathFinderWithSize = dlsymGD("gdspx_navigation_setup_path_finder_with_size")
 	x.SpxNavigationSetupPathFinder = dlsymGD("gdspx_navigation_setup_path_finder")
 	x.SpxNavigationSetObstacle = dlsymGD("gdspx_navigation_set_obstacle")
@@ -418,6 +437,7 @@ func (x *GDExtensionInterface) loadProcAddresses() {
 	x.SpxResSetLoadMode = dlsymGD("gdspx_res_set_load_mode")
 	x.SpxResGetLoadMode = dlsymGD("gdspx_res_get_load_mode")
 	x.SpxResGetBoundFromAlpha = dlsymGD("gdspx_res_get_bound_from_alpha")
+	x.SpxResGetPolygonFromAlpha = dlsymOptionalGD("gdspx_res_get_polygon_from_alpha")
 	x.SpxResGetImageSize = dlsymGD("gdspx_res_get_image_size")
 	x.SpxResReadAllText = dlsymGD("gdspx_res_read_all_text")
 	x.SpxResHasFile = dlsymGD("gdspx_res_has_file")
@@ -542,11 +562,15 @@ func (x *GDExtensionInterface) loadProcAddresses() {
 	x.SpxSpriteSetColliderRect = dlsymGD("gdspx_sprite_set_collider_rect")
 	x.SpxSpriteSetColliderCircle = dlsymGD("gdspx_sprite_set_collider_circle")
 	x.SpxSpriteSetColliderCapsule = dlsymGD("gdspx_sprite_set_collider_capsule")
+	x.SpxSpriteSetColliderPolygon = dlsymOptionalGD("gdspx_sprite_set_collider_polygon")
+	x.SpxSpriteAddColliderShape = dlsymOptionalGD("gdspx_sprite_add_collider_shape")
 	x.SpxSpriteSetCollisionEnabled = dlsymGD("gdspx_sprite_set_collision_enabled")
 	x.SpxSpriteIsCollisionEnabled = dlsymGD("gdspx_sprite_is_collision_enabled")
 	x.SpxSpriteSetTriggerRect = dlsymGD("gdspx_sprite_set_trigger_rect")
 	x.SpxSpriteSetTriggerCircle = dlsymGD("gdspx_sprite_set_trigger_circle")
 	x.SpxSpriteSetTriggerCapsule = dlsymGD("gdspx_sprite_set_trigger_capsule")
+	x.SpxSpriteSetTriggerPolygon = dlsymOptionalGD("gdspx_sprite_set_trigger_polygon")
+	x.SpxSpriteAddTriggerShape = dlsymOptionalGD("gdspx_sprite_add_trigger_shape")
 	x.SpxSpriteSetTriggerEnabled = dlsymGD("gdspx_sprite_set_trigger_enabled")
 	x.SpxSpriteIsTriggerEnabled = dlsymGD("gdspx_sprite_is_trigger_enabled")
 	x.SpxSpriteCheckCollisionByColor = dlsymGD("gdspx_sprite_check_collision_by_color")
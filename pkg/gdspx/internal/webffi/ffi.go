@@ -51,3 +51,17 @@ func dlsymGD(funcName string) js.Value {
 	}
 	return val
 }
+
+// dlsymOptionalGD is dlsymGD for the functions older engines may lack, it
+// returns undefined for those, see HasProc.
+func dlsymOptionalGD(funcName string) js.Value {
+	return js.Global().Get(funcName)
+}
+
+// HasProc reports whether the engine exports the function funcName. The
+// wrappers of the optional functions check it and degrade gracefully rather
+// than invoking undefined.
+func HasProc(funcName string) bool {
+	val := js.Global().Get(funcName)
+	return !val.IsUndefined() && !val.IsNull()
+}
@@ -0,0 +1,48 @@
+//go:build !pure_engine
+
+package wrap
+
+import (
+	"math"
+	"sync"
+
+	. "github.com/goplus/spbase/mathf"
+	spxlog "github.com/goplus/spx/v2/internal/log"
+	. "github.com/goplus/spx/v2/pkg/gdspx/pkg/engine"
+)
+
+// Fallbacks of the optional engine entry points, for engines older than the
+// spx runtime, see HasProc.
+
+var fallbackWarned sync.Map // the entry points warned about
+
+// warnFallback warns, once per entry point, that the engine lacks proc and
+// what the fallback does instead
+func warnFallback(proc, fallback string) {
+	if _, warned := fallbackWarned.LoadOrStore(proc, true); !warned {
+		spxlog.Warn("the engine has no %s, %s", proc, fallback)
+	}
+}
+
+// polygonRect returns the center and the size of the rect bounding the
+// polygon of flattened points around center
+func polygonRect(center Vec2, points Array) (Vec2, Vec2) {
+	values, _ := points.([]float64)
+	if len(values) < 2 {
+		return center, Vec2{}
+	}
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for i := 0; i+1 < len(values); i += 2 {
+		minX, maxX = math.Min(minX, values[i]), math.Max(maxX, values[i])
+		minY, maxY = math.Min(minY, values[i+1]), math.Max(maxY, values[i+1])
+	}
+	return center.Add(NewVec2((minX+maxX)/2, (minY+maxY)/2)), NewVec2(maxX-minX, maxY-minY)
+}
+
+// rectPolygon returns the corners of rect as flattened points
+func rectPolygon(rect Rect2) []float64 {
+	x0, y0 := rect.Position.X, rect.Position.Y
+	x1, y1 := x0+rect.Size.X, y0+rect.Size.Y
+	return []float64{x0, y0, x1, y0, x1, y1, x0, y1}
+}
@@ -0,0 +1,1067 @@
+//go:build pure_engine
+
+package wrap
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	. "github.com/goplus/spbase/mathf"
+	. "github.com/goplus/spx/v2/pkg/gdspx/pkg/engine"
+)
+
+// -----------------------------------------------------------------------------
+// input
+
+// key states returned by GetKeyState
+const (
+	hlKeyUp int64 = iota
+	hlKeyJustPressed
+	hlKeyHeld
+	hlKeyJustReleased
+)
+
+type hlInput struct {
+	keys         map[int64]bool
+	justPressed  map[int64]bool
+	justReleased map[int64]bool
+	mouseButtons map[int64]bool
+	mousePos     Vec2
+	actions      map[string][]int64
+	gamepads     map[int64]*hlGamepad
+	touches      map[int64]Vec2 // the touching fingers by id
+	wheel        Vec2           // scrolled during the frame
+}
+
+// hlGamepad is the state of a connected gamepad
+type hlGamepad struct {
+	buttons int64 // bit i is set if button i is pressed
+	axes    map[int64]float64
+}
+
+func newHlInput() hlInput {
+	return hlInput{
+		keys:         make(map[int64]bool),
+		justPressed:  make(map[int64]bool),
+		justReleased: make(map[int64]bool),
+		mouseButtons: make(map[int64]bool),
+		gamepads:     make(map[int64]*hlGamepad),
+		touches:      make(map[int64]Vec2),
+		// the built-in actions of Godot
+		actions: map[string][]int64{
+			"ui_left":   {int64(KeyLeft)},
+			"ui_right":  {int64(KeyRight)},
+			"ui_up":     {int64(KeyUp)},
+			"ui_down":   {int64(KeyDown)},
+			"ui_accept": {int64(KeyEnter), int64(KeySpace)},
+			"ui_cancel": {int64(KeyEscape)},
+		},
+	}
+}
+
+// setKey reports whether the state of key changed
+func (pself *hlInput) setKey(key int64, pressed bool) bool {
+	if pself.keys[key] == pressed {
+		return false
+	}
+	pself.keys[key] = pressed
+	if pressed {
+		pself.justPressed[key] = true
+	} else {
+		pself.justReleased[key] = true
+	}
+	return true
+}
+
+func (pself *hlInput) setMouseButton(button int64, pressed bool) bool {
+	if pself.mouseButtons[button] == pressed {
+		return false
+	}
+	pself.mouseButtons[button] = pressed
+	return true
+}
+
+// gamepad returns the gamepad device, connecting it if needed
+func (pself *hlInput) gamepad(device int64) *hlGamepad {
+	pad, ok := pself.gamepads[device]
+	if !ok {
+		pad = &hlGamepad{axes: make(map[int64]float64)}
+		pself.gamepads[device] = pad
+	}
+	return pad
+}
+
+// touchIds returns the ids of the touching fingers, in increasing order
+func (pself *hlInput) touchIds() []int64 {
+	ids := make([]int64, 0, len(pself.touches))
+	for id := range pself.touches {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (pself *hlInput) endFrame() {
+	clear(pself.justPressed)
+	clear(pself.justReleased)
+	pself.wheel = Vec2{}
+}
+
+func (pself *hlInput) actionKeys(action string) []int64 {
+	if keys, ok := pself.actions[action]; ok {
+		return keys
+	}
+	return nil
+}
+
+func (pself *hlInput) anyKey(action string, states map[int64]bool) bool {
+	for _, key := range pself.actionKeys(action) {
+		if states[key] {
+			return true
+		}
+	}
+	return false
+}
+
+func (pself *inputMgr) GetGlobalMousePos() Vec2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.input.mousePos
+}
+func (pself *inputMgr) GetKey(key int64) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.input.keys[key]
+}
+func (pself *inputMgr) GetMouseState(mouse_id int64) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.input.mouseButtons[mouse_id]
+}
+func (pself *inputMgr) GetKeyState(key int64) int64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	input := &world.input
+	switch {
+	case input.justPressed[key]:
+		return hlKeyJustPressed
+	case input.justReleased[key]:
+		return hlKeyJustReleased
+	case input.keys[key]:
+		return hlKeyHeld
+	}
+	return hlKeyUp
+}
+func (pself *inputMgr) GetAxis(neg_action string, pos_action string) float64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	value := 0.0
+	if world.input.anyKey(neg_action, world.input.keys) {
+		value--
+	}
+	if world.input.anyKey(pos_action, world.input.keys) {
+		value++
+	}
+	return value
+}
+func (pself *inputMgr) IsActionPressed(action string) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.input.anyKey(action, world.input.keys)
+}
+func (pself *inputMgr) IsActionJustPressed(action string) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.input.anyKey(action, world.input.justPressed)
+}
+func (pself *inputMgr) IsActionJustReleased(action string) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.input.anyKey(action, world.input.justReleased)
+}
+func (pself *inputMgr) GetConnectedGamepads() int64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	var devices int64
+	for device := range world.input.gamepads {
+		devices |= 1 << device
+	}
+	return devices
+}
+func (pself *inputMgr) GetGamepadButtons(device int64) int64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if pad, ok := world.input.gamepads[device]; ok {
+		return pad.buttons
+	}
+	return 0
+}
+func (pself *inputMgr) GetGamepadAxis(device int64, axis int64) float64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if pad, ok := world.input.gamepads[device]; ok {
+		return pad.axes[axis]
+	}
+	return 0
+}
+func (pself *inputMgr) GetTouchCount() int64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return int64(len(world.input.touches))
+}
+func (pself *inputMgr) GetTouchId(index int64) int64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if ids := world.input.touchIds(); index >= 0 && index < int64(len(ids)) {
+		return ids[index]
+	}
+	return -1
+}
+func (pself *inputMgr) GetTouchPos(index int64) Vec2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if ids := world.input.touchIds(); index >= 0 && index < int64(len(ids)) {
+		return world.input.touches[ids[index]]
+	}
+	return Vec2{}
+}
+func (pself *inputMgr) GetMouseWheel() Vec2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.input.wheel
+}
+
+// -----------------------------------------------------------------------------
+// camera
+
+type hlCamera struct {
+	pos    Vec2 // Godot space
+	zoom   Vec2
+	limits [4]float64 // left, top, right, bottom in Godot space
+}
+
+func newHlCamera() hlCamera {
+	const limit = 10000000
+	return hlCamera{zoom: NewVec2(1, 1), limits: [4]float64{-limit, -limit, limit, limit}}
+}
+
+// godotRect returns the visible area in Godot space (y axis down)
+func (pself *hlCamera) godotRect(viewport Vec2) Rect2 {
+	size := viewport
+	if pself.zoom.X != 0 && pself.zoom.Y != 0 {
+		size = NewVec2(viewport.X/pself.zoom.X, viewport.Y/pself.zoom.Y)
+	}
+	clamp := func(v, lo, hi, half float64) float64 {
+		if hi-lo < half*2 {
+			return (lo + hi) / 2
+		}
+		return math.Max(lo+half, math.Min(hi-half, v))
+	}
+	center := NewVec2(
+		clamp(pself.pos.X, pself.limits[0], pself.limits[2], size.X/2),
+		clamp(pself.pos.Y, pself.limits[1], pself.limits[3], size.Y/2),
+	)
+	return Rect2{Position: center.Sub(size.Divf(2)), Size: size}
+}
+
+// spxRect returns the visible area in spx space, Position is the bottom left
+func (pself *hlCamera) spxRect(viewport Vec2) Rect2 {
+	rect := pself.godotRect(viewport)
+	return NewRect2(rect.Position.X, -(rect.Position.Y + rect.Size.Y), rect.Size.X, rect.Size.Y)
+}
+
+func (pself *cameraMgr) GetCameraPosition() Vec2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.camera.pos
+}
+func (pself *cameraMgr) SetCameraPosition(position Vec2) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.camera.pos = position
+}
+func (pself *cameraMgr) GetCameraZoom() Vec2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.camera.zoom
+}
+func (pself *cameraMgr) SetCameraZoom(size Vec2) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.camera.zoom = size
+}
+func (pself *cameraMgr) GetViewportRect() Rect2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return Rect2{Size: world.platform.windowSize}
+}
+func (pself *cameraMgr) GetGlobalCameraRect() Rect2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.camera.godotRect(world.platform.windowSize)
+}
+func (pself *cameraMgr) SetCameraLimit(side int64, limit int64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if side >= 0 && side < int64(len(world.camera.limits)) {
+		world.camera.limits[side] = float64(limit)
+	}
+}
+func (pself *cameraMgr) SetCameraSmoothing(enabled bool) {
+}
+
+// -----------------------------------------------------------------------------
+// debug
+
+func (pself *debugMgr) DebugDrawCircle(pos Vec2, radius float64, color Color) {
+}
+func (pself *debugMgr) DebugDrawRect(pos Vec2, size Vec2, color Color) {
+}
+func (pself *debugMgr) DebugDrawLine(from Vec2, to Vec2, color Color) {
+}
+
+// -----------------------------------------------------------------------------
+// ext
+
+func (pself *headlessWorld) postPause(paused bool) {
+	if cb := callbacks.OnEnginePause; cb != nil {
+		pself.post(func() { cb(paused) })
+	}
+}
+
+func (pself *extMgr) RequestExit(exit_code int64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.exited = true
+	world.exitCode = exit_code
+}
+func (pself *extMgr) RequestReset(exit_code int64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.resetRequested = true
+}
+func (pself *extMgr) RequestRestart() {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.resetRequested = true
+}
+func (pself *extMgr) OnRuntimePanic(msg string) {
+	fmt.Fprintln(os.Stderr, msg)
+}
+func (pself *extMgr) Pause() {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if !world.paused {
+		world.paused = true
+		world.postPause(true)
+	}
+}
+func (pself *extMgr) Resume() {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if world.paused {
+		world.paused = false
+		world.postPause(false)
+	}
+}
+func (pself *extMgr) IsPaused() bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.paused
+}
+func (pself *extMgr) NextFrame() {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.stepOnce = true
+}
+func (pself *extMgr) SetLayerSorterMode(mode int64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.layerSortMode = mode
+}
+
+// -----------------------------------------------------------------------------
+// audio
+
+type hlAudioSource struct {
+	pitch  float64
+	pan    float64
+	volume float64
+}
+
+type hlAudioPlaying struct {
+	source   Object
+	duration float64
+	timer    float64
+	loop     bool
+	paused   bool
+}
+
+// stepAudios advances the playing sounds, the caller must hold mu. Sounds
+// whose length is unknown end after one frame.
+func (pself *headlessWorld) stepAudios(delta float64) {
+	for aid, playing := range pself.playings {
+		if playing.paused {
+			continue
+		}
+		pitch := 1.0
+		if source := pself.audios[playing.source]; source != nil && source.pitch > 0 {
+			pitch = source.pitch
+		}
+		playing.timer += delta * pitch
+		if playing.timer < playing.duration {
+			continue
+		}
+		if playing.loop && playing.duration > 0 {
+			playing.timer = math.Mod(playing.timer, playing.duration)
+			continue
+		}
+		delete(pself.playings, aid)
+	}
+}
+
+func withAudio[T any](obj Object, fn func(source *hlAudioSource) T) T {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	var ret T
+	if source := world.audios[obj]; source != nil {
+		ret = fn(source)
+	}
+	return ret
+}
+
+func withPlaying[T any](aid int64, fn func(playing *hlAudioPlaying) T) T {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	var ret T
+	if playing := world.playings[aid]; playing != nil {
+		ret = fn(playing)
+	}
+	return ret
+}
+
+func (pself *audioMgr) StopAll() {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	clear(world.playings)
+}
+func (pself *audioMgr) CreateAudio() Object {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	id := world.newId()
+	world.audios[id] = &hlAudioSource{pitch: 1, volume: 1}
+	return id
+}
+func (pself *audioMgr) DestroyAudio(obj Object) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	delete(world.audios, obj)
+	for aid, playing := range world.playings {
+		if playing.source == obj {
+			delete(world.playings, aid)
+		}
+	}
+}
+func (pself *audioMgr) SetPitch(obj Object, pitch float64) {
+	withAudio(obj, func(s *hlAudioSource) bool { s.pitch = pitch; return true })
+}
+func (pself *audioMgr) GetPitch(obj Object) float64 {
+	return withAudio(obj, func(s *hlAudioSource) float64 { return s.pitch })
+}
+func (pself *audioMgr) SetPan(obj Object, pan float64) {
+	withAudio(obj, func(s *hlAudioSource) bool { s.pan = pan; return true })
+}
+func (pself *audioMgr) GetPan(obj Object) float64 {
+	return withAudio(obj, func(s *hlAudioSource) float64 { return s.pan })
+}
+func (pself *audioMgr) SetVolume(obj Object, volume float64) {
+	withAudio(obj, func(s *hlAudioSource) bool { s.volume = volume; return true })
+}
+func (pself *audioMgr) GetVolume(obj Object) float64 {
+	return withAudio(obj, func(s *hlAudioSource) float64 { return s.volume })
+}
+func (pself *audioMgr) PlayWithAttenuation(obj Object, path string, owner_id Object, attenuation float64, max_distance float64) int64 {
+	return pself.Play(obj, path)
+}
+func (pself *audioMgr) Play(obj Object, path string) int64 {
+	duration := wavDuration(path)
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.nextAudio++
+	aid := world.nextAudio
+	world.playings[aid] = &hlAudioPlaying{source: obj, duration: duration}
+	return aid
+}
+func (pself *audioMgr) Pause(aid int64) {
+	withPlaying(aid, func(p *hlAudioPlaying) bool { p.paused = true; return true })
+}
+func (pself *audioMgr) Resume(aid int64) {
+	withPlaying(aid, func(p *hlAudioPlaying) bool { p.paused = false; return true })
+}
+func (pself *audioMgr) Stop(aid int64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	delete(world.playings, aid)
+}
+func (pself *audioMgr) SetLoop(aid int64, loop bool) {
+	withPlaying(aid, func(p *hlAudioPlaying) bool { p.loop = loop; return true })
+}
+func (pself *audioMgr) GetLoop(aid int64) bool {
+	return withPlaying(aid, func(p *hlAudioPlaying) bool { return p.loop })
+}
+func (pself *audioMgr) GetTimer(aid int64) float64 {
+	return withPlaying(aid, func(p *hlAudioPlaying) float64 { return p.timer })
+}
+func (pself *audioMgr) SetTimer(aid int64, time float64) {
+	withPlaying(aid, func(p *hlAudioPlaying) bool { p.timer = time; return true })
+}
+func (pself *audioMgr) IsPlaying(aid int64) bool {
+	return withPlaying(aid, func(p *hlAudioPlaying) bool { return !p.paused })
+}
+
+// -----------------------------------------------------------------------------
+// platform
+
+type hlPlatform struct {
+	windowSize    Vec2
+	windowPos     Vec2
+	title         string
+	fullscreen    bool
+	debugMode     bool
+	timeScale     float64
+	delta         float64 // scaled delta of the current frame
+	persistentDir string
+}
+
+func newHlPlatform() hlPlatform {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return hlPlatform{
+		windowSize:    NewVec2(480, 360),
+		timeScale:     1,
+		persistentDir: filepath.Join(dir, "spx"),
+	}
+}
+
+func (pself *hlPlatform) physicDelta() float64 {
+	if pself.delta > 0 {
+		return pself.delta
+	}
+	return 1.0 / headlessFrameRate
+}
+
+func (pself *platformMgr) SetStretchMode(enable bool) {
+}
+func (pself *platformMgr) SetStretchAspect(is_keep bool) {
+}
+func (pself *platformMgr) SetStretchContentScale(width int64, height int64) {
+}
+func (pself *platformMgr) SetWindowPosition(pos Vec2) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.platform.windowPos = pos
+}
+func (pself *platformMgr) GetWindowPosition() Vec2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.platform.windowPos
+}
+func (pself *platformMgr) SetWindowSize(width int64, height int64, with_content_scale bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.platform.windowSize = NewVec2(float64(width), float64(height))
+}
+func (pself *platformMgr) GetWindowSize() Vec2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.platform.windowSize
+}
+func (pself *platformMgr) SetWindowTitle(title string) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.platform.title = title
+}
+func (pself *platformMgr) GetWindowTitle() string {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.platform.title
+}
+func (pself *platformMgr) SetWindowFullscreen(enable bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.platform.fullscreen = enable
+}
+func (pself *platformMgr) IsWindowFullscreen() bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.platform.fullscreen
+}
+func (pself *platformMgr) SetDebugMode(enable bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.platform.debugMode = enable
+}
+func (pself *platformMgr) IsDebugMode() bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.platform.debugMode
+}
+func (pself *platformMgr) GetTimeScale() float64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.platform.timeScale
+}
+func (pself *platformMgr) SetTimeScale(time_scale float64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.platform.timeScale = time_scale
+}
+func (pself *platformMgr) GetPersistantDataDir() string {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.platform.persistentDir
+}
+func (pself *platformMgr) SetPersistantDataDir(path string) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.platform.persistentDir = path
+}
+func (pself *platformMgr) IsInPersistantDataDir(path string) bool {
+	world.mu.Lock()
+	dir := world.platform.persistentDir
+	world.mu.Unlock()
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(dir, abs)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// -----------------------------------------------------------------------------
+// navigation
+
+type hlPathGrid struct {
+	gridSize Vec2
+	cellSize Vec2
+	ready    bool
+}
+
+// cellOf returns the grid cell of pos, the grid is centered on the origin
+func (pself *hlPathGrid) cellOf(pos Vec2) (int, int, bool) {
+	x := int(math.Floor((pos.X + pself.gridSize.X*pself.cellSize.X/2) / pself.cellSize.X))
+	y := int(math.Floor((pos.Y + pself.gridSize.Y*pself.cellSize.Y/2) / pself.cellSize.Y))
+	ok := x >= 0 && y >= 0 && x < int(pself.gridSize.X) && y < int(pself.gridSize.Y)
+	return x, y, ok
+}
+
+func (pself *hlPathGrid) cellCenter(x, y int) Vec2 {
+	return NewVec2(
+		(float64(x)+0.5)*pself.cellSize.X-pself.gridSize.X*pself.cellSize.X/2,
+		(float64(y)+0.5)*pself.cellSize.Y-pself.gridSize.Y*pself.cellSize.Y/2,
+	)
+}
+
+// findPath runs a breadth first search over the free cells, the caller must
+// hold mu
+func (pself *headlessWorld) findPath(from, to Vec2) []float32 {
+	grid := &pself.pathGrid
+	straight := []float32{float32(from.X), float32(from.Y), float32(to.X), float32(to.Y)}
+	if !grid.ready || grid.cellSize.X <= 0 || grid.cellSize.Y <= 0 {
+		return straight
+	}
+	sx, sy, ok1 := grid.cellOf(from)
+	tx, ty, ok2 := grid.cellOf(to)
+	if !ok1 || !ok2 {
+		return straight
+	}
+	var obstacles []hlBox
+	for _, sprite := range pself.sortedSprites() {
+		if !sprite.obstacle {
+			continue
+		}
+		if box, ok := sprite.colliderBox(); ok {
+			obstacles = append(obstacles, box)
+		} else if box, ok := sprite.triggerBox(); ok {
+			obstacles = append(obstacles, box)
+		}
+	}
+	w, h := int(grid.gridSize.X), int(grid.gridSize.Y)
+	blocked := func(x, y int) bool {
+		cell := newRectBox(grid.cellCenter(x, y), grid.cellSize, 0)
+		for _, box := range obstacles {
+			if overlapBoxes(cell, box) {
+				return true
+			}
+		}
+		return false
+	}
+	prev := make(map[int]int)
+	start, goal := sy*w+sx, ty*w+tx
+	prev[start] = -1
+	queue := []int{start}
+	for len(queue) > 0 && queue[0] != goal {
+		cur := queue[0]
+		queue = queue[1:]
+		cx, cy := cur%w, cur/w
+		for _, d := range [4][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+			nx, ny := cx+d[0], cy+d[1]
+			if nx < 0 || ny < 0 || nx >= w || ny >= h {
+				continue
+			}
+			next := ny*w + nx
+			if _, seen := prev[next]; seen || blocked(nx, ny) {
+				continue
+			}
+			prev[next] = cur
+			queue = append(queue, next)
+		}
+	}
+	if _, ok := prev[goal]; !ok {
+		return []float32{}
+	}
+	var cells []int
+	for cur := goal; cur != -1; cur = prev[cur] {
+		cells = append(cells, cur)
+	}
+	path := make([]float32, 0, len(cells)*2)
+	for i := len(cells) - 1; i >= 0; i-- {
+		p := grid.cellCenter(cells[i]%w, cells[i]/w)
+		path = append(path, float32(p.X), float32(p.Y))
+	}
+	return path
+}
+
+func (pself *navigationMgr) SetupPathFinderWithSize(grid_size Vec2, cell_size Vec2, with_jump bool, with_debug bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.pathGrid = hlPathGrid{gridSize: grid_size, cellSize: cell_size, ready: true}
+}
+func (pself *navigationMgr) SetupPathFinder(with_jump bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	size := world.platform.windowSize
+	world.pathGrid = hlPathGrid{gridSize: size.Divf(16), cellSize: NewVec2(16, 16), ready: true}
+}
+func (pself *navigationMgr) SetObstacle(obj Object, enabled bool) {
+	updateSprite(obj, func(s *hlSprite) { s.obstacle = enabled })
+}
+func (pself *navigationMgr) FindPath(p_from Vec2, p_to Vec2, with_jump bool) Array {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.findPath(p_from, p_to)
+}
+
+// -----------------------------------------------------------------------------
+// pen, nothing is drawn without a renderer
+
+func (pself *penMgr) DestroyAllPens() {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	clear(world.pens)
+}
+func (pself *penMgr) CreatePen() Object {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	id := world.newId()
+	world.pens[id] = true
+	return id
+}
+func (pself *penMgr) DestroyPen(obj Object) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	delete(world.pens, obj)
+}
+func (pself *penMgr) PenStamp(obj Object) {
+}
+func (pself *penMgr) MovePenTo(obj Object, position Vec2) {
+}
+func (pself *penMgr) PenDown(obj Object, move_by_mouse bool) {
+}
+func (pself *penMgr) PenUp(obj Object) {
+}
+func (pself *penMgr) SetPenColorTo(obj Object, color Color) {
+}
+func (pself *penMgr) ChangePenBy(obj Object, property int64, amount float64) {
+}
+func (pself *penMgr) SetPenTo(obj Object, property int64, value float64) {
+}
+func (pself *penMgr) ChangePenSizeBy(obj Object, amount float64) {
+}
+func (pself *penMgr) SetPenSizeTo(obj Object, size float64) {
+}
+func (pself *penMgr) SetPenStampTexture(obj Object, texture_path string) {
+}
+
+// -----------------------------------------------------------------------------
+// scene
+
+func (pself *sceneMgr) ChangeSceneToFile(path string) {
+}
+func (pself *sceneMgr) DestroyAllSprites() {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	for _, sprite := range world.sortedSprites() {
+		if !sprite.dontDestroyOnLoad {
+			world.removeSprite(sprite.id)
+		}
+	}
+}
+func (pself *sceneMgr) ReloadCurrentScene() int64 {
+	return 0
+}
+func (pself *sceneMgr) UnloadCurrentScene() {
+}
+func (pself *sceneMgr) ClearPureSprites() {
+}
+func (pself *sceneMgr) CreatePureSprite(texture_path string, pos Vec2, zindex int64) {
+}
+func (pself *sceneMgr) DestroyPureSprite(id Object) {
+}
+
+func (pself *headlessWorld) createStaticSprite(texture_path string, pos Vec2, degree float64, scale Vec2, zindex int64, pivot Vec2) *hlSprite {
+	sprite := newHlSprite(pself.newId(), pos)
+	sprite.texture = texture_path
+	sprite.rot = degree * math.Pi / 180
+	sprite.scale = scale
+	sprite.zIndex = zindex
+	sprite.pivot = pivot
+	sprite.trigger.enabled = false
+	pself.addSprite(sprite)
+	return sprite
+}
+
+func (pself *sceneMgr) CreateRenderSprite(texture_path string, pos Vec2, degree float64, scale Vec2, zindex int64, pivot Vec2) Object {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.createStaticSprite(texture_path, pos, degree, scale, zindex, pivot).id
+}
+func (pself *sceneMgr) CreateStaticSprite(texture_path string, pos Vec2, degree float64, scale Vec2, zindex int64, pivot Vec2, collider_type int64, collider_pivot Vec2, collider_params Array) Object {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	sprite := world.createStaticSprite(texture_path, pos, degree, scale, zindex, pivot)
+	sprite.body.mode = hlPhysicsStatic
+	params, _ := collider_params.([]float64)
+	center := toSpxCenter(collider_pivot)
+	if collider_type == 1 { // auto
+		size := world.loadTexture(texture_path).size
+		sprite.collider.shape = hlShape{kind: hlShapeRect, center: center, size: NewVec2(size.X*math.Abs(scale.X), size.Y*math.Abs(scale.Y))}
+	} else {
+		sprite.collider.shape = colliderShape(collider_type, center, params)
+	}
+	return sprite.id
+}
+
+// colliderShape returns the shape of the given collider type of spx
+func colliderShape(collider_type int64, center Vec2, params []float64) hlShape {
+	switch {
+	case collider_type == 2 && len(params) >= 1: // circle
+		return hlShape{kind: hlShapeCircle, center: center, radius: params[0]}
+	case collider_type == 3 && len(params) >= 2: // rect
+		return hlShape{kind: hlShapeRect, center: center, size: NewVec2(params[0], params[1])}
+	case collider_type == 4 && len(params) >= 2: // capsule
+		return hlShape{kind: hlShapeCapsule, center: center, size: NewVec2(params[0]*2, params[1])}
+	case collider_type == 5 && len(params) >= 6: // polygon
+		return polygonBound(center, params)
+	}
+	return hlShape{}
+}
+
+// polygonBound approximates a polygon (Godot space points) by its bounding box
+func polygonBound(center Vec2, points []float64) hlShape {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for i := 0; i+1 < len(points); i += 2 {
+		x, y := points[i], -points[i+1]
+		minX, maxX = math.Min(minX, x), math.Max(maxX, x)
+		minY, maxY = math.Min(minY, y), math.Max(maxY, y)
+	}
+	return hlShape{
+		kind:   hlShapeRect,
+		center: center.Add(NewVec2((minX+maxX)/2, (minY+maxY)/2)),
+		size:   NewVec2(maxX-minX, maxY-minY),
+	}
+}
+
+// -----------------------------------------------------------------------------
+// tilemap
+
+type hlTileKey struct {
+	layer int64
+	x, y  int
+}
+
+type hlTileInfo struct {
+	collision bool
+	shape     hlShape // relative to the tile center
+}
+
+type hlTilemap struct {
+	tileSize float64
+	layer    int64
+	offsets  map[int64]Vec2
+	infos    map[string]hlTileInfo
+	cells    map[hlTileKey]string
+}
+
+func newHlTilemap() hlTilemap {
+	return hlTilemap{
+		tileSize: 16,
+		offsets:  make(map[int64]Vec2),
+		infos:    make(map[string]hlTileInfo),
+		cells:    make(map[hlTileKey]string),
+	}
+}
+
+func (pself *hlTilemap) keyOf(pos Vec2, layer int64) hlTileKey {
+	offset := pself.offsets[layer]
+	return hlTileKey{
+		layer: layer,
+		x:     int(math.Floor((pos.X - offset.X) / pself.tileSize)),
+		y:     int(math.Floor((pos.Y - offset.Y) / pself.tileSize)),
+	}
+}
+
+// solids returns the boxes of the tiles with collision, in a stable order
+func (pself *hlTilemap) solids() []hlSolid {
+	keys := make([]hlTileKey, 0, len(pself.cells))
+	for key := range pself.cells {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.layer != b.layer {
+			return a.layer < b.layer
+		}
+		if a.y != b.y {
+			return a.y < b.y
+		}
+		return a.x < b.x
+	})
+	var items []hlSolid
+	for _, key := range keys {
+		info := pself.infos[pself.cells[key]]
+		if !info.collision {
+			continue
+		}
+		offset := pself.offsets[key.layer]
+		center := NewVec2((float64(key.x)+0.5)*pself.tileSize, (float64(key.y)+0.5)*pself.tileSize).Add(offset)
+		items = append(items, hlSolid{box: newRectBox(center.Add(info.shape.center), info.shape.size, 0)})
+	}
+	return items
+}
+
+func positionsOf(ary Array) []Vec2 {
+	var items []Vec2
+	switch v := ary.(type) {
+	case []float32:
+		for i := 0; i+1 < len(v); i += 2 {
+			items = append(items, NewVec2(float64(v[i]), float64(v[i+1])))
+		}
+	case []float64:
+		for i := 0; i+1 < len(v); i += 2 {
+			items = append(items, NewVec2(v[i], v[i+1]))
+		}
+	}
+	return items
+}
+
+func (pself *tilemapMgr) OpenDrawTilesWithSize(tile_size int64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if tile_size > 0 {
+		world.tilemap.tileSize = float64(tile_size)
+	}
+}
+func (pself *tilemapMgr) OpenDrawTiles() {
+}
+func (pself *tilemapMgr) SetLayerIndex(index int64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.tilemap.layer = index
+}
+func (pself *tilemapMgr) SetTile(texture_path string, with_collision bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	size := world.tilemap.tileSize
+	world.tilemap.infos[texture_path] = hlTileInfo{
+		collision: with_collision,
+		shape:     hlShape{kind: hlShapeRect, size: NewVec2(size, size)},
+	}
+}
+func (pself *tilemapMgr) SetTileWithCollisionInfo(texture_path string, collision_points Array) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	var points []float64
+	for _, p := range positionsOf(collision_points) {
+		points = append(points, p.X, p.Y)
+	}
+	info := hlTileInfo{}
+	if len(points) >= 6 {
+		info = hlTileInfo{collision: true, shape: polygonBound(Vec2{}, points)}
+	}
+	world.tilemap.infos[texture_path] = info
+}
+func (pself *tilemapMgr) SetLayerOffset(index int64, offset Vec2) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.tilemap.offsets[index] = offset
+}
+func (pself *tilemapMgr) GetLayerOffset(index int64) Vec2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.tilemap.offsets[index]
+}
+func (pself *tilemapMgr) PlaceTiles(positions Array, texture_path string) {
+	world.mu.Lock()
+	layer := world.tilemap.layer
+	world.mu.Unlock()
+	pself.PlaceTilesWithLayer(positions, texture_path, layer)
+}
+func (pself *tilemapMgr) PlaceTilesWithLayer(positions Array, texture_path string, layer_index int64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	for _, pos := range positionsOf(positions) {
+		world.tilemap.cells[world.tilemap.keyOf(pos, layer_index)] = texture_path
+	}
+}
+func (pself *tilemapMgr) PlaceTile(pos Vec2, texture_path string) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.tilemap.cells[world.tilemap.keyOf(pos, world.tilemap.layer)] = texture_path
+}
+func (pself *tilemapMgr) PlaceTileWithLayer(pos Vec2, texture_path string, layer_index int64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.tilemap.cells[world.tilemap.keyOf(pos, layer_index)] = texture_path
+}
+func (pself *tilemapMgr) EraseTile(pos Vec2) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	delete(world.tilemap.cells, world.tilemap.keyOf(pos, world.tilemap.layer))
+}
+func (pself *tilemapMgr) EraseTileWithLayer(pos Vec2, layer_index int64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	delete(world.tilemap.cells, world.tilemap.keyOf(pos, layer_index))
+}
+func (pself *tilemapMgr) GetTile(pos Vec2) string {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.tilemap.cells[world.tilemap.keyOf(pos, world.tilemap.layer)]
+}
+func (pself *tilemapMgr) GetTileWithLayer(pos Vec2, layer_index int64) string {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.tilemap.cells[world.tilemap.keyOf(pos, layer_index)]
+}
+func (pself *tilemapMgr) CloseDrawTiles() {
+}
+func (pself *tilemapMgr) ExitTilemapEditorMode() {
+}
@@ -0,0 +1,503 @@
+//go:build pure_engine
+
+package wrap
+
+import (
+	"math"
+	"sort"
+
+	. "github.com/goplus/spbase/mathf"
+	. "github.com/goplus/spx/v2/pkg/gdspx/pkg/engine"
+)
+
+type hlPhysicSettings struct {
+	gravity          float64
+	friction         float64
+	airDrag          float64
+	collisionByAlpha bool
+}
+
+func newHlPhysicSettings() hlPhysicSettings {
+	return hlPhysicSettings{gravity: 1, friction: 1, airDrag: 1}
+}
+
+func (pself *hlBody) safeMass() float64 {
+	if pself.mass <= 0 {
+		return 1
+	}
+	return pself.mass
+}
+
+func maskMatch(mask, layer int64) bool {
+	return mask&layer != 0
+}
+
+// -----------------------------------------------------------------------------
+// simulation
+
+// stepPhysics moves the kinematic and dynamic bodies and updates the trigger
+// and collision pairs, the caller must hold mu.
+func (pself *headlessWorld) stepPhysics(delta float64) {
+	if delta <= 0 {
+		return
+	}
+	sprites := pself.sortedSprites()
+	for _, sprite := range sprites {
+		if !sprite.physicProcess {
+			continue
+		}
+		body := &sprite.body
+		switch body.mode {
+		case hlPhysicsDynamic:
+			if body.useGravity {
+				body.velocity.Y -= headlessGravity * pself.physic.gravity * body.gravityScale * delta
+			}
+			body.velocity = body.velocity.Add(body.force.Mulf(delta / body.safeMass()))
+			body.force = Vec2{}
+			if drag := body.drag * pself.physic.airDrag; drag > 0 {
+				body.velocity = body.velocity.Mulf(math.Max(0, 1-drag*delta))
+			}
+			if body.onFloor && body.friction > 0 {
+				decel := body.friction * pself.physic.friction * headlessGravity * delta
+				if math.Abs(body.velocity.X) <= decel {
+					body.velocity.X = 0
+				} else {
+					body.velocity.X -= math.Copysign(decel, body.velocity.X)
+				}
+			}
+			pself.moveBody(sprite, body.velocity.Mulf(delta))
+		case hlPhysicsKinematic:
+			if body.useGravity && body.gravityScale != 0 {
+				body.velocity.Y -= headlessGravity * pself.physic.gravity * body.gravityScale * delta
+			}
+			pself.moveBody(sprite, body.velocity.Mulf(delta))
+		}
+	}
+	pself.updateCollisionPairs(sprites)
+	pself.updateTriggerPairs(sprites)
+}
+
+// solids returns the boxes a body collides with
+func (pself *headlessWorld) solids(sprite *hlSprite) []hlSolid {
+	var items []hlSolid
+	for _, other := range pself.sortedSprites() {
+		if other == sprite || !maskMatch(sprite.collider.mask, other.collider.layer) {
+			continue
+		}
+		if box, ok := other.colliderBox(); ok {
+			items = append(items, hlSolid{id: other.id, box: box})
+		}
+	}
+	return append(items, pself.tilemap.solids()...)
+}
+
+type hlSolid struct {
+	id  Object // 0 for tiles
+	box hlBox
+}
+
+// axisPush returns the correction along axis moving a out of b when a moved
+// in direction dir
+func axisPush(a, b hlBox, axis int, dir float64) float64 {
+	amin, amax := a.bounds()
+	bmin, bmax := b.bounds()
+	if amax.X <= bmin.X || bmax.X <= amin.X || amax.Y <= bmin.Y || bmax.Y <= amin.Y {
+		return 0
+	}
+	if axis == 0 {
+		if dir > 0 {
+			return bmin.X - amax.X
+		}
+		return bmax.X - amin.X
+	}
+	if dir > 0 {
+		return bmin.Y - amax.Y
+	}
+	return bmax.Y - amin.Y
+}
+
+// moveBody moves sprite by motion one axis after the other, sliding along the
+// solids it hits. The caller must hold mu.
+func (pself *headlessWorld) moveBody(sprite *hlSprite, motion Vec2) {
+	body := &sprite.body
+	start := sprite.pos
+	body.onFloor, body.onWall, body.onCeiling = false, false, false
+	body.floorNormal, body.wallNormal = Vec2{}, Vec2{}
+	if _, ok := sprite.colliderBox(); !ok {
+		sprite.pos = sprite.pos.Add(motion)
+		pself.finishMove(sprite, start, motion)
+		return
+	}
+	solids := pself.solids(sprite)
+	hits := make(map[Object]bool)
+	for axis := 0; axis < 2; axis++ {
+		dir := motion.X
+		if axis == 1 {
+			dir = motion.Y
+		}
+		if dir == 0 {
+			continue
+		}
+		if axis == 0 {
+			sprite.pos.X += dir
+		} else {
+			sprite.pos.Y += dir
+		}
+		box, _ := sprite.colliderBox()
+		for _, solid := range solids {
+			push := axisPush(box, solid.box, axis, dir)
+			if push == 0 || math.Abs(push) > math.Abs(dir)+1 {
+				continue
+			}
+			if axis == 0 {
+				sprite.pos.X += push
+				body.velocity.X = 0
+				body.onWall = true
+				body.wallNormal = NewVec2(-math.Copysign(1, dir), 0)
+			} else {
+				sprite.pos.Y += push
+				body.velocity.Y = 0
+				if dir < 0 {
+					body.onFloor = true
+					body.floorNormal = NewVec2(0, 1)
+				} else {
+					body.onCeiling = true
+				}
+			}
+			if solid.id != 0 {
+				hits[solid.id] = true
+			}
+			box, _ = sprite.colliderBox()
+		}
+	}
+	// bodies which started inside a solid are pushed out along the shortest axis
+	box, _ := sprite.colliderBox()
+	for _, solid := range solids {
+		if push, ok := penetration(box, solid.box); ok && !hits[solid.id] {
+			if sprite.body.mode == hlPhysicsDynamic {
+				sprite.pos = sprite.pos.Add(push)
+				box, _ = sprite.colliderBox()
+			}
+			if solid.id != 0 {
+				hits[solid.id] = true
+			}
+		}
+	}
+	for id := range hits {
+		pself.touchBodies(sprite.id, id)
+	}
+	pself.finishMove(sprite, start, motion)
+}
+
+func (pself *headlessWorld) finishMove(sprite *hlSprite, start, motion Vec2) {
+	body := &sprite.body
+	body.positionDelta = sprite.pos.Sub(start)
+	body.lastMotion = body.positionDelta
+	if delta := pself.platform.physicDelta(); delta > 0 {
+		body.realVelocity = body.positionDelta.Divf(delta)
+	}
+}
+
+// touchBodies records a contact of this frame
+func (pself *headlessWorld) touchBodies(id, oid Object) {
+	if id > oid {
+		id, oid = oid, id
+	}
+	pself.contacts[[2]Object{id, oid}] = true
+}
+
+func (pself *headlessWorld) updateCollisionPairs(sprites []*hlSprite) {
+	// resting bodies do not move, so keep their contacts alive by overlap
+	for i, a := range sprites {
+		abox, ok := a.colliderBox()
+		if !ok {
+			continue
+		}
+		for _, b := range sprites[i+1:] {
+			if a.body.mode != hlPhysicsDynamic && a.body.mode != hlPhysicsKinematic &&
+				b.body.mode != hlPhysicsDynamic && b.body.mode != hlPhysicsKinematic {
+				continue
+			}
+			bbox, ok := b.colliderBox()
+			if !ok {
+				continue
+			}
+			if touchingBoxes(abox, bbox) {
+				pself.contacts[[2]Object{a.id, b.id}] = true
+			}
+		}
+	}
+	for _, pair := range sortedPairs(pself.contacts) {
+		if !pself.collisionPairs[pair] {
+			pself.collisionPairs[pair] = true
+			pself.postPair(callbacks.OnCollisionEnter, pair[0], pair[1])
+			pself.postPair(callbacks.OnCollisionEnter, pair[1], pair[0])
+		} else {
+			pself.postPair(callbacks.OnCollisionStay, pair[0], pair[1])
+			pself.postPair(callbacks.OnCollisionStay, pair[1], pair[0])
+		}
+	}
+	for _, pair := range sortedPairs(pself.collisionPairs) {
+		if !pself.contacts[pair] {
+			delete(pself.collisionPairs, pair)
+			pself.postPair(callbacks.OnCollisionExit, pair[0], pair[1])
+			pself.postPair(callbacks.OnCollisionExit, pair[1], pair[0])
+		}
+	}
+	clear(pself.contacts)
+}
+
+// sortedPairs keeps the order of the events stable between runs
+func sortedPairs(pairs map[[2]Object]bool) [][2]Object {
+	items := make([][2]Object, 0, len(pairs))
+	for pair := range pairs {
+		items = append(items, pair)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i][0] != items[j][0] {
+			return items[i][0] < items[j][0]
+		}
+		return items[i][1] < items[j][1]
+	})
+	return items
+}
+
+// touchingBoxes reports whether two boxes overlap or share an edge
+func touchingBoxes(a, b hlBox) bool {
+	const skin = 0.01
+	if a.circle {
+		a.radius += skin
+	} else {
+		a.half = a.half.Addf(skin)
+	}
+	return overlapBoxes(a, b)
+}
+
+func (pself *headlessWorld) updateTriggerPairs(sprites []*hlSprite) {
+	boxes := make([]hlBox, len(sprites))
+	actives := make([]bool, len(sprites))
+	for i, sprite := range sprites {
+		boxes[i], actives[i] = sprite.triggerBox()
+	}
+	current := make(map[[2]Object]bool)
+	for i, a := range sprites {
+		if !actives[i] {
+			continue
+		}
+		for j, b := range sprites {
+			if i == j || !actives[j] || !maskMatch(a.trigger.mask, b.trigger.layer) {
+				continue
+			}
+			if overlapBoxes(boxes[i], boxes[j]) {
+				current[[2]Object{a.id, b.id}] = true
+			}
+		}
+	}
+	for _, a := range sprites {
+		for _, b := range sprites {
+			pair := [2]Object{a.id, b.id}
+			switch {
+			case current[pair] && !pself.triggerPairs[pair]:
+				pself.triggerPairs[pair] = true
+				pself.postPair(callbacks.OnTriggerEnter, a.id, b.id)
+			case current[pair]:
+				pself.postPair(callbacks.OnTriggerStay, a.id, b.id)
+			case pself.triggerPairs[pair]:
+				delete(pself.triggerPairs, pair)
+				pself.postPair(callbacks.OnTriggerExit, a.id, b.id)
+			}
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// queries
+
+type hlRayHit struct {
+	id     Object
+	t      float64
+	pos    Vec2
+	normal Vec2
+}
+
+// raycast returns the nearest hit along the segment, the caller must hold mu
+func (pself *headlessWorld) raycast(from, to Vec2, ignores map[Object]bool, mask int64, areas, bodies bool) (hlRayHit, bool) {
+	best := hlRayHit{t: math.Inf(1)}
+	found := false
+	test := func(id Object, box hlBox) {
+		if t, normal, ok := box.raycast(from, to); ok && t < best.t {
+			best = hlRayHit{id: id, t: t, pos: from.Lerp(to, t), normal: normal}
+			found = true
+		}
+	}
+	for _, sprite := range pself.sortedSprites() {
+		if ignores[sprite.id] {
+			continue
+		}
+		if bodies && maskMatch(mask, sprite.collider.layer) {
+			if box, ok := sprite.colliderBox(); ok {
+				test(sprite.id, box)
+			}
+		}
+		if areas && maskMatch(mask, sprite.trigger.layer) {
+			if box, ok := sprite.triggerBox(); ok {
+				test(sprite.id, box)
+			}
+		}
+	}
+	if bodies {
+		for _, solid := range pself.tilemap.solids() {
+			test(0, solid.box)
+		}
+	}
+	return best, found
+}
+
+// overlapQuery returns the ids of the sprites whose collider or trigger
+// overlaps box, the caller must hold mu
+func (pself *headlessWorld) overlapQuery(box hlBox, mask int64) []Object {
+	ids := make([]Object, 0)
+	for _, sprite := range pself.sortedSprites() {
+		hit := false
+		if b, ok := sprite.colliderBox(); ok && maskMatch(mask, sprite.collider.layer) {
+			hit = overlapBoxes(box, b)
+		}
+		if b, ok := sprite.triggerBox(); !hit && ok && maskMatch(mask, sprite.trigger.layer) {
+			hit = overlapBoxes(box, b)
+		}
+		if hit {
+			ids = append(ids, sprite.id)
+		}
+	}
+	return ids
+}
+
+func toIdSet(ary Array) map[Object]bool {
+	set := make(map[Object]bool)
+	switch v := ary.(type) {
+	case []int64:
+		for _, id := range v {
+			set[id] = true
+		}
+	case []any:
+		for _, id := range v {
+			if i, ok := id.(int64); ok {
+				set[i] = true
+			}
+		}
+	}
+	return set
+}
+
+// -----------------------------------------------------------------------------
+// IPhysicMgr
+
+func (pself *physicMgr) Raycast(from Vec2, to Vec2, collision_mask int64) Object {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	hit, _ := world.raycast(from, to, nil, collision_mask, false, true)
+	return hit.id
+}
+func (pself *physicMgr) CheckCollision(from Vec2, to Vec2, collision_mask int64, collide_with_areas bool, collide_with_bodies bool) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	_, ok := world.raycast(from, to, nil, collision_mask, collide_with_areas, collide_with_bodies)
+	return ok
+}
+func (pself *physicMgr) CheckTouchedCameraBoundaries(obj Object) int64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	sprite := world.getSprite(obj)
+	if sprite == nil {
+		return 0
+	}
+	box, ok := sprite.visualBox(world)
+	if !ok {
+		box, ok = sprite.triggerBox()
+	}
+	if !ok {
+		return 0
+	}
+	min, max := box.bounds()
+	rect := world.camera.spxRect(world.platform.windowSize)
+	left, bottom := rect.Position.X, rect.Position.Y
+	right, top := left+rect.Size.X, bottom+rect.Size.Y
+	var ret int64
+	if min.X <= left {
+		ret |= 1
+	}
+	if max.Y >= top {
+		ret |= 2
+	}
+	if max.X >= right {
+		ret |= 4
+	}
+	if min.Y <= bottom {
+		ret |= 8
+	}
+	return ret
+}
+func (pself *physicMgr) CheckTouchedCameraBoundary(obj Object, board_type int64) bool {
+	return pself.CheckTouchedCameraBoundaries(obj)&(1<<board_type) != 0
+}
+func (pself *physicMgr) SetCollisionSystemType(is_collision_by_alpha bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.physic.collisionByAlpha = is_collision_by_alpha
+}
+func (pself *physicMgr) SetGlobalGravity(gravity float64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.physic.gravity = gravity
+}
+func (pself *physicMgr) GetGlobalGravity() float64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.physic.gravity
+}
+func (pself *physicMgr) SetGlobalFriction(friction float64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.physic.friction = friction
+}
+func (pself *physicMgr) GetGlobalFriction() float64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.physic.friction
+}
+func (pself *physicMgr) SetGlobalAirDrag(air_drag float64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.physic.airDrag = air_drag
+}
+func (pself *physicMgr) GetGlobalAirDrag() float64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.physic.airDrag
+}
+func (pself *physicMgr) CheckCollisionRect(pos Vec2, size Vec2, collision_mask int64) Array {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.overlapQuery(newRectBox(pos, size, 0), collision_mask)
+}
+func (pself *physicMgr) CheckCollisionCircle(pos Vec2, radius float64, collision_mask int64) Array {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.overlapQuery(newCircleBox(pos, radius), collision_mask)
+}
+func (pself *physicMgr) RaycastWithDetails(from Vec2, to Vec2, ignore_sprites Array, collision_mask int64, collide_with_areas bool, collide_with_bodies bool) Array {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	hit, ok := world.raycast(from, to, toIdSet(ignore_sprites), collision_mask, collide_with_areas, collide_with_bodies)
+	if !ok {
+		return make([]int64, 6)
+	}
+	return []int64{
+		1,
+		hit.id,
+		int64(hit.pos.X * Float2IntFactor),
+		int64(hit.pos.Y * Float2IntFactor),
+		int64(hit.normal.X * Float2IntFactor),
+		int64(hit.normal.Y * Float2IntFactor),
+	}
+}
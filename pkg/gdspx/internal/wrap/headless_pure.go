@@ -0,0 +1,380 @@
+//go:build pure_engine
+
+package wrap
+
+import (
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	. "github.com/goplus/spbase/mathf"
+	. "github.com/goplus/spx/v2/pkg/gdspx/pkg/engine"
+)
+
+const (
+	headlessFrameRate = 60
+	// headlessGravity matches Godot's default 2D gravity (pixels/s²)
+	headlessGravity = 980.0
+)
+
+// headlessWorld is the in-memory replacement of the Godot scene tree used by
+// pure engine builds. Managers may be called from any goroutine, so every
+// access goes through mu. Engine callbacks are never invoked while mu is held:
+// they are queued in pending and dispatched by the frame loop.
+type headlessWorld struct {
+	mu sync.Mutex
+
+	nextId    Object
+	sprites   map[Object]*hlSprite
+	spriteIds []Object // sorted, keeps iteration deterministic
+	uiNodes   map[Object]*hlUiNode
+	uiBinds   map[string]Object
+	anims     map[string]*hlAnim
+	textures  map[string]*hlTexture
+	audios    map[Object]*hlAudioSource
+	playings  map[int64]*hlAudioPlaying
+	nextAudio int64
+	pens      map[Object]bool
+	tilemap   hlTilemap
+	pathGrid  hlPathGrid
+
+	triggerPairs   map[[2]Object]bool
+	collisionPairs map[[2]Object]bool
+	contacts       map[[2]Object]bool // body contacts of the current frame
+
+	input    hlInput
+	camera   hlCamera
+	platform hlPlatform
+	physic   hlPhysicSettings
+
+	pending []func()
+
+	frame          int64
+	paused         bool
+	stepOnce       bool
+	manualStep     bool
+	exited         bool
+	exitCode       int64
+	resetRequested bool
+	layerSortMode  int64
+	loadDirect     bool
+}
+
+var world = newHeadlessWorld()
+
+func newHeadlessWorld() *headlessWorld {
+	return &headlessWorld{
+		nextId:         1,
+		sprites:        make(map[Object]*hlSprite),
+		uiNodes:        make(map[Object]*hlUiNode),
+		uiBinds:        make(map[string]Object),
+		anims:          make(map[string]*hlAnim),
+		textures:       make(map[string]*hlTexture),
+		audios:         make(map[Object]*hlAudioSource),
+		playings:       make(map[int64]*hlAudioPlaying),
+		pens:           make(map[Object]bool),
+		tilemap:        newHlTilemap(),
+		triggerPairs:   make(map[[2]Object]bool),
+		collisionPairs: make(map[[2]Object]bool),
+		contacts:       make(map[[2]Object]bool),
+		input:          newHlInput(),
+		camera:         newHlCamera(),
+		platform:       newHlPlatform(),
+		physic:         newHlPhysicSettings(),
+	}
+}
+
+func (pself *headlessWorld) newId() Object {
+	id := pself.nextId
+	pself.nextId++
+	return id
+}
+
+// post queues an engine callback, the caller must hold mu
+func (pself *headlessWorld) post(call func()) {
+	pself.pending = append(pself.pending, call)
+}
+
+func (pself *headlessWorld) postId(cb func(int64), id Object) {
+	if cb == nil {
+		return
+	}
+	pself.post(func() { cb(id) })
+}
+
+func (pself *headlessWorld) postPair(cb func(int64, int64), id, oid Object) {
+	if cb == nil {
+		return
+	}
+	pself.post(func() { cb(id, oid) })
+}
+
+// flush dispatches the queued callbacks on the calling goroutine. Callbacks
+// may queue new ones, those are dispatched in the same flush.
+func (pself *headlessWorld) flush() {
+	for {
+		pself.mu.Lock()
+		calls := pself.pending
+		pself.pending = nil
+		pself.mu.Unlock()
+		if len(calls) == 0 {
+			return
+		}
+		for _, call := range calls {
+			call()
+		}
+	}
+}
+
+// step advances the world by one frame. It returns false once the game
+// requested to exit.
+func (pself *headlessWorld) step(delta float64) bool {
+	pself.mu.Lock()
+	if pself.exited {
+		pself.mu.Unlock()
+		return false
+	}
+	simulate := !pself.paused || pself.stepOnce
+	pself.stepOnce = false
+	scaled := delta * pself.platform.timeScale
+	pself.platform.delta = scaled
+	if simulate {
+		pself.frame++
+	}
+	pself.mu.Unlock()
+
+	// ready/destroyed notifications and injected input of the last frame
+	pself.flush()
+	if !simulate {
+		return pself.checkRunning()
+	}
+
+	if callbacks.OnEngineFixedUpdate != nil {
+		callbacks.OnEngineFixedUpdate(scaled)
+	}
+	pself.mu.Lock()
+	pself.stepPhysics(scaled)
+	pself.stepAnimations(scaled)
+	pself.stepAudios(scaled)
+	pself.mu.Unlock()
+	pself.flush()
+
+	if callbacks.OnEngineUpdate != nil {
+		callbacks.OnEngineUpdate(scaled)
+	}
+	pself.flush()
+
+	pself.mu.Lock()
+	pself.input.endFrame()
+	pself.mu.Unlock()
+	return pself.checkRunning()
+}
+
+func (pself *headlessWorld) checkRunning() bool {
+	pself.mu.Lock()
+	reset := pself.resetRequested
+	pself.resetRequested = false
+	pself.mu.Unlock()
+	if reset && callbacks.OnEngineReset != nil {
+		callbacks.OnEngineReset()
+	}
+
+	pself.mu.Lock()
+	defer pself.mu.Unlock()
+	return !pself.exited
+}
+
+// sortedSprites returns the live sprites ordered by id, the caller must hold mu
+func (pself *headlessWorld) sortedSprites() []*hlSprite {
+	items := make([]*hlSprite, 0, len(pself.spriteIds))
+	for _, id := range pself.spriteIds {
+		if sprite, ok := pself.sprites[id]; ok {
+			items = append(items, sprite)
+		}
+	}
+	return items
+}
+
+func (pself *headlessWorld) addSprite(sprite *hlSprite) {
+	pself.sprites[sprite.id] = sprite
+	idx := sort.Search(len(pself.spriteIds), func(i int) bool { return pself.spriteIds[i] >= sprite.id })
+	pself.spriteIds = append(pself.spriteIds, 0)
+	copy(pself.spriteIds[idx+1:], pself.spriteIds[idx:])
+	pself.spriteIds[idx] = sprite.id
+	pself.postId(callbacks.OnSpriteReady, sprite.id)
+}
+
+func (pself *headlessWorld) removeSprite(id Object) bool {
+	if _, ok := pself.sprites[id]; !ok {
+		return false
+	}
+	delete(pself.sprites, id)
+	idx := sort.Search(len(pself.spriteIds), func(i int) bool { return pself.spriteIds[i] >= id })
+	if idx < len(pself.spriteIds) && pself.spriteIds[idx] == id {
+		pself.spriteIds = append(pself.spriteIds[:idx], pself.spriteIds[idx+1:]...)
+	}
+	for pair := range pself.triggerPairs {
+		if pair[0] == id || pair[1] == id {
+			delete(pself.triggerPairs, pair)
+		}
+	}
+	for pair := range pself.collisionPairs {
+		if pair[0] == id || pair[1] == id {
+			delete(pself.collisionPairs, pair)
+		}
+	}
+	pself.postId(callbacks.OnSpriteDestroyed, id)
+	return true
+}
+
+func (pself *headlessWorld) getSprite(id Object) *hlSprite {
+	return pself.sprites[id]
+}
+
+// -----------------------------------------------------------------------------
+// frame loop
+
+func runHeadlessLoop() {
+	if callbacks.OnEngineStart != nil {
+		callbacks.OnEngineStart()
+	}
+	delta := 1.0 / headlessFrameRate
+	interval := time.Second / headlessFrameRate
+	next := time.Now()
+	for world.step(delta) {
+		next = next.Add(interval)
+		if wait := time.Until(next); wait > 0 {
+			time.Sleep(wait)
+		} else {
+			next = time.Now()
+		}
+	}
+	if callbacks.OnEngineDestroy != nil {
+		callbacks.OnEngineDestroy()
+	}
+	code, _ := ExitStatus()
+	os.Exit(int(code))
+}
+
+// SetManualStep disables the built-in frame loop. The caller is then
+// responsible for driving frames with Step, which is what tests and
+// recorders want.
+func SetManualStep(enabled bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.manualStep = enabled
+}
+
+func isManualStep() bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.manualStep
+}
+
+// Step advances the headless engine by one frame of delta seconds (before
+// time scale). It returns false once the game requested to exit.
+func Step(delta float64) bool {
+	return world.step(delta)
+}
+
+// Frame returns the number of simulated frames.
+func Frame() int64 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.frame
+}
+
+// ExitStatus reports whether the game requested to exit and with which code.
+func ExitStatus() (code int64, exited bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.exitCode, world.exited
+}
+
+// InjectKey changes the state of a key as if it was pressed or released by
+// the user. The key callbacks are delivered at the beginning of next frame.
+func InjectKey(key int64, pressed bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if world.input.setKey(key, pressed) {
+		if pressed {
+			world.postId(callbacks.OnKeyPressed, key)
+		} else {
+			world.postId(callbacks.OnKeyReleased, key)
+		}
+	}
+}
+
+// InjectMouseButton changes the state of a mouse button.
+func InjectMouseButton(button int64, pressed bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if world.input.setMouseButton(button, pressed) {
+		if pressed {
+			world.postId(callbacks.OnMousePressed, button)
+		} else {
+			world.postId(callbacks.OnMouseReleased, button)
+		}
+	}
+}
+
+// InjectMousePos moves the mouse cursor to pos (world space, y up).
+func InjectMousePos(pos Vec2) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.input.mousePos = pos
+}
+
+// InjectGamepad connects or disconnects the gamepad device.
+func InjectGamepad(device int64, connected bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if connected {
+		world.input.gamepad(device)
+	} else {
+		delete(world.input.gamepads, device)
+	}
+}
+
+// InjectGamepadButton presses or releases a button of the gamepad device,
+// connecting it if needed.
+func InjectGamepadButton(device, button int64, pressed bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	pad := world.input.gamepad(device)
+	if pressed {
+		pad.buttons |= 1 << button
+	} else {
+		pad.buttons &^= 1 << button
+	}
+}
+
+// InjectGamepadAxis moves an axis of the gamepad device to value, in
+// [-1, 1], connecting it if needed.
+func InjectGamepadAxis(device, axis int64, value float64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.input.gamepad(device).axes[axis] = value
+}
+
+// InjectTouch puts the finger id down at pos (world space, y up), moves it
+// there if it is already down, or lifts it if pressed is false.
+func InjectTouch(id int64, pos Vec2, pressed bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if pressed {
+		world.input.touches[id] = pos
+	} else {
+		delete(world.input.touches, id)
+	}
+}
+
+// InjectMouseWheel scrolls the mouse wheel by delta notches during the
+// current frame, y up.
+func InjectMouseWheel(delta Vec2) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.input.wheel.X += delta.X
+	world.input.wheel.Y += delta.Y
+}
@@ -0,0 +1,387 @@
+//go:build pure_engine
+
+package wrap
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	. "github.com/goplus/spbase/mathf"
+	. "github.com/goplus/spx/v2/pkg/gdspx/pkg/engine"
+)
+
+// resolvePath maps an engine resource path to the file system. Asset paths
+// are relative to the Godot project, which lives one level below the spx
+// project, while the headless binary runs in the spx project itself.
+func resolvePath(path string) string {
+	path = strings.TrimPrefix(path, "res://")
+	if _, err := os.Stat(path); err == nil {
+		return path
+	}
+	if trimmed := strings.TrimPrefix(path, "../"); trimmed != path {
+		if _, err := os.Stat(trimmed); err == nil {
+			return trimmed
+		}
+	}
+	return path
+}
+
+// -----------------------------------------------------------------------------
+// textures
+
+type hlTexture struct {
+	img    image.Image
+	size   Vec2
+	bounds map[Rect2]Rect2
+}
+
+// loadTexture returns the cached texture of path, the caller must hold mu.
+// Missing or undecodable files yield an empty texture.
+func (pself *headlessWorld) loadTexture(path string) *hlTexture {
+	if tex, ok := pself.textures[path]; ok {
+		return tex
+	}
+	tex := &hlTexture{bounds: make(map[Rect2]Rect2)}
+	pself.textures[path] = tex
+	file := resolvePath(path)
+	if strings.HasSuffix(strings.ToLower(file), ".svg") {
+		if data, err := os.ReadFile(file); err == nil {
+			tex.size = parseSvgSize(string(data))
+		}
+		return tex
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return tex
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return tex
+	}
+	tex.img = img
+	b := img.Bounds()
+	tex.size = NewVec2(float64(b.Dx()), float64(b.Dy()))
+	return tex
+}
+
+// alphaBound returns the used rect of region in pixels, relative to region
+func (pself *hlTexture) alphaBound(region Rect2) Rect2 {
+	if bound, ok := pself.bounds[region]; ok {
+		return bound
+	}
+	bound := NewRect2(0, 0, region.Size.X, region.Size.Y)
+	if pself.img != nil {
+		origin := pself.img.Bounds().Min
+		x0, y0 := int(region.Position.X), int(region.Position.Y)
+		w, h := int(region.Size.X), int(region.Size.Y)
+		minX, minY, maxX, maxY := w, h, -1, -1
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				if _, _, _, a := pself.img.At(origin.X+x0+x, origin.Y+y0+y).RGBA(); a == 0 {
+					continue
+				}
+				minX, maxX = min(minX, x), max(maxX, x)
+				minY, maxY = min(minY, y), max(maxY, y)
+			}
+		}
+		if maxX < 0 {
+			bound = Rect2{}
+		} else {
+			bound = NewRect2(float64(minX), float64(minY), float64(maxX-minX+1), float64(maxY-minY+1))
+		}
+	}
+	pself.bounds[region] = bound
+	return bound
+}
+
+// alphaHull returns the convex hull of the opaque pixels of the texture, as
+// flattened points in pixels, y down. The outline of the engine may be
+// concave, the headless world approximates shapes anyway.
+func (pself *hlTexture) alphaHull() []float64 {
+	if pself.img == nil {
+		bound := pself.alphaBound(NewRect2(0, 0, pself.size.X, pself.size.Y))
+		x0, y0 := bound.Position.X, bound.Position.Y
+		x1, y1 := x0+bound.Size.X, y0+bound.Size.Y
+		return []float64{x0, y0, x1, y0, x1, y1, x0, y1}
+	}
+	// the left and right edges of the opaque pixels of each row
+	b := pself.img.Bounds()
+	var points []Vec2
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		left, right := -1, -1
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if _, _, _, a := pself.img.At(x, y).RGBA(); a != 0 {
+				if left < 0 {
+					left = x
+				}
+				right = x
+			}
+		}
+		if left >= 0 {
+			fy := float64(y - b.Min.Y)
+			fl, fr := float64(left-b.Min.X), float64(right-b.Min.X+1)
+			points = append(points, NewVec2(fl, fy), NewVec2(fr, fy), NewVec2(fl, fy+1), NewVec2(fr, fy+1))
+		}
+	}
+	hull := convexHull(points)
+	ret := make([]float64, 0, len(hull)*2)
+	for _, p := range hull {
+		ret = append(ret, p.X, p.Y)
+	}
+	return ret
+}
+
+// convexHull returns the convex hull of points, by the monotone chain algorithm
+func convexHull(points []Vec2) []Vec2 {
+	if len(points) < 3 {
+		return points
+	}
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].X < points[j].X || points[i].X == points[j].X && points[i].Y < points[j].Y
+	})
+	cross := func(o, a, b Vec2) float64 {
+		return a.Sub(o).Cross(b.Sub(o))
+	}
+	hull := make([]Vec2, 0, 2*len(points))
+	for _, p := range points {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	for i, lower := len(points)-2, len(hull)+1; i >= 0; i-- {
+		p := points[i]
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	return hull[:len(hull)-1]
+}
+
+var (
+	svgWidthRe   = regexp.MustCompile(`<svg[^>]*?\swidth="([0-9.]+)`)
+	svgHeightRe  = regexp.MustCompile(`<svg[^>]*?\sheight="([0-9.]+)`)
+	svgViewBoxRe = regexp.MustCompile(`<svg[^>]*?\sviewBox="[-0-9.]+[ ,]+[-0-9.]+[ ,]+([0-9.]+)[ ,]+([0-9.]+)"`)
+)
+
+func parseSvgSize(data string) Vec2 {
+	parse := func(re *regexp.Regexp, idx int) float64 {
+		if m := re.FindStringSubmatch(data); m != nil {
+			v, _ := strconv.ParseFloat(m[idx], 64)
+			return v
+		}
+		return 0
+	}
+	w, h := parse(svgWidthRe, 1), parse(svgHeightRe, 1)
+	if w == 0 || h == 0 {
+		w, h = parse(svgViewBoxRe, 1), parse(svgViewBoxRe, 2)
+	}
+	return NewVec2(w, h)
+}
+
+// -----------------------------------------------------------------------------
+// animations
+
+type hlAnimFrame struct {
+	path  string
+	atlas Rect2
+}
+
+type hlAnim struct {
+	frames  []hlAnimFrame
+	isAtlas bool
+	fps     float64
+}
+
+type hlAnimPayload struct {
+	BasePath string            `json:"base_path"`
+	Frames   []json.RawMessage `json:"frames"`
+}
+
+func parseAnim(ctx string, fps int64, isAtlas bool) (*hlAnim, error) {
+	var payload hlAnimPayload
+	if err := json.Unmarshal([]byte(ctx), &payload); err != nil {
+		return nil, err
+	}
+	anim := &hlAnim{isAtlas: isAtlas, fps: float64(fps)}
+	for _, raw := range payload.Frames {
+		var frame struct {
+			Path       string
+			X, Y, W, H float64
+		}
+		if err := json.Unmarshal(raw, &frame); err != nil {
+			return nil, err
+		}
+		if isAtlas {
+			anim.frames = append(anim.frames, hlAnimFrame{path: payload.BasePath, atlas: NewRect2(frame.X, frame.Y, frame.W, frame.H)})
+		} else {
+			anim.frames = append(anim.frames, hlAnimFrame{path: frame.Path})
+		}
+	}
+	return anim, nil
+}
+
+func animKey(typeName, animName string) string {
+	return typeName + ":" + animName
+}
+
+// findAnim returns the animation the sprite is set to, the caller must hold mu
+func (pself *headlessWorld) findAnim(sprite *hlSprite) *hlAnim {
+	anim := pself.anims[animKey(sprite.typeName, sprite.anim.name)]
+	if anim == nil || len(anim.frames) == 0 {
+		return nil
+	}
+	return anim
+}
+
+func (pself *headlessWorld) applyAnimFrame(sprite *hlSprite, anim *hlAnim) {
+	idx := sprite.anim.frame
+	if idx < 0 || idx >= int64(len(anim.frames)) {
+		return
+	}
+	frame := anim.frames[idx]
+	sprite.texture = frame.path
+	sprite.atlas = frame.atlas
+	sprite.isAtlas = anim.isAtlas
+}
+
+// stepAnimations advances the playing animations, the caller must hold mu
+func (pself *headlessWorld) stepAnimations(delta float64) {
+	for _, sprite := range pself.sortedSprites() {
+		state := &sprite.anim
+		if !state.playing || !sprite.process {
+			continue
+		}
+		anim := pself.findAnim(sprite)
+		if anim == nil {
+			state.playing = false
+			pself.postId(callbacks.OnSpriteAnimationFinished, sprite.id)
+			continue
+		}
+		state.elapsed += delta * anim.fps * state.speed * state.speedScale
+		count := int64(len(anim.frames))
+		for state.playing && state.elapsed >= 1 {
+			state.elapsed--
+			next := state.frame + 1
+			if state.backwards {
+				next = state.frame - 1
+			}
+			if next < 0 || next >= count {
+				if !state.loop {
+					state.playing = false
+					state.elapsed = 0
+					pself.postId(callbacks.OnSpriteAnimationFinished, sprite.id)
+					break
+				}
+				next = (next + count) % count
+				pself.postId(callbacks.OnSpriteAnimationLooped, sprite.id)
+			}
+			state.frame = next
+			pself.applyAnimFrame(sprite, anim)
+			pself.postId(callbacks.OnSpriteFrameChanged, sprite.id)
+		}
+	}
+}
+
+// -----------------------------------------------------------------------------
+// audio
+
+// wavDuration returns the length in seconds of a PCM wav file, or 0 when the
+// format is not understood
+func wavDuration(path string) float64 {
+	data, err := os.ReadFile(resolvePath(path))
+	if err != nil || len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		return 0
+	}
+	var byteRate uint32
+	for pos := 12; pos+8 <= len(data); {
+		id := string(data[pos : pos+4])
+		size := binary.LittleEndian.Uint32(data[pos+4 : pos+8])
+		body := pos + 8
+		switch id {
+		case "fmt ":
+			if body+12 <= len(data) {
+				byteRate = binary.LittleEndian.Uint32(data[body+8 : body+12])
+			}
+		case "data":
+			if byteRate == 0 {
+				return 0
+			}
+			return float64(size) / float64(byteRate)
+		}
+		pos = body + int(size) + int(size&1)
+	}
+	return 0
+}
+
+// -----------------------------------------------------------------------------
+// IResMgr
+
+func (pself *resMgr) CreateAnimation(p_sprite_type string, p_anim_name string, p_json_ctx string, fps int64, is_atlas bool) {
+	anim, err := parseAnim(p_json_ctx, fps, is_atlas)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "headless: invalid animation %s.%s: %v\n", p_sprite_type, p_anim_name, err)
+		return
+	}
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.anims[animKey(p_sprite_type, p_anim_name)] = anim
+}
+func (pself *resMgr) SetLoadMode(is_direct_mode bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	world.loadDirect = is_direct_mode
+}
+func (pself *resMgr) GetLoadMode() bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.loadDirect
+}
+func (pself *resMgr) GetBoundFromAlpha(p_path string) Rect2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	tex := world.loadTexture(p_path)
+	return tex.alphaBound(NewRect2(0, 0, tex.size.X, tex.size.Y))
+}
+func (pself *resMgr) GetPolygonFromAlpha(p_path string) Array {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.loadTexture(p_path).alphaHull()
+}
+func (pself *resMgr) GetImageSize(p_path string) Vec2 {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.loadTexture(p_path).size
+}
+func (pself *resMgr) ReadAllText(p_path string) string {
+	data, err := os.ReadFile(resolvePath(p_path))
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+func (pself *resMgr) HasFile(p_path string) bool {
+	_, err := os.Stat(resolvePath(p_path))
+	return err == nil
+}
+func (pself *resMgr) ReloadTexture(path string) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	delete(world.textures, path)
+}
+func (pself *resMgr) FreeStr(str string) {
+}
+func (pself *resMgr) SetDefaultFont(font_path string) {
+}
@@ -0,0 +1,256 @@
+//go:build pure_engine
+
+package wrap
+
+import (
+	"math"
+
+	. "github.com/goplus/spbase/mathf"
+)
+
+// Shapes of the headless world live in spx space (y axis up). Rects are
+// oriented boxes, circles are exact and capsules, polygons and compound shapes
+// are approximated by their bounding box, which is all the precision the
+// touching/raycast queries of spx need.
+
+type hlShapeKind int
+
+const (
+	hlShapeNone hlShapeKind = iota
+	hlShapeRect
+	hlShapeCircle
+	hlShapeCapsule
+)
+
+// hlShape is a shape in the local space of a sprite
+type hlShape struct {
+	kind   hlShapeKind
+	center Vec2
+	size   Vec2
+	radius float64
+}
+
+// bound returns the local rect bounding the shape
+func (pself hlShape) bound() (min, max Vec2) {
+	half := pself.size.Divf(2)
+	if pself.kind == hlShapeCircle {
+		half = NewVec2(pself.radius, pself.radius)
+	}
+	return pself.center.Sub(half), pself.center.Add(half)
+}
+
+// union returns a shape made of pself and other, approximated by the rect
+// bounding both
+func (pself hlShape) union(other hlShape) hlShape {
+	if pself.kind == hlShapeNone {
+		return other
+	}
+	if other.kind == hlShapeNone {
+		return pself
+	}
+	amin, amax := pself.bound()
+	bmin, bmax := other.bound()
+	min := NewVec2(math.Min(amin.X, bmin.X), math.Min(amin.Y, bmin.Y))
+	max := NewVec2(math.Max(amax.X, bmax.X), math.Max(amax.Y, bmax.Y))
+	return hlShape{kind: hlShapeRect, center: min.Add(max).Divf(2), size: max.Sub(min)}
+}
+
+// hlBox is a shape placed in the world, either a circle or an oriented box
+type hlBox struct {
+	circle bool
+	center Vec2
+	half   Vec2
+	angle  float64 // counter-clockwise, radians
+	radius float64
+}
+
+func newRectBox(center, size Vec2, angle float64) hlBox {
+	return hlBox{center: center, half: NewVec2(math.Abs(size.X)/2, math.Abs(size.Y)/2), angle: angle}
+}
+
+func newCircleBox(center Vec2, radius float64) hlBox {
+	return hlBox{circle: true, center: center, radius: math.Abs(radius)}
+}
+
+func (pself hlBox) axes() (Vec2, Vec2) {
+	sin, cos := math.Sincos(pself.angle)
+	return NewVec2(cos, sin), NewVec2(-sin, cos)
+}
+
+// toLocal returns point in the box frame
+func (pself hlBox) toLocal(point Vec2) Vec2 {
+	u, v := pself.axes()
+	d := point.Sub(pself.center)
+	return NewVec2(d.Dot(u), d.Dot(v))
+}
+
+func (pself hlBox) toWorldDir(dir Vec2) Vec2 {
+	u, v := pself.axes()
+	return u.Mulf(dir.X).Add(v.Mulf(dir.Y))
+}
+
+// bounds returns the axis aligned bounding box
+func (pself hlBox) bounds() (min, max Vec2) {
+	if pself.circle {
+		r := NewVec2(pself.radius, pself.radius)
+		return pself.center.Sub(r), pself.center.Add(r)
+	}
+	u, v := pself.axes()
+	ext := NewVec2(
+		math.Abs(u.X)*pself.half.X+math.Abs(v.X)*pself.half.Y,
+		math.Abs(u.Y)*pself.half.X+math.Abs(v.Y)*pself.half.Y,
+	)
+	return pself.center.Sub(ext), pself.center.Add(ext)
+}
+
+func (pself hlBox) contains(point Vec2) bool {
+	if pself.circle {
+		return point.DistanceSquaredTo(pself.center) <= pself.radius*pself.radius
+	}
+	local := pself.toLocal(point)
+	return math.Abs(local.X) <= pself.half.X && math.Abs(local.Y) <= pself.half.Y
+}
+
+// closestPoint returns the point of the box nearest to point
+func (pself hlBox) closestPoint(point Vec2) Vec2 {
+	local := pself.toLocal(point)
+	local.X = math.Max(-pself.half.X, math.Min(pself.half.X, local.X))
+	local.Y = math.Max(-pself.half.Y, math.Min(pself.half.Y, local.Y))
+	return pself.center.Add(pself.toWorldDir(local))
+}
+
+// projectRadius returns the half length of the box projected on axis
+func (pself hlBox) projectRadius(axis Vec2) float64 {
+	u, v := pself.axes()
+	return pself.half.X*math.Abs(u.Dot(axis)) + pself.half.Y*math.Abs(v.Dot(axis))
+}
+
+func overlapBoxes(a, b hlBox) bool {
+	switch {
+	case a.circle && b.circle:
+		r := a.radius + b.radius
+		return a.center.DistanceSquaredTo(b.center) <= r*r
+	case a.circle:
+		return b.closestPoint(a.center).DistanceSquaredTo(a.center) <= a.radius*a.radius
+	case b.circle:
+		return a.closestPoint(b.center).DistanceSquaredTo(b.center) <= b.radius*b.radius
+	}
+	au, av := a.axes()
+	bu, bv := b.axes()
+	d := b.center.Sub(a.center)
+	for _, axis := range [4]Vec2{au, av, bu, bv} {
+		if math.Abs(d.Dot(axis)) > a.projectRadius(axis)+b.projectRadius(axis) {
+			return false
+		}
+	}
+	return true
+}
+
+// penetration returns the minimal translation moving a out of b along the
+// world axes, it is computed on the bounding boxes except for two circles.
+func penetration(a, b hlBox) (Vec2, bool) {
+	if a.circle && b.circle {
+		d := a.center.Sub(b.center)
+		dist := d.Length()
+		depth := a.radius + b.radius - dist
+		if depth <= 0 {
+			return Vec2{}, false
+		}
+		if dist == 0 {
+			return NewVec2(0, depth), true
+		}
+		return d.Divf(dist).Mulf(depth), true
+	}
+	amin, amax := a.bounds()
+	bmin, bmax := b.bounds()
+	dx1, dx2 := bmax.X-amin.X, amax.X-bmin.X
+	dy1, dy2 := bmax.Y-amin.Y, amax.Y-bmin.Y
+	if dx1 <= 0 || dx2 <= 0 || dy1 <= 0 || dy2 <= 0 {
+		return Vec2{}, false
+	}
+	push := NewVec2(dx1, 0)
+	if dx2 < dx1 {
+		push = NewVec2(-dx2, 0)
+	}
+	if math.Min(dy1, dy2) < math.Abs(push.X) {
+		push = NewVec2(0, dy1)
+		if dy2 < dy1 {
+			push = NewVec2(0, -dy2)
+		}
+	}
+	return push, true
+}
+
+// raycast returns the fraction of the segment where it enters the box and the
+// surface normal at that point.
+func (pself hlBox) raycast(from, to Vec2) (float64, Vec2, bool) {
+	if pself.circle {
+		d := to.Sub(from)
+		f := from.Sub(pself.center)
+		a := d.Dot(d)
+		c := f.Dot(f) - pself.radius*pself.radius
+		if c <= 0 {
+			return 0, NewVec2(0, 0), true
+		}
+		if a == 0 {
+			return 0, Vec2{}, false
+		}
+		b := 2 * f.Dot(d)
+		disc := b*b - 4*a*c
+		if disc < 0 {
+			return 0, Vec2{}, false
+		}
+		t := (-b - math.Sqrt(disc)) / (2 * a)
+		if t < 0 || t > 1 {
+			return 0, Vec2{}, false
+		}
+		hit := from.Add(d.Mulf(t))
+		return t, hit.Sub(pself.center).Normalize(), true
+	}
+	lfrom := pself.toLocal(from)
+	lto := pself.toLocal(to)
+	d := lto.Sub(lfrom)
+	tmin, tmax := 0.0, 1.0
+	normal := Vec2{}
+	inside := true
+	for axis := 0; axis < 2; axis++ {
+		var o, dir, half float64
+		if axis == 0 {
+			o, dir, half = lfrom.X, d.X, pself.half.X
+		} else {
+			o, dir, half = lfrom.Y, d.Y, pself.half.Y
+		}
+		if o < -half || o > half {
+			inside = false
+		}
+		if math.Abs(dir) < 1e-12 {
+			if o < -half || o > half {
+				return 0, Vec2{}, false
+			}
+			continue
+		}
+		t1 := (-half - o) / dir
+		t2 := (half - o) / dir
+		sign := -1.0
+		if t1 > t2 {
+			t1, t2 = t2, t1
+			sign = 1
+		}
+		if t1 > tmin {
+			tmin = t1
+			if axis == 0 {
+				normal = NewVec2(sign, 0)
+			} else {
+				normal = NewVec2(0, sign)
+			}
+		}
+		tmax = math.Min(tmax, t2)
+		if tmin > tmax {
+			return 0, Vec2{}, false
+		}
+	}
+	if inside {
+		return 0, NewVec2(0, 0), true
+	}
+	return tmin, pself.toWorldDir(normal), true
+}
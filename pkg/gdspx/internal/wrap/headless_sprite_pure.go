@@ -0,0 +1,807 @@
+//go:build pure_engine
+
+package wrap
+
+import (
+	"math"
+
+	. "github.com/goplus/spbase/mathf"
+	. "github.com/goplus/spx/v2/pkg/gdspx/pkg/engine"
+)
+
+const (
+	hlPhysicsNone int64 = iota
+	hlPhysicsKinematic
+	hlPhysicsDynamic
+	hlPhysicsStatic
+)
+
+type hlTransform struct {
+	pos   Vec2
+	rot   float64
+	scale Vec2
+}
+
+type hlCollision struct {
+	shape   hlShape
+	enabled bool
+	layer   int64
+	mask    int64
+}
+
+type hlBody struct {
+	mode         int64
+	velocity     Vec2
+	force        Vec2
+	mass         float64
+	gravityScale float64
+	useGravity   bool
+	drag         float64
+	friction     float64
+
+	onFloor       bool
+	onWall        bool
+	onCeiling     bool
+	floorNormal   Vec2
+	wallNormal    Vec2
+	lastMotion    Vec2
+	positionDelta Vec2
+	realVelocity  Vec2
+}
+
+type hlAnimState struct {
+	name       string
+	playing    bool
+	loop       bool
+	backwards  bool
+	speed      float64
+	speedScale float64
+	frame      int64
+	elapsed    float64
+	centered   bool
+	offset     Vec2
+	flipH      bool
+	flipV      bool
+}
+
+// hlSprite mirrors the state of a Godot sprite node. Positions, pivots and
+// shapes are stored in spx space (y axis up), rot is the Godot rotation
+// (clockwise on screen).
+type hlSprite struct {
+	id                Object
+	typeName          string
+	isBackdrop        bool
+	dontDestroyOnLoad bool
+	process           bool
+	physicProcess     bool
+
+	pos         Vec2
+	rot         float64
+	scale       Vec2
+	renderScale Vec2
+	pivot       Vec2
+	children    map[string]*hlTransform
+
+	color       Color
+	visible     bool
+	zIndex      int64
+	shader      string
+	params      map[string]float64
+	paramsVec4  map[string]Vec4
+	paramsColor map[string]Color
+	texture     string
+	atlas       Rect2
+	isAtlas     bool
+
+	anim     hlAnimState
+	body     hlBody
+	collider hlCollision
+	trigger  hlCollision
+	obstacle bool
+}
+
+func newHlSprite(id Object, pos Vec2) *hlSprite {
+	return &hlSprite{
+		id:            id,
+		process:       true,
+		physicProcess: true,
+		pos:           pos,
+		scale:         NewVec2(1, 1),
+		renderScale:   NewVec2(1, 1),
+		children:      make(map[string]*hlTransform),
+		color:         NewColor(1, 1, 1, 1),
+		visible:       true,
+		params:        make(map[string]float64),
+		paramsVec4:    make(map[string]Vec4),
+		paramsColor:   make(map[string]Color),
+		anim:          hlAnimState{speed: 1, speedScale: 1, centered: true},
+		body:          hlBody{mass: 1, gravityScale: 1, useGravity: true},
+		collider:      hlCollision{enabled: true, layer: 1, mask: 1},
+		trigger:       hlCollision{enabled: true, layer: 1, mask: 1},
+	}
+}
+
+func (pself *hlSprite) clone(id Object) *hlSprite {
+	dst := *pself
+	dst.id = id
+	dst.dontDestroyOnLoad = false
+	dst.children = make(map[string]*hlTransform, len(pself.children))
+	for k, v := range pself.children {
+		t := *v
+		dst.children[k] = &t
+	}
+	dst.params = make(map[string]float64, len(pself.params))
+	for k, v := range pself.params {
+		dst.params[k] = v
+	}
+	dst.paramsVec4 = make(map[string]Vec4, len(pself.paramsVec4))
+	for k, v := range pself.paramsVec4 {
+		dst.paramsVec4[k] = v
+	}
+	dst.paramsColor = make(map[string]Color, len(pself.paramsColor))
+	for k, v := range pself.paramsColor {
+		dst.paramsColor[k] = v
+	}
+	return &dst
+}
+
+// toWorld converts a point relative to the node position into world space.
+// Rotation and flipping happen around the pivot like under Godot.
+func (pself *hlSprite) toWorld(local Vec2) Vec2 {
+	p := local.Add(pself.pivot)
+	p = NewVec2(p.X*pself.scale.X, p.Y*pself.scale.Y)
+	sin, cos := math.Sincos(-pself.rot)
+	p = NewVec2(p.X*cos-p.Y*sin, p.X*sin+p.Y*cos)
+	return pself.pos.Sub(pself.pivot).Add(p)
+}
+
+func (pself *hlSprite) shapeBox(shape hlShape) hlBox {
+	center := pself.toWorld(shape.center)
+	sx, sy := math.Abs(pself.scale.X), math.Abs(pself.scale.Y)
+	if shape.kind == hlShapeCircle {
+		return newCircleBox(center, shape.radius*math.Max(sx, sy))
+	}
+	return newRectBox(center, NewVec2(shape.size.X*sx, shape.size.Y*sy), -pself.rot)
+}
+
+func (pself *hlCollision) active() bool {
+	return pself.enabled && pself.shape.kind != hlShapeNone
+}
+
+func (pself *hlSprite) colliderBox() (hlBox, bool) {
+	if !pself.collider.active() || pself.body.mode == hlPhysicsNone {
+		return hlBox{}, false
+	}
+	return pself.shapeBox(pself.collider.shape), true
+}
+
+func (pself *hlSprite) triggerBox() (hlBox, bool) {
+	if !pself.trigger.active() {
+		return hlBox{}, false
+	}
+	return pself.shapeBox(pself.trigger.shape), true
+}
+
+// visualBox returns the box of the opaque part of the current texture
+func (pself *hlSprite) visualBox(w *headlessWorld) (hlBox, bool) {
+	if pself.texture == "" {
+		return hlBox{}, false
+	}
+	tex := w.loadTexture(pself.texture)
+	var size Vec2
+	var bound Rect2
+	if pself.isAtlas {
+		size = pself.atlas.Size
+		bound = tex.alphaBound(pself.atlas)
+	} else {
+		size = tex.size
+		bound = tex.alphaBound(NewRect2(0, 0, tex.size.X, tex.size.Y))
+	}
+	if bound.Size.X <= 0 || bound.Size.Y <= 0 {
+		return hlBox{}, false
+	}
+	center := NewVec2(bound.Position.X+bound.Size.X/2-size.X/2, -(bound.Position.Y + bound.Size.Y/2 - size.Y/2))
+	if !pself.anim.centered {
+		center = center.Add(NewVec2(size.X/2, -size.Y/2))
+	}
+	center = center.Add(NewVec2(pself.anim.offset.X, -pself.anim.offset.Y))
+	if pself.anim.flipH {
+		center.X = -center.X
+	}
+	if pself.anim.flipV {
+		center.Y = -center.Y
+	}
+	rs := pself.renderScale
+	center = NewVec2(center.X*rs.X, center.Y*rs.Y)
+	shape := hlShape{kind: hlShapeRect, center: center, size: NewVec2(bound.Size.X*math.Abs(rs.X), bound.Size.Y*math.Abs(rs.Y))}
+	return pself.shapeBox(shape), true
+}
+
+// touchBox returns the box used by the spx touching queries: the opaque part
+// of the texture when collision is pixel based, the trigger otherwise.
+func (pself *hlSprite) touchBox(w *headlessWorld) (hlBox, bool) {
+	if w.physic.collisionByAlpha {
+		return pself.visualBox(w)
+	}
+	return pself.triggerBox()
+}
+
+func (pself *hlSprite) box(isTrigger bool) (hlBox, bool) {
+	if isTrigger {
+		return pself.triggerBox()
+	}
+	return pself.colliderBox()
+}
+
+// -----------------------------------------------------------------------------
+// ISpriteMgr
+
+func withSprite[T any](obj Object, fn func(sprite *hlSprite) T) T {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	var ret T
+	if sprite := world.getSprite(obj); sprite != nil {
+		ret = fn(sprite)
+	}
+	return ret
+}
+
+func updateSprite(obj Object, fn func(sprite *hlSprite)) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if sprite := world.getSprite(obj); sprite != nil {
+		fn(sprite)
+	}
+}
+
+func (pself *spriteMgr) SetDontDestroyOnLoad(obj Object) {
+	updateSprite(obj, func(s *hlSprite) { s.dontDestroyOnLoad = true })
+}
+func (pself *spriteMgr) SetProcess(obj Object, is_on bool) {
+	updateSprite(obj, func(s *hlSprite) { s.process = is_on })
+}
+func (pself *spriteMgr) SetPhysicProcess(obj Object, is_on bool) {
+	updateSprite(obj, func(s *hlSprite) { s.physicProcess = is_on })
+}
+func (pself *spriteMgr) SetTypeName(obj Object, type_name string) {
+	updateSprite(obj, func(s *hlSprite) { s.typeName = type_name })
+}
+func (pself *spriteMgr) SetPivot(obj Object, pivot Vec2) {
+	updateSprite(obj, func(s *hlSprite) { s.pivot = pivot })
+}
+func (pself *spriteMgr) GetPivot(obj Object) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.pivot })
+}
+
+func (pself *hlSprite) child(path string) *hlTransform {
+	t, ok := pself.children[path]
+	if !ok {
+		t = &hlTransform{scale: NewVec2(1, 1)}
+		pself.children[path] = t
+	}
+	return t
+}
+
+func (pself *spriteMgr) SetChildPosition(obj Object, path string, pos Vec2) {
+	updateSprite(obj, func(s *hlSprite) { s.child(path).pos = pos })
+}
+func (pself *spriteMgr) GetChildPosition(obj Object, path string) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.child(path).pos })
+}
+func (pself *spriteMgr) SetChildRotation(obj Object, path string, rot float64) {
+	updateSprite(obj, func(s *hlSprite) { s.child(path).rot = rot })
+}
+func (pself *spriteMgr) GetChildRotation(obj Object, path string) float64 {
+	return withSprite(obj, func(s *hlSprite) float64 { return s.child(path).rot })
+}
+func (pself *spriteMgr) SetChildScale(obj Object, path string, scale Vec2) {
+	updateSprite(obj, func(s *hlSprite) { s.child(path).scale = scale })
+}
+func (pself *spriteMgr) GetChildScale(obj Object, path string) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.child(path).scale })
+}
+
+func (pself *spriteMgr) CheckCollision(obj Object, target Object, is_src_trigger bool, is_dst_trigger bool) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	src, dst := world.getSprite(obj), world.getSprite(target)
+	if src == nil || dst == nil {
+		return false
+	}
+	a, ok1 := src.box(is_src_trigger)
+	b, ok2 := dst.box(is_dst_trigger)
+	return ok1 && ok2 && overlapBoxes(a, b)
+}
+func (pself *spriteMgr) CheckCollisionWithPoint(obj Object, point Vec2, is_trigger bool) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	sprite := world.getSprite(obj)
+	if sprite == nil {
+		return false
+	}
+	var box hlBox
+	var ok bool
+	if world.physic.collisionByAlpha {
+		box, ok = sprite.visualBox(world)
+	} else {
+		box, ok = sprite.box(is_trigger)
+	}
+	return ok && box.contains(point)
+}
+func (pself *spriteMgr) CreateBackdrop(path string) Object {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	sprite := newHlSprite(world.newId(), Vec2{})
+	sprite.isBackdrop = true
+	world.addSprite(sprite)
+	return sprite.id
+}
+func (pself *spriteMgr) CreateSprite(path string, pos Vec2) Object {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	sprite := newHlSprite(world.newId(), pos)
+	world.addSprite(sprite)
+	return sprite.id
+}
+func (pself *spriteMgr) CloneSprite(obj Object) Object {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	src := world.getSprite(obj)
+	if src == nil {
+		return 0
+	}
+	sprite := src.clone(world.newId())
+	world.addSprite(sprite)
+	return sprite.id
+}
+func (pself *spriteMgr) DestroySprite(obj Object) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.removeSprite(obj)
+}
+func (pself *spriteMgr) IsSpriteAlive(obj Object) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	return world.getSprite(obj) != nil
+}
+func (pself *spriteMgr) SetPosition(obj Object, pos Vec2) {
+	updateSprite(obj, func(s *hlSprite) { s.pos = pos })
+}
+func (pself *spriteMgr) GetPosition(obj Object) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.pos })
+}
+func (pself *spriteMgr) SetRotation(obj Object, rot float64) {
+	updateSprite(obj, func(s *hlSprite) { s.rot = rot })
+}
+func (pself *spriteMgr) GetRotation(obj Object) float64 {
+	return withSprite(obj, func(s *hlSprite) float64 { return s.rot })
+}
+func (pself *spriteMgr) SetScale(obj Object, scale Vec2) {
+	updateSprite(obj, func(s *hlSprite) { s.scale = scale })
+}
+func (pself *spriteMgr) GetScale(obj Object) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.scale })
+}
+func (pself *spriteMgr) SetRenderScale(obj Object, scale Vec2) {
+	updateSprite(obj, func(s *hlSprite) { s.renderScale = scale })
+}
+func (pself *spriteMgr) GetRenderScale(obj Object) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.renderScale })
+}
+func (pself *spriteMgr) SetColor(obj Object, color Color) {
+	updateSprite(obj, func(s *hlSprite) { s.color = color })
+}
+func (pself *spriteMgr) GetColor(obj Object) Color {
+	return withSprite(obj, func(s *hlSprite) Color { return s.color })
+}
+func (pself *spriteMgr) SetMaterialShader(obj Object, path string) {
+	updateSprite(obj, func(s *hlSprite) { s.shader = path })
+}
+func (pself *spriteMgr) GetMaterialShader(obj Object) string {
+	return withSprite(obj, func(s *hlSprite) string { return s.shader })
+}
+func (pself *spriteMgr) SetMaterialParams(obj Object, effect string, amount float64) {
+	updateSprite(obj, func(s *hlSprite) { s.params[effect] = amount })
+}
+func (pself *spriteMgr) GetMaterialParams(obj Object, effect string) float64 {
+	return withSprite(obj, func(s *hlSprite) float64 { return s.params[effect] })
+}
+func (pself *spriteMgr) SetMaterialParamsVec(obj Object, effect string, x float64, y float64, z float64, w float64) {
+	updateSprite(obj, func(s *hlSprite) { s.paramsVec4[effect] = NewVec4(x, y, z, w) })
+}
+func (pself *spriteMgr) SetMaterialParamsVec4(obj Object, effect string, vec4 Vec4) {
+	updateSprite(obj, func(s *hlSprite) { s.paramsVec4[effect] = vec4 })
+}
+func (pself *spriteMgr) GetMaterialParamsVec4(obj Object, effect string) Vec4 {
+	return withSprite(obj, func(s *hlSprite) Vec4 { return s.paramsVec4[effect] })
+}
+func (pself *spriteMgr) SetMaterialParamsColor(obj Object, effect string, color Color) {
+	updateSprite(obj, func(s *hlSprite) { s.paramsColor[effect] = color })
+}
+func (pself *spriteMgr) GetMaterialParamsColor(obj Object, effect string) Color {
+	return withSprite(obj, func(s *hlSprite) Color { return s.paramsColor[effect] })
+}
+func (pself *spriteMgr) SetTextureAtlas(obj Object, path string, rect2 Rect2) {
+	updateSprite(obj, func(s *hlSprite) {
+		s.texture, s.atlas, s.isAtlas = path, rect2, true
+	})
+}
+func (pself *spriteMgr) SetTexture(obj Object, path string) {
+	updateSprite(obj, func(s *hlSprite) {
+		s.texture, s.isAtlas = path, false
+	})
+}
+func (pself *spriteMgr) SetTextureAtlasDirect(obj Object, path string, rect2 Rect2) {
+	pself.SetTextureAtlas(obj, path, rect2)
+}
+func (pself *spriteMgr) SetTextureDirect(obj Object, path string) {
+	pself.SetTexture(obj, path)
+}
+func (pself *spriteMgr) GetTexture(obj Object) string {
+	return withSprite(obj, func(s *hlSprite) string { return s.texture })
+}
+func (pself *spriteMgr) SetVisible(obj Object, visible bool) {
+	updateSprite(obj, func(s *hlSprite) { s.visible = visible })
+}
+func (pself *spriteMgr) GetVisible(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.visible })
+}
+func (pself *spriteMgr) GetZIndex(obj Object) int64 {
+	return withSprite(obj, func(s *hlSprite) int64 { return s.zIndex })
+}
+func (pself *spriteMgr) SetZIndex(obj Object, z int64) {
+	updateSprite(obj, func(s *hlSprite) { s.zIndex = z })
+}
+
+// -----------------------------------------------------------------------------
+// animation
+
+func (pself *spriteMgr) PlayAnim(obj Object, p_name string, p_speed float64, isLoop bool, p_revert bool) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	sprite := world.getSprite(obj)
+	if sprite == nil {
+		return
+	}
+	if sprite.anim.name != p_name {
+		world.postId(callbacks.OnSpriteAnimationChanged, obj)
+	}
+	sprite.anim.name = p_name
+	sprite.anim.speed = p_speed
+	sprite.anim.loop = isLoop
+	sprite.anim.backwards = p_revert
+	sprite.anim.elapsed = 0
+	sprite.anim.frame = 0
+	anim := world.findAnim(sprite)
+	if anim == nil {
+		sprite.anim.playing = false
+		return
+	}
+	if p_revert {
+		sprite.anim.frame = int64(len(anim.frames)) - 1
+	}
+	sprite.anim.playing = true
+	world.applyAnimFrame(sprite, anim)
+}
+func (pself *spriteMgr) PlayBackwardsAnim(obj Object, p_name string) {
+	loop := withSprite(obj, func(s *hlSprite) bool { return s.anim.loop })
+	pself.PlayAnim(obj, p_name, 1, loop, true)
+}
+func (pself *spriteMgr) PauseAnim(obj Object) {
+	updateSprite(obj, func(s *hlSprite) { s.anim.playing = false })
+}
+func (pself *spriteMgr) StopAnim(obj Object) {
+	updateSprite(obj, func(s *hlSprite) {
+		s.anim.playing = false
+		s.anim.frame = 0
+		s.anim.elapsed = 0
+	})
+}
+func (pself *spriteMgr) IsPlayingAnim(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.anim.playing })
+}
+func (pself *spriteMgr) SetAnim(obj Object, p_name string) {
+	updateSprite(obj, func(s *hlSprite) {
+		s.anim.name = p_name
+		s.anim.frame = 0
+		s.anim.elapsed = 0
+	})
+}
+func (pself *spriteMgr) GetAnim(obj Object) string {
+	return withSprite(obj, func(s *hlSprite) string { return s.anim.name })
+}
+func (pself *spriteMgr) SetAnimFrame(obj Object, p_frame int64) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	sprite := world.getSprite(obj)
+	if sprite == nil {
+		return
+	}
+	sprite.anim.frame = p_frame
+	sprite.anim.elapsed = 0
+	if anim := world.findAnim(sprite); anim != nil {
+		world.applyAnimFrame(sprite, anim)
+	}
+}
+func (pself *spriteMgr) GetAnimFrame(obj Object) int64 {
+	return withSprite(obj, func(s *hlSprite) int64 { return s.anim.frame })
+}
+func (pself *spriteMgr) SetAnimSpeedScale(obj Object, p_speed_scale float64) {
+	updateSprite(obj, func(s *hlSprite) { s.anim.speedScale = p_speed_scale })
+}
+func (pself *spriteMgr) GetAnimSpeedScale(obj Object) float64 {
+	return withSprite(obj, func(s *hlSprite) float64 { return s.anim.speedScale })
+}
+func (pself *spriteMgr) GetAnimPlayingSpeed(obj Object) float64 {
+	return withSprite(obj, func(s *hlSprite) float64 {
+		if !s.anim.playing {
+			return 0
+		}
+		speed := s.anim.speed * s.anim.speedScale
+		if s.anim.backwards {
+			speed = -speed
+		}
+		return speed
+	})
+}
+func (pself *spriteMgr) SetAnimCentered(obj Object, p_center bool) {
+	updateSprite(obj, func(s *hlSprite) { s.anim.centered = p_center })
+}
+func (pself *spriteMgr) IsAnimCentered(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.anim.centered })
+}
+func (pself *spriteMgr) SetAnimOffset(obj Object, p_offset Vec2) {
+	updateSprite(obj, func(s *hlSprite) { s.anim.offset = p_offset })
+}
+func (pself *spriteMgr) GetAnimOffset(obj Object) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.anim.offset })
+}
+func (pself *spriteMgr) SetAnimFlipH(obj Object, p_flip bool) {
+	updateSprite(obj, func(s *hlSprite) { s.anim.flipH = p_flip })
+}
+func (pself *spriteMgr) IsAnimFlippedH(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.anim.flipH })
+}
+func (pself *spriteMgr) SetAnimFlipV(obj Object, p_flip bool) {
+	updateSprite(obj, func(s *hlSprite) { s.anim.flipV = p_flip })
+}
+func (pself *spriteMgr) IsAnimFlippedV(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.anim.flipV })
+}
+func (pself *spriteMgr) GetCurrentAnimName(obj Object) string {
+	return withSprite(obj, func(s *hlSprite) string { return s.anim.name })
+}
+
+// -----------------------------------------------------------------------------
+// physic body
+
+func (pself *spriteMgr) SetVelocity(obj Object, velocity Vec2) {
+	updateSprite(obj, func(s *hlSprite) { s.body.velocity = velocity })
+}
+func (pself *spriteMgr) GetVelocity(obj Object) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.body.velocity })
+}
+func (pself *spriteMgr) IsOnFloor(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.body.onFloor })
+}
+func (pself *spriteMgr) IsOnFloorOnly(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.body.onFloor && !s.body.onWall && !s.body.onCeiling })
+}
+func (pself *spriteMgr) IsOnWall(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.body.onWall })
+}
+func (pself *spriteMgr) IsOnWallOnly(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.body.onWall && !s.body.onFloor && !s.body.onCeiling })
+}
+func (pself *spriteMgr) IsOnCeiling(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.body.onCeiling })
+}
+func (pself *spriteMgr) IsOnCeilingOnly(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.body.onCeiling && !s.body.onFloor && !s.body.onWall })
+}
+func (pself *spriteMgr) GetLastMotion(obj Object) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.body.lastMotion })
+}
+func (pself *spriteMgr) GetPositionDelta(obj Object) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.body.positionDelta })
+}
+func (pself *spriteMgr) GetFloorNormal(obj Object) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.body.floorNormal })
+}
+func (pself *spriteMgr) GetWallNormal(obj Object) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.body.wallNormal })
+}
+func (pself *spriteMgr) GetRealVelocity(obj Object) Vec2 {
+	return withSprite(obj, func(s *hlSprite) Vec2 { return s.body.realVelocity })
+}
+func (pself *spriteMgr) MoveAndSlide(obj Object) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if sprite := world.getSprite(obj); sprite != nil {
+		world.moveBody(sprite, sprite.body.velocity.Mulf(world.platform.physicDelta()))
+	}
+}
+func (pself *spriteMgr) SetGravity(obj Object, gravity float64) {
+	updateSprite(obj, func(s *hlSprite) { s.body.gravityScale = gravity })
+}
+func (pself *spriteMgr) GetGravity(obj Object) float64 {
+	return withSprite(obj, func(s *hlSprite) float64 { return s.body.gravityScale })
+}
+func (pself *spriteMgr) SetMass(obj Object, mass float64) {
+	updateSprite(obj, func(s *hlSprite) { s.body.mass = mass })
+}
+func (pself *spriteMgr) GetMass(obj Object) float64 {
+	return withSprite(obj, func(s *hlSprite) float64 { return s.body.mass })
+}
+func (pself *spriteMgr) AddForce(obj Object, force Vec2) {
+	updateSprite(obj, func(s *hlSprite) { s.body.force = s.body.force.Add(force) })
+}
+func (pself *spriteMgr) AddImpulse(obj Object, impulse Vec2) {
+	updateSprite(obj, func(s *hlSprite) {
+		s.body.velocity = s.body.velocity.Add(impulse.Divf(s.body.safeMass()))
+	})
+}
+func (pself *spriteMgr) SetPhysicsMode(obj Object, mode int64) {
+	updateSprite(obj, func(s *hlSprite) { s.body.mode = mode })
+}
+func (pself *spriteMgr) GetPhysicsMode(obj Object) int64 {
+	return withSprite(obj, func(s *hlSprite) int64 { return s.body.mode })
+}
+func (pself *spriteMgr) SetUseGravity(obj Object, enabled bool) {
+	updateSprite(obj, func(s *hlSprite) { s.body.useGravity = enabled })
+}
+func (pself *spriteMgr) IsUseGravity(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.body.useGravity })
+}
+func (pself *spriteMgr) SetGravityScale(obj Object, scale float64) {
+	updateSprite(obj, func(s *hlSprite) { s.body.gravityScale = scale })
+}
+func (pself *spriteMgr) GetGravityScale(obj Object) float64 {
+	return withSprite(obj, func(s *hlSprite) float64 { return s.body.gravityScale })
+}
+func (pself *spriteMgr) SetDrag(obj Object, drag float64) {
+	updateSprite(obj, func(s *hlSprite) { s.body.drag = drag })
+}
+func (pself *spriteMgr) GetDrag(obj Object) float64 {
+	return withSprite(obj, func(s *hlSprite) float64 { return s.body.drag })
+}
+func (pself *spriteMgr) SetFriction(obj Object, friction float64) {
+	updateSprite(obj, func(s *hlSprite) { s.body.friction = friction })
+}
+func (pself *spriteMgr) GetFriction(obj Object) float64 {
+	return withSprite(obj, func(s *hlSprite) float64 { return s.body.friction })
+}
+
+// -----------------------------------------------------------------------------
+// collider and trigger
+
+// toSpxCenter converts a shape center from Godot space, the coordinate
+// wrapper flips it before it reaches the engine
+func toSpxCenter(center Vec2) Vec2 {
+	return NewVec2(center.X, -center.Y)
+}
+
+func (pself *spriteMgr) SetCollisionLayer(obj Object, layer int64) {
+	updateSprite(obj, func(s *hlSprite) { s.collider.layer = layer })
+}
+func (pself *spriteMgr) GetCollisionLayer(obj Object) int64 {
+	return withSprite(obj, func(s *hlSprite) int64 { return s.collider.layer })
+}
+func (pself *spriteMgr) SetCollisionMask(obj Object, mask int64) {
+	updateSprite(obj, func(s *hlSprite) { s.collider.mask = mask })
+}
+func (pself *spriteMgr) GetCollisionMask(obj Object) int64 {
+	return withSprite(obj, func(s *hlSprite) int64 { return s.collider.mask })
+}
+func (pself *spriteMgr) SetTriggerLayer(obj Object, layer int64) {
+	updateSprite(obj, func(s *hlSprite) { s.trigger.layer = layer })
+}
+func (pself *spriteMgr) GetTriggerLayer(obj Object) int64 {
+	return withSprite(obj, func(s *hlSprite) int64 { return s.trigger.layer })
+}
+func (pself *spriteMgr) SetTriggerMask(obj Object, mask int64) {
+	updateSprite(obj, func(s *hlSprite) { s.trigger.mask = mask })
+}
+func (pself *spriteMgr) GetTriggerMask(obj Object) int64 {
+	return withSprite(obj, func(s *hlSprite) int64 { return s.trigger.mask })
+}
+func (pself *spriteMgr) SetColliderRect(obj Object, center Vec2, size Vec2) {
+	updateSprite(obj, func(s *hlSprite) {
+		s.collider.shape = hlShape{kind: hlShapeRect, center: toSpxCenter(center), size: size}
+	})
+}
+func (pself *spriteMgr) SetColliderCircle(obj Object, center Vec2, radius float64) {
+	updateSprite(obj, func(s *hlSprite) {
+		s.collider.shape = hlShape{kind: hlShapeCircle, center: toSpxCenter(center), radius: radius}
+	})
+}
+func (pself *spriteMgr) SetColliderCapsule(obj Object, center Vec2, size Vec2) {
+	updateSprite(obj, func(s *hlSprite) {
+		s.collider.shape = hlShape{kind: hlShapeCapsule, center: toSpxCenter(center), size: size}
+	})
+}
+func (pself *spriteMgr) SetColliderPolygon(obj Object, center Vec2, points Array) {
+	params, _ := points.([]float64)
+	updateSprite(obj, func(s *hlSprite) { s.collider.shape = polygonBound(toSpxCenter(center), params) })
+}
+func (pself *spriteMgr) AddColliderShape(obj Object, shape_type int64, center Vec2, params Array) {
+	values, _ := params.([]float64)
+	updateSprite(obj, func(s *hlSprite) {
+		s.collider.shape = s.collider.shape.union(colliderShape(shape_type, toSpxCenter(center), values))
+	})
+}
+func (pself *spriteMgr) SetCollisionEnabled(obj Object, enabled bool) {
+	updateSprite(obj, func(s *hlSprite) { s.collider.enabled = enabled })
+}
+func (pself *spriteMgr) IsCollisionEnabled(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.collider.enabled })
+}
+func (pself *spriteMgr) SetTriggerRect(obj Object, center Vec2, size Vec2) {
+	updateSprite(obj, func(s *hlSprite) {
+		s.trigger.shape = hlShape{kind: hlShapeRect, center: toSpxCenter(center), size: size}
+	})
+}
+func (pself *spriteMgr) SetTriggerCircle(obj Object, center Vec2, radius float64) {
+	updateSprite(obj, func(s *hlSprite) {
+		s.trigger.shape = hlShape{kind: hlShapeCircle, center: toSpxCenter(center), radius: radius}
+	})
+}
+func (pself *spriteMgr) SetTriggerCapsule(obj Object, center Vec2, size Vec2) {
+	updateSprite(obj, func(s *hlSprite) {
+		s.trigger.shape = hlShape{kind: hlShapeCapsule, center: toSpxCenter(center), size: size}
+	})
+}
+func (pself *spriteMgr) SetTriggerPolygon(obj Object, center Vec2, points Array) {
+	params, _ := points.([]float64)
+	updateSprite(obj, func(s *hlSprite) { s.trigger.shape = polygonBound(toSpxCenter(center), params) })
+}
+func (pself *spriteMgr) AddTriggerShape(obj Object, shape_type int64, center Vec2, params Array) {
+	values, _ := params.([]float64)
+	updateSprite(obj, func(s *hlSprite) {
+		s.trigger.shape = s.trigger.shape.union(colliderShape(shape_type, toSpxCenter(center), values))
+	})
+}
+func (pself *spriteMgr) SetTriggerEnabled(obj Object, trigger bool) {
+	updateSprite(obj, func(s *hlSprite) { s.trigger.enabled = trigger })
+}
+func (pself *spriteMgr) IsTriggerEnabled(obj Object) bool {
+	return withSprite(obj, func(s *hlSprite) bool { return s.trigger.enabled })
+}
+
+// CheckCollisionByColor needs rendered pixels, which the headless engine
+// does not have.
+func (pself *spriteMgr) CheckCollisionByColor(obj Object, color Color, color_threshold float64, alpha_threshold float64) bool {
+	return false
+}
+func (pself *spriteMgr) CheckCollisionByAlpha(obj Object, alpha_threshold float64) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	sprite := world.getSprite(obj)
+	if sprite == nil {
+		return false
+	}
+	box, ok := sprite.visualBox(world)
+	if !ok {
+		return false
+	}
+	for _, other := range world.sortedSprites() {
+		if other == sprite || other.isBackdrop || !other.visible {
+			continue
+		}
+		if obox, ok := other.visualBox(world); ok && overlapBoxes(box, obox) {
+			return true
+		}
+	}
+	return false
+}
+func (pself *spriteMgr) CheckCollisionWithSpriteByAlpha(obj Object, obj_b Object, alpha_threshold float64) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	src, dst := world.getSprite(obj), world.getSprite(obj_b)
+	if src == nil || dst == nil {
+		return false
+	}
+	a, ok1 := src.touchBox(world)
+	b, ok2 := dst.touchBox(world)
+	return ok1 && ok2 && overlapBoxes(a, b)
+}
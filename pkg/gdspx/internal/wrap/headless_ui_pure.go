@@ -0,0 +1,255 @@
+//go:build pure_engine
+
+package wrap
+
+import (
+	"strconv"
+
+	. "github.com/goplus/spbase/mathf"
+	. "github.com/goplus/spx/v2/pkg/gdspx/pkg/engine"
+)
+
+// ui node types reported by GetType
+const (
+	hlUiControl int64 = iota
+	hlUiLabel
+	hlUiButton
+	hlUiImage
+	hlUiToggle
+	hlUiSlider
+	hlUiInput
+)
+
+type hlUiNode struct {
+	id           Object
+	parent       Object
+	kind         int64
+	text         string
+	texture      string
+	color        Color
+	fontSize     int64
+	visible      bool
+	interactable bool
+	position     Vec2
+	size         Vec2
+	scale        Vec2
+	rotation     float64
+	layoutDir    int64
+	layoutMode   int64
+	anchors      int64
+	flipH        bool
+	flipV        bool
+}
+
+func (pself *headlessWorld) createUiNode(kind int64, parent Object) *hlUiNode {
+	node := &hlUiNode{
+		id:           pself.newId(),
+		parent:       parent,
+		kind:         kind,
+		color:        NewColor(1, 1, 1, 1),
+		fontSize:     16,
+		visible:      true,
+		interactable: true,
+		scale:        NewVec2(1, 1),
+	}
+	pself.uiNodes[node.id] = node
+	return node
+}
+
+func (pself *headlessWorld) uiGlobalPosition(node *hlUiNode) Vec2 {
+	pos := node.position
+	for parent := pself.uiNodes[node.parent]; parent != nil; parent = pself.uiNodes[parent.parent] {
+		pos = pos.Add(parent.position)
+	}
+	return pos
+}
+
+func withUiNode[T any](obj Object, fn func(node *hlUiNode) T) T {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	var ret T
+	if node := world.uiNodes[obj]; node != nil {
+		ret = fn(node)
+	}
+	return ret
+}
+
+func updateUiNode(obj Object, fn func(node *hlUiNode)) {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if node := world.uiNodes[obj]; node != nil {
+		fn(node)
+	}
+}
+
+func (pself *uiMgr) createNode(kind int64, init func(node *hlUiNode)) Object {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	node := world.createUiNode(kind, 0)
+	if init != nil {
+		init(node)
+	}
+	return node.id
+}
+
+// -----------------------------------------------------------------------------
+// IUiMgr
+
+func (pself *uiMgr) BindNode(obj Object, rel_path string) Object {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	key := strconv.FormatInt(obj, 10) + "/" + rel_path
+	if id, ok := world.uiBinds[key]; ok {
+		return id
+	}
+	node := world.createUiNode(hlUiControl, obj)
+	world.uiBinds[key] = node.id
+	return node.id
+}
+func (pself *uiMgr) CreateNode(path string) Object {
+	return pself.createNode(hlUiControl, nil)
+}
+func (pself *uiMgr) CreateButton(path string, text string) Object {
+	return pself.createNode(hlUiButton, func(node *hlUiNode) { node.text = text })
+}
+func (pself *uiMgr) CreateLabel(path string, text string) Object {
+	return pself.createNode(hlUiLabel, func(node *hlUiNode) { node.text = text })
+}
+func (pself *uiMgr) CreateImage(path string) Object {
+	return pself.createNode(hlUiImage, nil)
+}
+func (pself *uiMgr) CreateToggle(path string, value bool) Object {
+	return pself.createNode(hlUiToggle, func(node *hlUiNode) { node.text = strconv.FormatBool(value) })
+}
+func (pself *uiMgr) CreateSlider(path string, value float64) Object {
+	return pself.createNode(hlUiSlider, func(node *hlUiNode) { node.text = strconv.FormatFloat(value, 'g', -1, 64) })
+}
+func (pself *uiMgr) CreateInput(path string, text string) Object {
+	return pself.createNode(hlUiInput, func(node *hlUiNode) { node.text = text })
+}
+func (pself *uiMgr) DestroyNode(obj Object) bool {
+	world.mu.Lock()
+	defer world.mu.Unlock()
+	if _, ok := world.uiNodes[obj]; !ok {
+		return false
+	}
+	delete(world.uiNodes, obj)
+	for key, id := range world.uiBinds {
+		if id == obj {
+			delete(world.uiBinds, key)
+		}
+	}
+	return true
+}
+func (pself *uiMgr) GetType(obj Object) int64 {
+	return withUiNode(obj, func(n *hlUiNode) int64 { return n.kind })
+}
+func (pself *uiMgr) SetText(obj Object, text string) {
+	updateUiNode(obj, func(n *hlUiNode) { n.text = text })
+}
+func (pself *uiMgr) GetText(obj Object) string {
+	return withUiNode(obj, func(n *hlUiNode) string { return n.text })
+}
+func (pself *uiMgr) SetTexture(obj Object, path string) {
+	updateUiNode(obj, func(n *hlUiNode) { n.texture = path })
+}
+func (pself *uiMgr) GetTexture(obj Object) string {
+	return withUiNode(obj, func(n *hlUiNode) string { return n.texture })
+}
+func (pself *uiMgr) SetColor(obj Object, color Color) {
+	updateUiNode(obj, func(n *hlUiNode) { n.color = color })
+}
+func (pself *uiMgr) GetColor(obj Object) Color {
+	return withUiNode(obj, func(n *hlUiNode) Color { return n.color })
+}
+func (pself *uiMgr) SetFontSize(obj Object, size int64) {
+	updateUiNode(obj, func(n *hlUiNode) { n.fontSize = size })
+}
+func (pself *uiMgr) GetFontSize(obj Object) int64 {
+	return withUiNode(obj, func(n *hlUiNode) int64 { return n.fontSize })
+}
+func (pself *uiMgr) SetVisible(obj Object, visible bool) {
+	updateUiNode(obj, func(n *hlUiNode) { n.visible = visible })
+}
+func (pself *uiMgr) GetVisible(obj Object) bool {
+	return withUiNode(obj, func(n *hlUiNode) bool { return n.visible })
+}
+func (pself *uiMgr) SetInteractable(obj Object, interactable bool) {
+	updateUiNode(obj, func(n *hlUiNode) { n.interactable = interactable })
+}
+func (pself *uiMgr) GetInteractable(obj Object) bool {
+	return withUiNode(obj, func(n *hlUiNode) bool { return n.interactable })
+}
+func (pself *uiMgr) SetRect(obj Object, rect Rect2) {
+	updateUiNode(obj, func(n *hlUiNode) { n.position, n.size = rect.Position, rect.Size })
+}
+func (pself *uiMgr) GetRect(obj Object) Rect2 {
+	return withUiNode(obj, func(n *hlUiNode) Rect2 { return Rect2{Position: n.position, Size: n.size} })
+}
+func (pself *uiMgr) GetLayoutDirection(obj Object) int64 {
+	return withUiNode(obj, func(n *hlUiNode) int64 { return n.layoutDir })
+}
+func (pself *uiMgr) SetLayoutDirection(obj Object, value int64) {
+	updateUiNode(obj, func(n *hlUiNode) { n.layoutDir = value })
+}
+func (pself *uiMgr) GetLayoutMode(obj Object) int64 {
+	return withUiNode(obj, func(n *hlUiNode) int64 { return n.layoutMode })
+}
+func (pself *uiMgr) SetLayoutMode(obj Object, value int64) {
+	updateUiNode(obj, func(n *hlUiNode) { n.layoutMode = value })
+}
+func (pself *uiMgr) GetAnchorsPreset(obj Object) int64 {
+	return withUiNode(obj, func(n *hlUiNode) int64 { return n.anchors })
+}
+func (pself *uiMgr) SetAnchorsPreset(obj Object, value int64) {
+	updateUiNode(obj, func(n *hlUiNode) { n.anchors = value })
+}
+func (pself *uiMgr) GetScale(obj Object) Vec2 {
+	return withUiNode(obj, func(n *hlUiNode) Vec2 { return n.scale })
+}
+func (pself *uiMgr) SetScale(obj Object, value Vec2) {
+	updateUiNode(obj, func(n *hlUiNode) { n.scale = value })
+}
+func (pself *uiMgr) GetPosition(obj Object) Vec2 {
+	return withUiNode(obj, func(n *hlUiNode) Vec2 { return n.position })
+}
+func (pself *uiMgr) SetPosition(obj Object, value Vec2) {
+	updateUiNode(obj, func(n *hlUiNode) { n.position = value })
+}
+func (pself *uiMgr) GetSize(obj Object) Vec2 {
+	return withUiNode(obj, func(n *hlUiNode) Vec2 { return n.size })
+}
+func (pself *uiMgr) SetSize(obj Object, value Vec2) {
+	updateUiNode(obj, func(n *hlUiNode) { n.size = value })
+}
+func (pself *uiMgr) GetGlobalPosition(obj Object) Vec2 {
+	return withUiNode(obj, func(n *hlUiNode) Vec2 { return world.uiGlobalPosition(n) })
+}
+func (pself *uiMgr) SetGlobalPosition(obj Object, value Vec2) {
+	updateUiNode(obj, func(n *hlUiNode) {
+		n.position = n.position.Add(value.Sub(world.uiGlobalPosition(n)))
+	})
+}
+func (pself *uiMgr) GetRotation(obj Object) float64 {
+	return withUiNode(obj, func(n *hlUiNode) float64 { return n.rotation })
+}
+func (pself *uiMgr) SetRotation(obj Object, value float64) {
+	updateUiNode(obj, func(n *hlUiNode) { n.rotation = value })
+}
+func (pself *uiMgr) GetFlip(obj Object, horizontal bool) bool {
+	return withUiNode(obj, func(n *hlUiNode) bool {
+		if horizontal {
+			return n.flipH
+		}
+		return n.flipV
+	})
+}
+func (pself *uiMgr) SetFlip(obj Object, horizontal bool, is_flip bool) {
+	updateUiNode(obj, func(n *hlUiNode) {
+		if horizontal {
+			n.flipH = is_flip
+		} else {
+			n.flipV = is_flip
+		}
+	})
+}
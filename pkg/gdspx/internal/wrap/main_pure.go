@@ -20,13 +20,25 @@ func addManager[T IManager](mgr T) T {
 	return mgr
 }
 
+func RegisterFFI() {
+}
+
 func LinkFFI() bool {
 	// Pure mode doesn't need FFI linking
 	return true
 }
 
+// OnLinked starts the headless frame loop, which takes over the calling
+// goroutine like the Godot main loop does. In manual step mode the caller
+// drives the frames with Step instead.
 func OnLinked() {
-	// Pure mode doesn't need linking callbacks
+	if isManualStep() {
+		if callbacks.OnEngineStart != nil {
+			callbacks.OnEngineStart()
+		}
+		return
+	}
+	runHeadlessLoop()
 }
 
 func UnlinkFFI() {
@@ -37,8 +49,6 @@ func CreateMgrs() []IManager {
 	return createMgrs()
 }
 
-func RegisterCallbacks(callbacks CallbackInfo) {
-	// Pure mode doesn't need callback registration
-	// Store callbacks for potential future use
-	callbacks = callbacks
+func RegisterCallbacks(infos CallbackInfo) {
+	callbacks = infos
 }
@@ -1,4 +1,4 @@
-//go:build !js
+//go:build !js && !pure_engine
 
 /*
 ------------------------------------------------------------------------------
@@ -372,6 +372,60 @@ func (pself *inputMgr) IsActionJustReleased(action string) bool {
 	retValue := CallInputIsActionJustReleased(arg0)
 	return ToBool(retValue)
 }
+func (pself *inputMgr) GetConnectedGamepads() int64 {
+	if !HasProc("spx_input_get_connected_gamepads") {
+		return 0
+	}
+	retValue := CallInputGetConnectedGamepads()
+	return ToInt64(retValue)
+}
+func (pself *inputMgr) GetGamepadButtons(device int64) int64 {
+	if !HasProc("spx_input_get_gamepad_buttons") {
+		return 0
+	}
+	arg0 := ToGdInt(device)
+	retValue := CallInputGetGamepadButtons(arg0)
+	return ToInt64(retValue)
+}
+func (pself *inputMgr) GetGamepadAxis(device int64, axis int64) float64 {
+	if !HasProc("spx_input_get_gamepad_axis") {
+		return 0
+	}
+	arg0 := ToGdInt(device)
+	arg1 := ToGdInt(axis)
+	retValue := CallInputGetGamepadAxis(arg0, arg1)
+	return ToFloat64(retValue)
+}
+func (pself *inputMgr) GetTouchCount() int64 {
+	if !HasProc("spx_input_get_touch_count") {
+		return 0
+	}
+	retValue := CallInputGetTouchCount()
+	return ToInt64(retValue)
+}
+func (pself *inputMgr) GetTouchId(index int64) int64 {
+	if !HasProc("spx_input_get_touch_id") {
+		return 0
+	}
+	arg0 := ToGdInt(index)
+	retValue := CallInputGetTouchId(arg0)
+	return ToInt64(retValue)
+}
+func (pself *inputMgr) GetTouchPos(index int64) Vec2 {
+	if !HasProc("spx_input_get_touch_pos") {
+		return Vec2{}
+	}
+	arg0 := ToGdInt(index)
+	retValue := CallInputGetTouchPos(arg0)
+	return ToVec2(retValue)
+}
+func (pself *inputMgr) GetMouseWheel() Vec2 {
+	if !HasProc("spx_input_get_mouse_wheel") {
+		return Vec2{}
+	}
+	retValue := CallInputGetMouseWheel()
+	return ToVec2(retValue)
+}
 func (pself *navigationMgr) SetupPathFinderWithSize(grid_size Vec2, cell_size Vec2, with_jump bool, with_debug bool) {
 	arg0 := ToGdVec2(grid_size)
 	arg1 := ToGdVec2(cell_size)
@@ -648,6 +702,17 @@ func (pself *resMgr) GetBoundFromAlpha(p_path string) Rect2 {
 	retValue := CallResGetBoundFromAlpha(arg0)
 	return ToRect2(retValue)
 }
+func (pself *resMgr) GetPolygonFromAlpha(p_path string) Array {
+	if !HasProc("spx_res_get_polygon_from_alpha") {
+		warnFallback("spx_res_get_polygon_from_alpha", "the costume outlines are their bounding rects")
+		return rectPolygon(pself.GetBoundFromAlpha(p_path))
+	}
+	arg0Str := C.CString(p_path)
+	arg0 := (GdString)(arg0Str)
+	defer C.free(unsafe.Pointer(arg0Str))
+	retValue := CallResGetPolygonFromAlpha(arg0)
+	return ToArray(retValue)
+}
 func (pself *resMgr) GetImageSize(p_path string) Vec2 {
 	arg0Str := C.CString(p_path)
 	arg0 := (GdString)(arg0Str)
@@ -1364,6 +1429,29 @@ func (pself *spriteMgr) SetColliderCapsule(obj Object, center Vec2, size Vec2) {
 	arg2 := ToGdVec2(size)
 	CallSpriteSetColliderCapsule(arg0, arg1, arg2)
 }
+func (pself *spriteMgr) SetColliderPolygon(obj Object, center Vec2, points Array) {
+	if !HasProc("spx_sprite_set_collider_polygon") {
+		warnFallback("spx_sprite_set_collider_polygon", "the polygon colliders are their bounding rects")
+		rectCenter, size := polygonRect(center, points)
+		pself.SetColliderRect(obj, rectCenter, size)
+		return
+	}
+	arg0 := ToGdObj(obj)
+	arg1 := ToGdVec2(center)
+	arg2 := ToGdArray(points)
+	CallSpriteSetColliderPolygon(arg0, arg1, arg2)
+}
+func (pself *spriteMgr) AddColliderShape(obj Object, shape_type int64, center Vec2, params Array) {
+	if !HasProc("spx_sprite_add_collider_shape") {
+		warnFallback("spx_sprite_add_collider_shape", "the compound colliders are their first shapes")
+		return
+	}
+	arg0 := ToGdObj(obj)
+	arg1 := ToGdInt(shape_type)
+	arg2 := ToGdVec2(center)
+	arg3 := ToGdArray(params)
+	CallSpriteAddColliderShape(arg0, arg1, arg2, arg3)
+}
 func (pself *spriteMgr) SetCollisionEnabled(obj Object, enabled bool) {
 	arg0 := ToGdObj(obj)
 	arg1 := ToGdBool(enabled)
@@ -1392,6 +1480,29 @@ func (pself *spriteMgr) SetTriggerCapsule(obj Object, center Vec2, size Vec2) {
 	arg2 := ToGdVec2(size)
 	CallSpriteSetTriggerCapsule(arg0, arg1, arg2)
 }
+func (pself *spriteMgr) SetTriggerPolygon(obj Object, center Vec2, points Array) {
+	if !HasProc("spx_sprite_set_trigger_polygon") {
+		warnFallback("spx_sprite_set_trigger_polygon", "the polygon triggers are their bounding rects")
+		rectCenter, size := polygonRect(center, points)
+		pself.SetTriggerRect(obj, rectCenter, size)
+		return
+	}
+	arg0 := ToGdObj(obj)
+	arg1 := ToGdVec2(center)
+	arg2 := ToGdArray(points)
+	CallSpriteSetTriggerPolygon(arg0, arg1, arg2)
+}
+func (pself *spriteMgr) AddTriggerShape(obj Object, shape_type int64, center Vec2, params Array) {
+	if !HasProc("spx_sprite_add_trigger_shape") {
+		warnFallback("spx_sprite_add_trigger_shape", "the compound triggers are their first shapes")
+		return
+	}
+	arg0 := ToGdObj(obj)
+	arg1 := ToGdInt(shape_type)
+	arg2 := ToGdVec2(center)
+	arg3 := ToGdArray(params)
+	CallSpriteAddTriggerShape(arg0, arg1, arg2, arg3)
+}
 func (pself *spriteMgr) SetTriggerEnabled(obj Object, trigger bool) {
 	arg0 := ToGdObj(obj)
 	arg1 := ToGdBool(trigger)
@@ -0,0 +1,125 @@
+//go:build pure_engine
+
+package wrap
+
+import (
+	"fmt"
+	"reflect"
+
+	. "github.com/goplus/spx/v2/pkg/gdspx/pkg/engine"
+)
+
+// In pure engine mode every manager is backed by the in-memory world in
+// headless_pure.go instead of the Godot runtime.
+
+func BindMgr(mgrs []IManager) {
+	for _, mgr := range mgrs {
+		switch v := mgr.(type) {
+		case IAudioMgr:
+			AudioMgr = v
+
+		case ICameraMgr:
+			CameraMgr = v
+
+		case IDebugMgr:
+			DebugMgr = v
+
+		case IExtMgr:
+			ExtMgr = v
+
+		case IInputMgr:
+			InputMgr = v
+
+		case INavigationMgr:
+			NavigationMgr = v
+
+		case IPenMgr:
+			PenMgr = v
+
+		case IPhysicMgr:
+			PhysicMgr = v
+
+		case IPlatformMgr:
+			PlatformMgr = v
+
+		case IResMgr:
+			ResMgr = v
+
+		case ISceneMgr:
+			SceneMgr = v
+
+		case ISpriteMgr:
+			SpriteMgr = v
+
+		case ITilemapMgr:
+			TilemapMgr = v
+
+		case IUiMgr:
+			UiMgr = v
+
+		default:
+			panic(fmt.Sprintf("engine init error : unknown manager type %s", reflect.TypeOf(mgr).String()))
+		}
+	}
+}
+
+type audioMgr struct {
+	baseMgr
+}
+type cameraMgr struct {
+	baseMgr
+}
+type debugMgr struct {
+	baseMgr
+}
+type extMgr struct {
+	baseMgr
+}
+type inputMgr struct {
+	baseMgr
+}
+type navigationMgr struct {
+	baseMgr
+}
+type penMgr struct {
+	baseMgr
+}
+type physicMgr struct {
+	baseMgr
+}
+type platformMgr struct {
+	baseMgr
+}
+type resMgr struct {
+	baseMgr
+}
+type sceneMgr struct {
+	baseMgr
+}
+type spriteMgr struct {
+	baseMgr
+}
+type tilemapMgr struct {
+	baseMgr
+}
+type uiMgr struct {
+	baseMgr
+}
+
+func createMgrs() []IManager {
+	addManager(&audioMgr{})
+	addManager(&cameraMgr{})
+	addManager(&debugMgr{})
+	addManager(&extMgr{})
+	addManager(&inputMgr{})
+	addManager(&navigationMgr{})
+	addManager(&penMgr{})
+	addManager(&physicMgr{})
+	addManager(&platformMgr{})
+	addManager(&resMgr{})
+	addManager(&sceneMgr{})
+	addManager(&spriteMgr{})
+	addManager(&tilemapMgr{})
+	addManager(&uiMgr{})
+	return mgrs
+}
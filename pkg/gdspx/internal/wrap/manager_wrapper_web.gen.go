@@ -351,6 +351,60 @@ func (pself *inputMgr) IsActionJustReleased(action string) bool {
 	_retValue := API.SpxInputIsActionJustReleased.Invoke(arg0)
 	return JsToGdBool(_retValue)
 }
+func (pself *inputMgr) GetConnectedGamepads() int64 {
+	if !HasProc("gdspx_input_get_connected_gamepads") {
+		return 0
+	}
+	_retValue := API.SpxInputGetConnectedGamepads.Invoke()
+	return JsToGdInt(_retValue)
+}
+func (pself *inputMgr) GetGamepadButtons(device int64) int64 {
+	if !HasProc("gdspx_input_get_gamepad_buttons") {
+		return 0
+	}
+	arg0 := JsFromGdInt(device)
+	_retValue := API.SpxInputGetGamepadButtons.Invoke(arg0)
+	return JsToGdInt(_retValue)
+}
+func (pself *inputMgr) GetGamepadAxis(device int64, axis int64) float64 {
+	if !HasProc("gdspx_input_get_gamepad_axis") {
+		return 0
+	}
+	arg0 := JsFromGdInt(device)
+	arg1 := JsFromGdInt(axis)
+	_retValue := API.SpxInputGetGamepadAxis.Invoke(arg0, arg1)
+	return JsToGdFloat(_retValue)
+}
+func (pself *inputMgr) GetTouchCount() int64 {
+	if !HasProc("gdspx_input_get_touch_count") {
+		return 0
+	}
+	_retValue := API.SpxInputGetTouchCount.Invoke()
+	return JsToGdInt(_retValue)
+}
+func (pself *inputMgr) GetTouchId(index int64) int64 {
+	if !HasProc("gdspx_input_get_touch_id") {
+		return 0
+	}
+	arg0 := JsFromGdInt(index)
+	_retValue := API.SpxInputGetTouchId.Invoke(arg0)
+	return JsToGdInt(_retValue)
+}
+func (pself *inputMgr) GetTouchPos(index int64) Vec2 {
+	if !HasProc("gdspx_input_get_touch_pos") {
+		return Vec2{}
+	}
+	arg0 := JsFromGdInt(index)
+	_retValue := API.SpxInputGetTouchPos.Invoke(arg0)
+	return JsToGdVec2(_retValue)
+}
+func (pself *inputMgr) GetMouseWheel() Vec2 {
+	if !HasProc("gdspx_input_get_mouse_wheel") {
+		return Vec2{}
+	}
+	_retValue := API.SpxInputGetMouseWheel.Invoke()
+	return JsToGdVec2(_retValue)
+}
 func (pself *navigationMgr) SetupPathFinderWithSize(grid_size Vec2, cell_size Vec2, with_jump bool, with_debug bool) {
 	arg0 := JsFromGdVec2(grid_size)
 	arg1 := JsFromGdVec2(cell_size)
@@ -611,6 +665,15 @@ func (pself *resMgr) GetBoundFromAlpha(p_path string) Rect2 {
 	_retValue := API.SpxResGetBoundFromAlpha.Invoke(arg0)
 	return JsToGdRect2(_retValue)
 }
+func (pself *resMgr) GetPolygonFromAlpha(p_path string) Array {
+	if !HasProc("gdspx_res_get_polygon_from_alpha") {
+		warnFallback("gdspx_res_get_polygon_from_alpha", "the costume outlines are their bounding rects")
+		return rectPolygon(pself.GetBoundFromAlpha(p_path))
+	}
+	arg0 := JsFromGdString(p_path)
+	_retValue := API.SpxResGetPolygonFromAlpha.Invoke(arg0)
+	return JsToGdArray(_retValue)
+}
 func (pself *resMgr) GetImageSize(p_path string) Vec2 {
 	arg0 := JsFromGdString(p_path)
 	_retValue := API.SpxResGetImageSize.Invoke(arg0)
@@ -1259,6 +1322,29 @@ func (pself *spriteMgr) SetColliderCapsule(obj Object, center Vec2, size Vec2) {
 	arg2 := JsFromGdVec2(size)
 	API.SpxSpriteSetColliderCapsule.Invoke(arg0, arg1, arg2)
 }
+func (pself *spriteMgr) SetColliderPolygon(obj Object, center Vec2, points Array) {
+	if !HasProc("gdspx_sprite_set_collider_polygon") {
+		warnFallback("gdspx_sprite_set_collider_polygon", "the polygon colliders are their bounding rects")
+		rectCenter, size := polygonRect(center, points)
+		pself.SetColliderRect(obj, rectCenter, size)
+		return
+	}
+	arg0 := JsFromGdObj(obj)
+	arg1 := JsFromGdVec2(center)
+	arg2 := JsFromGdArray(points)
+	API.SpxSpriteSetColliderPolygon.Invoke(arg0, arg1, arg2)
+}
+func (pself *spriteMgr) AddColliderShape(obj Object, shape_type int64, center Vec2, params Array) {
+	if !HasProc("gdspx_sprite_add_collider_shape") {
+		warnFallback("gdspx_sprite_add_collider_shape", "the compound colliders are their first shapes")
+		return
+	}
+	arg0 := JsFromGdObj(obj)
+	arg1 := JsFromGdInt(shape_type)
+	arg2 := JsFromGdVec2(center)
+	arg3 := JsFromGdArray(params)
+	API.SpxSpriteAddColliderShape.Invoke(arg0, arg1, arg2, arg3)
+}
 func (pself *spriteMgr) SetCollisionEnabled(obj Object, enabled bool) {
 	arg0 := JsFromGdObj(obj)
 	arg1 := JsFromGdBool(enabled)
@@ -1287,6 +1373,29 @@ func (pself *spriteMgr) SetTriggerCapsule(obj Object, center Vec2, size Vec2) {
 	arg2 := JsFromGdVec2(size)
 	API.SpxSpriteSetTriggerCapsule.Invoke(arg0, arg1, arg2)
 }
+func (pself *spriteMgr) SetTriggerPolygon(obj Object, center Vec2, points Array) {
+	if !HasProc("gdspx_sprite_set_trigger_polygon") {
+		warnFallback("gdspx_sprite_set_trigger_polygon", "the polygon triggers are their bounding rects")
+		rectCenter, size := polygonRect(center, points)
+		pself.SetTriggerRect(obj, rectCenter, size)
+		return
+	}
+	arg0 := JsFromGdObj(obj)
+	arg1 := JsFromGdVec2(center)
+	arg2 := JsFromGdArray(points)
+	API.SpxSpriteSetTriggerPolygon.Invoke(arg0, arg1, arg2)
+}
+func (pself *spriteMgr) AddTriggerShape(obj Object, shape_type int64, center Vec2, params Array) {
+	if !HasProc("gdspx_sprite_add_trigger_shape") {
+		warnFallback("gdspx_sprite_add_trigger_shape", "the compound triggers are their first shapes")
+		return
+	}
+	arg0 := JsFromGdObj(obj)
+	arg1 := JsFromGdInt(shape_type)
+	arg2 := JsFromGdVec2(center)
+	arg3 := JsFromGdArray(params)
+	API.SpxSpriteAddTriggerShape.Invoke(arg0, arg1, arg2, arg3)
+}
 func (pself *spriteMgr) SetTriggerEnabled(obj Object, trigger bool) {
 	arg0 := JsFromGdObj(obj)
 	arg1 := JsFromGdBool(trigger)
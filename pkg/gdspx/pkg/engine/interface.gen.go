@@ -91,6 +91,13 @@ type IInputMgr interface {
 	IsActionPressed(action string) bool
 	IsActionJustPressed(action string) bool
 	IsActionJustReleased(action string) bool
+	GetConnectedGamepads() int64
+	GetGamepadButtons(device int64) int64
+	GetGamepadAxis(device int64, axis int64) float64
+	GetTouchCount() int64
+	GetTouchId(index int64) int64
+	GetTouchPos(index int64) Vec2
+	GetMouseWheel() Vec2
 }
 
 type INavigationMgr interface {
@@ -159,6 +166,7 @@ type IResMgr interface {
 	SetLoadMode(is_direct_mode bool)
 	GetLoadMode() bool
 	GetBoundFromAlpha(p_path string) Rect2
+	GetPolygonFromAlpha(p_path string) Array
 	GetImageSize(p_path string) Vec2
 	ReadAllText(p_path string) string
 	HasFile(p_path string) bool
@@ -289,11 +297,15 @@ type ISpriteMgr interface {
 	SetColliderRect(obj Object, center Vec2, size Vec2)
 	SetColliderCircle(obj Object, center Vec2, radius float64)
 	SetColliderCapsule(obj Object, center Vec2, size Vec2)
+	SetColliderPolygon(obj Object, center Vec2, points Array)
+	AddColliderShape(obj Object, shape_type int64, center Vec2, params Array)
 	SetCollisionEnabled(obj Object, enabled bool)
 	IsCollisionEnabled(obj Object) bool
 	SetTriggerRect(obj Object, center Vec2, size Vec2)
 	SetTriggerCircle(obj Object, center Vec2, radius float64)
 	SetTriggerCapsule(obj Object, center Vec2, size Vec2)
+	SetTriggerPolygon(obj Object, center Vec2, points Array)
+	AddTriggerShape(obj Object, shape_type int64, center Vec2, params Array)
 	SetTriggerEnabled(obj Object, trigger bool)
 	IsTriggerEnabled(obj Object) bool
 	CheckCollisionByColor(obj Object, color Color, color_threshold float64, alpha_threshold float64) bool
@@ -30,6 +30,9 @@ type ISpriter interface {
 	OnTriggerExit(ISpriter)
 	V_OnTriggerExit(ISpriter)
 
+	OnCollisionEnter(ISpriter)
+	V_OnCollisionEnter(ISpriter)
+
 	OnScreenEntered()
 	V_OnScreenEntered()
 
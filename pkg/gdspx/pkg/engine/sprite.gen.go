@@ -16,6 +16,10 @@ import (
 	. "github.com/goplus/spbase/mathf"
 )
 
+func (pself *Sprite) AddColliderShape(shape_type int64, center Vec2, params Array) {
+	SpriteMgr.AddColliderShape(pself.Id, shape_type, center, params)
+}
+
 func (pself *Sprite) AddForce(force Vec2) {
 	SpriteMgr.AddForce(pself.Id, force)
 }
@@ -24,6 +28,10 @@ func (pself *Sprite) AddImpulse(impulse Vec2) {
 	SpriteMgr.AddImpulse(pself.Id, impulse)
 }
 
+func (pself *Sprite) AddTriggerShape(shape_type int64, center Vec2, params Array) {
+	SpriteMgr.AddTriggerShape(pself.Id, shape_type, center, params)
+}
+
 func (pself *Sprite) CheckCollision(target Object, is_src_trigger bool, is_dst_trigger bool) bool {
 	return SpriteMgr.CheckCollision(pself.Id, target, is_src_trigger, is_dst_trigger)
 }
@@ -332,6 +340,10 @@ func (pself *Sprite) SetColliderCircle(center Vec2, radius float64) {
 	SpriteMgr.SetColliderCircle(pself.Id, center, radius)
 }
 
+func (pself *Sprite) SetColliderPolygon(center Vec2, points Array) {
+	SpriteMgr.SetColliderPolygon(pself.Id, center, points)
+}
+
 func (pself *Sprite) SetColliderRect(center Vec2, size Vec2) {
 	SpriteMgr.SetColliderRect(pself.Id, center, size)
 }
@@ -464,6 +476,10 @@ func (pself *Sprite) SetTriggerMask(mask int64) {
 	SpriteMgr.SetTriggerMask(pself.Id, mask)
 }
 
+func (pself *Sprite) SetTriggerPolygon(center Vec2, points Array) {
+	SpriteMgr.SetTriggerPolygon(pself.Id, center, points)
+}
+
 func (pself *Sprite) SetTriggerRect(center Vec2, size Vec2) {
 	SpriteMgr.SetTriggerRect(pself.Id, center, size)
 }
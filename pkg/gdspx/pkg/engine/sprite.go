@@ -4,6 +4,7 @@ type Sprite struct {
 	Id                       Object
 	OnTriggerEnterEvent      *Event1[ISpriter]
 	OnTriggerExitEvent       *Event1[ISpriter]
+	OnCollisionEnterEvent    *Event1[ISpriter]
 	OnScreenExitedEvent      *Event0
 	OnScreenEnteredEvent     *Event0
 	OnFramesSetChangedEvent  *Event0
@@ -17,6 +18,7 @@ type Sprite struct {
 func (pself *Sprite) onCreate() {
 	pself.OnTriggerEnterEvent = NewEvent1[ISpriter]()
 	pself.OnTriggerExitEvent = NewEvent1[ISpriter]()
+	pself.OnCollisionEnterEvent = NewEvent1[ISpriter]()
 	pself.OnScreenExitedEvent = NewEvent0()
 	pself.OnScreenEnteredEvent = NewEvent0()
 	pself.OnFramesSetChangedEvent = NewEvent0()
@@ -94,6 +96,12 @@ func (pself *Sprite) OnTriggerEnter(ISpriter) {}
 
 func (pself *Sprite) OnTriggerExit(ISpriter) {}
 
+func (pself *Sprite) V_OnCollisionEnter(other ISpriter) {
+	pself.OnCollisionEnterEvent.Trigger(other)
+}
+
+func (pself *Sprite) OnCollisionEnter(ISpriter) {}
+
 func (pself *Sprite) GetId() Object {
 	return pself.Id
 }
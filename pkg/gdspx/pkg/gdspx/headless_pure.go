@@ -0,0 +1,72 @@
+//go:build pure_engine
+
+package gdspx
+
+import (
+	"github.com/goplus/spbase/mathf"
+	"github.com/goplus/spx/v2/pkg/gdspx/internal/wrap"
+)
+
+// SetManualStep disables the built-in frame loop of the headless engine, so
+// LinkEngine returns right after the engine started and frames are driven by
+// Step. It must be called before LinkEngine.
+func SetManualStep(enabled bool) {
+	wrap.SetManualStep(enabled)
+}
+
+// Step advances the headless engine by one frame of delta seconds. It returns
+// false once the game requested to exit.
+func Step(delta float64) bool {
+	return wrap.Step(delta)
+}
+
+// Frame returns the number of frames simulated by the headless engine.
+func Frame() int64 {
+	return wrap.Frame()
+}
+
+// ExitStatus reports whether the game requested to exit and with which code.
+func ExitStatus() (code int64, exited bool) {
+	return wrap.ExitStatus()
+}
+
+// InjectKey presses or releases a key, as if the user did it.
+func InjectKey(key int64, pressed bool) {
+	wrap.InjectKey(key, pressed)
+}
+
+// InjectMouseButton presses or releases a mouse button.
+func InjectMouseButton(button int64, pressed bool) {
+	wrap.InjectMouseButton(button, pressed)
+}
+
+// InjectMousePos moves the mouse cursor to pos, in spx world coordinates.
+func InjectMousePos(pos mathf.Vec2) {
+	wrap.InjectMousePos(pos)
+}
+
+// InjectGamepad connects or disconnects the gamepad device.
+func InjectGamepad(device int64, connected bool) {
+	wrap.InjectGamepad(device, connected)
+}
+
+// InjectGamepadButton presses or releases a button of the gamepad device.
+func InjectGamepadButton(device, button int64, pressed bool) {
+	wrap.InjectGamepadButton(device, button, pressed)
+}
+
+// InjectGamepadAxis moves an axis of the gamepad device to value.
+func InjectGamepadAxis(device, axis int64, value float64) {
+	wrap.InjectGamepadAxis(device, axis, value)
+}
+
+// InjectTouch puts the finger id down at pos, in spx world coordinates, moves
+// it there if it is already down, or lifts it if pressed is false.
+func InjectTouch(id int64, pos mathf.Vec2, pressed bool) {
+	wrap.InjectTouch(id, pos, pressed)
+}
+
+// InjectMouseWheel scrolls the mouse wheel by delta notches, y up.
+func InjectMouseWheel(delta mathf.Vec2) {
+	wrap.InjectMouseWheel(delta)
+}
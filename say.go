@@ -91,4 +91,12 @@ func (p *SpriteImpl) doStopSay() {
 	}
 }
 
+// SayText returns what the sprite says or thinks, "" if it says nothing.
+func (p *SpriteImpl) SayText() string {
+	if p.sayObj == nil {
+		return ""
+	}
+	return p.sayObj.msg
+}
+
 // -------------------------------------------------------------------------------------
@@ -0,0 +1,40 @@
+//go:build ignore
+
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+
+	_ "github.com/goplus/spx/v2" // registers the config types
+	"github.com/goplus/spx/v2/internal/jsonschema"
+)
+
+func main() {
+	for _, kind := range jsonschema.Kinds() {
+		b, err := json.MarshalIndent(jsonschema.Generate(kind), "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = os.WriteFile(kind+".schema.json", append(b, '\n'), 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
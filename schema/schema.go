@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package schema publishes the JSON Schema of the spx project files:
+//
+//   - index.schema.json: index.json and the scene files in scenes/
+//   - sprite.schema.json: sprites/<name>/index.json
+//   - sound.schema.json: sounds/<name>/index.json
+//
+// The schemas are generated from the types spx decodes the files into. Run
+// go generate after changing them.
+package schema
+
+import _ "embed"
+
+//go:generate go run gen.go
+
+var (
+	//go:embed index.schema.json
+	Index []byte
+
+	//go:embed sprite.schema.json
+	Sprite []byte
+
+	//go:embed sound.schema.json
+	Sound []byte
+)
@@ -0,0 +1,25 @@
+package schema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	_ "github.com/goplus/spx/v2" // registers the config types
+	"github.com/goplus/spx/v2/internal/jsonschema"
+	"github.com/goplus/spx/v2/schema"
+)
+
+// TestUpToDate checks the published schemas are the ones generated from the
+// config types, run go generate if it fails.
+func TestUpToDate(t *testing.T) {
+	published := map[string][]byte{"index": schema.Index, "sprite": schema.Sprite, "sound": schema.Sound}
+	for _, kind := range jsonschema.Kinds() {
+		want, err := json.MarshalIndent(jsonschema.Generate(kind), "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := published[kind]; string(got) != string(want)+"\n" {
+			t.Errorf("%s.schema.json is out of date, run go generate", kind)
+		}
+	}
+}
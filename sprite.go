@@ -122,13 +122,20 @@ type Sprite interface {
 	DeleteThisClone()
 	Destroy()
 	Die()
+	DontDestroyOnLoad()
+	SetPauseExempt(exempt bool)
+	PauseExempt() bool
 	DeltaTime() float64
+	SetTimeScale(scale float64)
+	TimeScale() float64
 	TimeSinceLevelLoad() float64
 
 	// Visibility Methods
 	Hide()
 	Show()
 	Visible() bool
+	SetClickThrough(through bool)
+	ClickThrough() bool
 	HideVar(name string)
 	ShowVar(name string)
 
@@ -239,6 +246,7 @@ type Sprite interface {
 	Say__1(msg any, secs float64)
 	Think__0(msg any)
 	Think__1(msg any, secs float64)
+	SayText() string
 	Ask(msg any)
 	Quote__0(message string)
 	Quote__1(message string, secs float64)
@@ -252,6 +260,8 @@ type Sprite interface {
 	OnTouchStart__1(sprite SpriteName, onTouchStart func())
 	OnTouchStart__2(sprites []SpriteName, onTouchStart func(Sprite))
 	OnTouchStart__3(sprites []SpriteName, onTouchStart func())
+	OnCollide__0(onCollide func(c Contact))
+	OnCollide__1(layers int64, onCollide func(c Contact))
 
 	// Sound Methods
 	Volume() float64
@@ -275,11 +285,24 @@ type Sprite interface {
 	SetGravity(gravity float64)
 	Gravity() float64
 	AddImpulse(impulseX, impulseY float64)
+	AddForce(forceX, forceY float64)
+	SetMass(mass float64)
+	Mass() float64
+	SetFriction(friction float64)
+	Friction() float64
+	SetAirDrag(drag float64)
+	AirDrag() float64
 	IsOnFloor() bool
+	IsOnWall() bool
+	IsOnCeiling() bool
+	FloorNormal() (normalX, normalY float64)
+	WallNormal() (normalX, normalY float64)
 
 	// Collider Methods
 	SetColliderShape(isTrigger bool, ctype ColliderShapeType, params []float64) error
 	ColliderShape(isTrigger bool) (ColliderShapeType, []float64)
+	SetColliderShapes(isTrigger bool, parts []ColliderPart) error
+	ColliderShapes(isTrigger bool) []ColliderPart
 	SetColliderPivot(isTrigger bool, offsetX, offsetY float64)
 	ColliderPivot(isTrigger bool) (offsetX, offsetY float64)
 
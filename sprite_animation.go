@@ -23,7 +23,6 @@ import (
 	"github.com/goplus/spbase/mathf"
 	"github.com/goplus/spx/v2/internal/engine"
 	spxlog "github.com/goplus/spx/v2/internal/log"
-	"github.com/goplus/spx/v2/internal/time"
 	"github.com/goplus/spx/v2/internal/tools"
 )
 
@@ -205,7 +204,7 @@ func (p *SpriteImpl) doTween(name SpriteAnimationName, ani *aniConfig) {
 		if info.IsCanceled {
 			return
 		}
-		timer += time.DeltaTime()
+		timer += p.DeltaTime()
 		percent := mathf.Clamp01f(timer / duration)
 		deltaPercent := percent - prePercent
 		prePercent = percent
@@ -215,7 +214,7 @@ func (p *SpriteImpl) doTween(name SpriteAnimationName, ani *aniConfig) {
 			dst, _ := tools.GetVec2(ani.To)
 			diff := dst.Sub(src)
 			if enabledPhysics && p.physicsMode != NoPhysics && p.physicsMode != StaticPhysics {
-				speed := diff.Length() / duration
+				speed := diff.Length() / duration * p.timeScale
 				dir := diff.Normalize()
 				vel := dir.Mulf(speed)
 				p.SetVelocity(vel.X, vel.Y)
@@ -0,0 +1,259 @@
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spx
+
+import (
+	"math"
+
+	"github.com/goplus/spbase/mathf"
+)
+
+// -------------------------------------------------------------------------------------
+// Contacts
+//
+// OnCollide is called when a sprite starts touching another one, whether their
+// physics bodies collide or their triggers overlap. The contact tells where
+// and how they touch. It's computed by spx, not read from the engine, from the
+// collider shapes turned with the sprites: circles, or rects bounding the
+// capsules and polygons, so the contacts of those are approximate. The
+// relative velocity is the one before the physics step the sprites touched
+// in, as the collision may already have stopped or bounced them.
+
+// Contact describes a sprite starting to touch another one, see OnCollide.
+// The point, normal and depth are those of the circles and of the turned rects
+// standing for the colliders: exact for circles and rects, approximate for
+// capsules and polygons, which their bounding rects stand for.
+type Contact struct {
+	Other   Sprite // the sprite touched
+	Trigger bool   // the triggers overlap, rather than the physics bodies colliding
+
+	X, Y             float64 // contact point
+	NormalX, NormalY float64 // unit normal, from the other sprite to this one
+	Depth            float64 // penetration depth
+
+	// velocity relative to the other sprite, before the contact
+	VelocityX, VelocityY float64
+
+	self, other *SpriteImpl
+	layer       int64 // collision or trigger layer of the other sprite
+}
+
+// Speed returns the speed of the sprite relative to the other one, before the
+// contact.
+func (c *Contact) Speed() float64 {
+	return math.Hypot(c.VelocityX, c.VelocityY)
+}
+
+// ImpactSpeed returns the speed of the sprite toward the other one along the
+// normal, before the contact: 0 if it was sliding along it.
+func (c *Contact) ImpactSpeed() float64 {
+	return math.Max(0, -(c.VelocityX*c.NormalX + c.VelocityY*c.NormalY))
+}
+
+// contactShape is a collider in world space: a circle, or a rect if radius is
+// 0
+type contactShape struct {
+	center mathf.Vec2
+	half   mathf.Vec2 // half size of the rect
+	radius float64
+	angle  float64 // rotation of the rect, in radians counterclockwise
+}
+
+func (p *SpriteImpl) contactShape(isTrigger bool) contactShape {
+	cfg := p.getPhysicConfig(isTrigger)
+	// like the engine, which turns the node around the sprite position, offset
+	// by the render offset that PivotOffset cancels
+	offsetX, offsetY := getRenderOffset(p)
+	pivot := cfg.Pivot.Sub(cfg.PivotOffset).Mulf(p.scale).Add(mathf.NewVec2(offsetX, offsetY))
+	degree, hScale := calcRenderRotation(p)
+	angle := -degree * math.Pi / 180 // the engine turns clockwise, y down
+	pivot = rotateVec(mathf.NewVec2(pivot.X*hScale, pivot.Y), angle)
+	shape := contactShape{center: mathf.NewVec2(p.x, p.y).Add(pivot), angle: angle}
+	params := cfg.Params
+	switch cfg.Type {
+	case physicsColliderCircle:
+		if len(params) >= 1 {
+			shape.radius = params[0] * p.scale
+		}
+	case physicsColliderCapsule:
+		if len(params) >= 2 {
+			shape.half = mathf.NewVec2(params[0]*p.scale, params[1]*p.scale/2)
+		}
+	case physicsColliderRect, physicsColliderAuto:
+		if len(params) >= 2 {
+			shape.half = mathf.NewVec2(params[0]*p.scale/2, params[1]*p.scale/2)
+		}
+	case physicsColliderPolygon, physicsColliderOutline, physicsColliderCompound:
+		w, h := cfg.getDimensions()
+		shape.half = mathf.NewVec2(w*p.scale/2, h*p.scale/2)
+	}
+	return shape
+}
+
+// box returns the rect bounding the shape
+func (s contactShape) box() contactShape {
+	if s.radius > 0 {
+		s.half = mathf.NewVec2(s.radius, s.radius)
+		s.radius = 0
+		s.angle = 0
+	}
+	return s
+}
+
+// axes returns the unit axes of the rect
+func (s contactShape) axes() (x, y mathf.Vec2) {
+	sin, cos := math.Sincos(s.angle)
+	return mathf.NewVec2(cos, sin), mathf.NewVec2(-sin, cos)
+}
+
+// extent returns the half length of the rect projected on the unit axis n
+func (s contactShape) extent(n mathf.Vec2) float64 {
+	x, y := s.axes()
+	return s.half.X*math.Abs(x.Dot(n)) + s.half.Y*math.Abs(y.Dot(n))
+}
+
+// contactOf returns the contact point, the normal from b to a and the
+// penetration depth of a touching b
+func contactOf(a, b contactShape) (point, normal mathf.Vec2, depth float64) {
+	switch {
+	case a.radius > 0 && b.radius > 0:
+		d := a.center.Sub(b.center)
+		dist := d.Length()
+		normal = mathf.NewVec2(0, 1)
+		if dist > 0 {
+			normal = d.Divf(dist)
+		}
+		return b.center.Add(normal.Mulf(b.radius)), normal, a.radius + b.radius - dist
+	case a.radius > 0:
+		// the closest point of the rect to the center of the circle, in the
+		// frame of the rect
+		local := rotateVec(a.center.Sub(b.center), -b.angle)
+		q := mathf.NewVec2(
+			mathf.Clampf(local.X, -b.half.X, b.half.X),
+			mathf.Clampf(local.Y, -b.half.Y, b.half.Y))
+		d := local.Sub(q)
+		if dist := d.Length(); dist > 0 {
+			point = b.center.Add(rotateVec(q, b.angle))
+			return point, rotateVec(d.Divf(dist), b.angle), a.radius - dist
+		}
+		return contactOf(a.box(), b) // the center is in the rect
+	case b.radius > 0:
+		point, normal, depth = contactOf(b, a)
+		return point, normal.Mulf(-1), depth
+	}
+	// separating axes: the normal is the axis of the rects they overlap the
+	// least along
+	d := a.center.Sub(b.center)
+	ax, ay := a.axes()
+	bx, by := b.axes()
+	depth = math.Inf(1)
+	for _, n := range [...]mathf.Vec2{ax, ay, bx, by} {
+		if overlap := a.extent(n) + b.extent(n) - math.Abs(d.Dot(n)); overlap < depth {
+			depth = overlap
+			normal = n
+			if d.Dot(n) < 0 {
+				normal = n.Mulf(-1)
+			}
+		}
+	}
+	// the point is on the side of b, in the middle of the overlap of the
+	// rects along it
+	side := mathf.NewVec2(-normal.Y, normal.X)
+	t0 := math.Max(a.center.Dot(side)-a.extent(side), b.center.Dot(side)-b.extent(side))
+	t1 := math.Min(a.center.Dot(side)+a.extent(side), b.center.Dot(side)+b.extent(side))
+	point = normal.Mulf(b.center.Dot(normal) + b.extent(normal)).Add(side.Mulf((t0 + t1) / 2))
+	return point, normal, depth
+}
+
+// newContact describes self starting to touch other
+func newContact(self, other *SpriteImpl, isTrigger bool) *Contact {
+	point, normal, depth := contactOf(self.contactShape(isTrigger), other.contactShape(isTrigger))
+	velocity := self.contactVelocity.Sub(other.contactVelocity)
+	c := &Contact{
+		Other:     other.sprite,
+		Trigger:   isTrigger,
+		X:         point.X,
+		Y:         point.Y,
+		NormalX:   normal.X,
+		NormalY:   normal.Y,
+		Depth:     math.Max(depth, 0),
+		VelocityX: velocity.X,
+		VelocityY: velocity.Y,
+		self:      self,
+		other:     other,
+	}
+	if other.syncSprite != nil {
+		if isTrigger {
+			c.layer = other.syncSprite.GetTriggerLayer()
+		} else {
+			c.layer = other.syncSprite.GetCollisionLayer()
+		}
+	}
+	return c
+}
+
+// syncFireContact calls the OnCollide handlers of src starting to touch dst
+func (p *Game) syncFireContact(src, dst *SpriteImpl, isTrigger bool) {
+	if len(p.sinkMgr.allWhenCollide) == 0 {
+		return
+	}
+	p.sinkMgr.doWhenCollide(newContact(src, dst, isTrigger))
+}
+
+// syncUpdateContactVelocities samples the velocities of the sprites with a
+// collider or a trigger before the next physics step. They are sampled every
+// frame, so that the handlers added later read them too.
+func (p *Game) syncUpdateContactVelocities() {
+	for _, item := range p.getTempShapes() {
+		sprite, ok := item.(*SpriteImpl)
+		if !ok || sprite.syncSprite == nil {
+			continue
+		}
+		if sprite.collisionInfo.Type != physicsColliderNone || sprite.triggerInfo.Type != physicsColliderNone {
+			sprite.contactVelocity = sprite.syncSprite.GetVelocity()
+		}
+	}
+}
+
+// -------------------------------------------------------------------------------------
+
+// OnCollide__0 is called with the contact when the sprite starts touching
+// another one, whether their physics bodies collide or their triggers
+// overlap.
+func (p *SpriteImpl) OnCollide__0(onCollide func(c Contact)) {
+	p.allWhenCollide = append(p.allWhenCollide, eventSink{
+		pthis: p,
+		sink:  onCollide,
+		cond: func(data any) bool {
+			return data.(*Contact).self == p
+		},
+	})
+}
+
+// OnCollide__1 is like OnCollide__0, but only for the sprites of the given
+// layers: the collision layers of the bodies, or the trigger layers of the
+// triggers.
+func (p *SpriteImpl) OnCollide__1(layers int64, onCollide func(c Contact)) {
+	p.allWhenCollide = append(p.allWhenCollide, eventSink{
+		pthis: p,
+		sink:  onCollide,
+		cond: func(data any) bool {
+			c := data.(*Contact)
+			return c.self == p && c.layer&layers != 0
+		},
+	})
+}
@@ -73,6 +73,22 @@ type SpriteImpl struct {
 	isPenDown bool
 	isDying   bool
 
+	clickThrough bool // see SetClickThrough
+
+	pauseExempt bool    // keeps running while the game is paused
+	timeScale   float64 // on top of the game one, see SetTimeScale
+
+	frozen         bool       // the body is static while paused, see syncPhysicsFreeze
+	frozenVelocity mathf.Vec2 // restored when the game resumes
+
+	contactVelocity mathf.Vec2 // velocity before the last physics step, see Contact
+
+	// identifies the sprite in game snapshots, 0 until the first snapshot
+	snapshotID int64
+
+	// kept when another scene is loaded, see DontDestroyOnLoad
+	persistent bool
+
 	// Event flags
 	hasOnCloned     bool
 	hasOnTouchStart bool
@@ -88,6 +104,9 @@ type SpriteImpl struct {
 	// Physics configuration
 	triggerInfo   physicConfig
 	collisionInfo physicConfig
+	costumeShapes map[string]*costumeShapeConfig // shapes overridden by costume
+	shapedCostume SpriteCostumeName              // costume the shapes were set for
+	defaultShapes *[2]physicConfig               // collision and trigger shapes of the sprite, while a costume overrides them
 
 	// Engine objects
 	penObj   *engine.Object
@@ -104,6 +123,7 @@ type SpriteImpl struct {
 	friction    float64
 	airDrag     float64
 	gravity     float64
+	bodySet     bodyProp // the ones above set by the config or a setter, see syncBodyProps
 }
 
 // ============================================================================
@@ -160,6 +180,7 @@ func (p *SpriteImpl) initBasicProperties(g *Game, name string, sprite Sprite, ga
 	p.direction = spriteCfg.Heading
 	p.rotationStyle = toRotationStyle(spriteCfg.RotationStyle)
 	p.isVisible = spriteCfg.Visible
+	p.timeScale = 1
 	p.pivot = spriteCfg.Pivot
 	p.animBindings = make(map[string]string)
 	maps.Copy(p.animBindings, spriteCfg.AnimBindings)
@@ -170,6 +191,7 @@ func (p *SpriteImpl) initBasicProperties(g *Game, name string, sprite Sprite, ga
 func (p *SpriteImpl) initPhysicsConfig(spriteCfg *spriteConfig) {
 	p.initCollisionConfig(spriteCfg)
 	p.initTriggerConfig(spriteCfg)
+	p.costumeShapes = spriteCfg.CostumeShapes
 }
 
 // initCollisionConfig initializes collision configuration
@@ -186,12 +208,16 @@ func (p *SpriteImpl) initCollisionConfig(spriteCfg *spriteConfig) {
 	p.collisionInfo.Type = parseColliderShapeType(spriteCfg.CollisionShapeType, defaultCollisionType)
 	p.collisionInfo.Pivot = spriteCfg.CollisionPivot
 	p.collisionInfo.Params = spriteCfg.CollisionShapeParams
+	if len(spriteCfg.CollisionShapes) > 0 {
+		p.collisionInfo.setShapes(spriteCfg.CollisionShapes)
+	}
 
 	// Validate colliderShapeType and colliderShape length matching
 	if !p.collisionInfo.validateShape() {
 		spxlog.Warn("Invalid collider configuration for sprite %s, using default values", p.name)
 		p.collisionInfo.Type = physicsColliderNone
 		p.collisionInfo.Params = nil
+		p.collisionInfo.Parts = nil
 	}
 }
 
@@ -202,12 +228,16 @@ func (p *SpriteImpl) initTriggerConfig(spriteCfg *spriteConfig) {
 	p.triggerInfo.Type = parseColliderShapeType(spriteCfg.TriggerShapeType, physicsColliderAuto)
 	p.triggerInfo.Pivot = spriteCfg.TriggerPivot
 	p.triggerInfo.Params = spriteCfg.TriggerShapeParams
+	if len(spriteCfg.TriggerShapes) > 0 {
+		p.triggerInfo.setShapes(spriteCfg.TriggerShapes)
+	}
 
 	// Validate triggerType and triggerShape length matching
 	if !p.triggerInfo.validateShape() {
 		spxlog.Warn("Invalid trigger configuration for sprite %s, using default values", p.name)
 		p.triggerInfo.Type = physicsColliderAuto
 		p.triggerInfo.Params = nil
+		p.triggerInfo.Parts = nil
 	}
 }
 
@@ -218,6 +248,16 @@ func (p *SpriteImpl) initPhysicsProperties(spriteCfg *spriteConfig) {
 	p.gravity = parseDefaultFloatValue(spriteCfg.Gravity, 1)
 	p.friction = parseDefaultFloatValue(spriteCfg.Friction, 1)
 	p.mass = parseDefaultFloatValue(spriteCfg.Mass, 1)
+	p.bodySet = 0
+	if spriteCfg.Mass != nil {
+		p.bodySet |= bodyMass
+	}
+	if spriteCfg.Friction != nil {
+		p.bodySet |= bodyFriction
+	}
+	if spriteCfg.AirDrag != nil {
+		p.bodySet |= bodyAirDrag
+	}
 }
 
 // initAnimations initializes sprite animations and animation wrappers
@@ -315,7 +355,12 @@ func (p *SpriteImpl) InitFrom(src *SpriteImpl) {
 	p.isVisible = src.isVisible
 	p.isCloned_ = true
 	p.isPenDown = src.isPenDown
+	p.clickThrough = src.clickThrough
 	p.isDying = false
+	p.pauseExempt = src.pauseExempt
+	p.timeScale = src.timeScale
+	p.snapshotID = 0
+	p.persistent = false
 
 	p.hasOnCloned = false
 	p.hasOnTouchStart = false
@@ -324,6 +369,9 @@ func (p *SpriteImpl) InitFrom(src *SpriteImpl) {
 
 	p.collisionInfo.copyFrom(&src.collisionInfo)
 	p.triggerInfo.copyFrom(&src.triggerInfo)
+	p.costumeShapes = src.costumeShapes
+	p.shapedCostume = src.shapedCostume
+	p.defaultShapes = src.defaultShapes // never modified, shared
 
 	p.pendingAudios = make([]string, 0)
 }
@@ -389,7 +437,7 @@ func cloneSprite(out reflect.Value, outPtr Sprite, in reflect.Value, v specsp) *
 		dest.onAwake(func() {
 			dest.awake()
 		})
-		runMain(outPtr.Main)
+		runMain(dest, outPtr.Main)
 	}
 	dest.syncSprite = nil
 	dest.curAnimState = nil
@@ -571,6 +619,7 @@ func (p *SpriteImpl) Destroy() { // destroy sprite, whether prototype or cloned
 
 	p.Hide()
 	p.doDeleteClone()
+	p.g.deleteJoints(p)
 	p.destroyPen()
 	p.g.removeShape(p)
 	p.Stop(ThisSprite)
@@ -619,6 +668,20 @@ func (p *SpriteImpl) Visible() bool {
 	return p.isVisible
 }
 
+// SetClickThrough makes the clicks on the sprite go on to the sprites below
+// it, or to the stage, once its click handlers are started, unless one of
+// them stops their propagation, see EventHandle.SetStopPropagation. By
+// default, only the topmost sprite under the mouse is clicked.
+func (p *SpriteImpl) SetClickThrough(through bool) {
+	p.clickThrough = through
+}
+
+// ClickThrough reports whether the clicks on the sprite go on to the sprites
+// below it.
+func (p *SpriteImpl) ClickThrough() bool {
+	return p.clickThrough
+}
+
 // ============================================================================
 // Costume Methods
 // ============================================================================
@@ -870,8 +933,10 @@ func (pself *SpriteImpl) onUpdate(delta float64) {
 // Time Methods
 // ============================================================================
 
+// DeltaTime returns the duration of the last frame, scaled by the time scale
+// of the sprite.
 func (pself *SpriteImpl) DeltaTime() float64 {
-	return time.DeltaTime()
+	return time.DeltaTime() * pself.timeScale
 }
 
 func (pself *SpriteImpl) TimeSinceLevelLoad() float64 {
@@ -22,11 +22,12 @@ import (
 
 	"github.com/goplus/spbase/mathf"
 	"github.com/goplus/spx/v2/internal/engine"
+	spxlog "github.com/goplus/spx/v2/internal/log"
 )
 
 // ======================== Physics Component ========================
 // This file contains physics-related functionality for sprites,
-// including physics modes, colliders, velocity, gravity, and impulse.
+// including physics modes, colliders, velocity, gravity, forces and impulse.
 
 // -----------------------------------------------------------------------------
 // Physics Mode and Types
@@ -48,9 +49,24 @@ const (
 	CircleCollider    ColliderShapeType = ColliderShapeType(physicsColliderCircle)
 	CapsuleCollider   ColliderShapeType = ColliderShapeType(physicsColliderCapsule)
 	PolygonCollider   ColliderShapeType = ColliderShapeType(physicsColliderPolygon)
+	CompoundCollider  ColliderShapeType = ColliderShapeType(physicsColliderCompound) // several shapes, see SetColliderShapes
+	OutlineCollider   ColliderShapeType = ColliderShapeType(physicsColliderOutline)  // polygon following the opaque pixels of the costume
 	TriggerExtraPixel float64           = 2.0
 )
 
+// ColliderPart is one of the shapes of a compound collider, its pivot being
+// relative to the pivot of the collider. Its params are the ones of the
+// shape type, see SetColliderShape.
+type ColliderPart struct {
+	Type           ColliderShapeType
+	Params         []float64
+	PivotX, PivotY float64
+}
+
+func (part *ColliderPart) pivot() mathf.Vec2 {
+	return mathf.NewVec2(part.PivotX, part.PivotY)
+}
+
 // -----------------------------------------------------------------------------
 // Physics Configuration
 // -----------------------------------------------------------------------------
@@ -62,6 +78,7 @@ type physicConfig struct {
 	Type        ColliderShapeType // collider/trigger type
 	Pivot       mathf.Vec2        // pivot position
 	Params      []float64         // shape parameters
+	Parts       []ColliderPart    // shapes of a compound collider
 	PivotOffset mathf.Vec2        // pivot offset for render offset adjustment
 }
 
@@ -77,11 +94,31 @@ func (cfg *physicConfig) copyFrom(src *physicConfig) {
 	cfg.PivotOffset = src.PivotOffset
 	cfg.Params = make([]float64, len(src.Params))
 	copy(cfg.Params, src.Params)
+	cfg.Parts = copyColliderParts(src.Parts)
+}
+
+// copyShapeFrom copies the shape of src, leaving layer and mask untouched
+func (cfg *physicConfig) copyShapeFrom(src *physicConfig) {
+	mask, layer := cfg.Mask, cfg.Layer
+	cfg.copyFrom(src)
+	cfg.Mask, cfg.Layer = mask, layer
+}
+
+func copyColliderParts(parts []ColliderPart) []ColliderPart {
+	if parts == nil {
+		return nil
+	}
+	ret := make([]ColliderPart, len(parts))
+	for i, part := range parts {
+		ret[i] = part
+		ret[i].Params = append([]float64(nil), part.Params...)
+	}
+	return ret
 }
 
 // validateShape validates if shape parameters match the type
 func (cfg *physicConfig) validateShape() bool {
-	if cfg.Type == physicsColliderNone || cfg.Type == physicsColliderAuto {
+	if cfg.Type == physicsColliderNone || cfg.Type == physicsColliderAuto || cfg.Type == physicsColliderOutline {
 		return true
 	}
 
@@ -104,6 +141,24 @@ func (cfg *physicConfig) validateShape() bool {
 			return false
 		}
 		return true
+	case physicsColliderCompound:
+		if len(cfg.Parts) == 0 {
+			fmt.Printf("Shape validation error: CompoundTrigger requires at least 1 part\n")
+			return false
+		}
+		for _, part := range cfg.Parts {
+			switch part.Type {
+			case physicsColliderRect, physicsColliderCircle, physicsColliderCapsule, physicsColliderPolygon:
+			default:
+				fmt.Printf("Shape validation error: CompoundTrigger part of type %d is not a primitive shape\n", part.Type)
+				return false
+			}
+			partCfg := physicConfig{Type: part.Type, Params: part.Params}
+			if !partCfg.validateShape() {
+				return false
+			}
+		}
+		return true
 	default:
 		fmt.Printf("Shape validation error: Unknown trigger type: %d\n", cfg.Type)
 		return false
@@ -116,6 +171,31 @@ func (cfg *physicConfig) validateShape() bool {
 	return true
 }
 
+// setShapes sets the shape of a config: a single one, or a compound of several
+// ones
+func (cfg *physicConfig) setShapes(shapes []shapeConfig) {
+	if len(shapes) == 1 {
+		cfg.Type = parseColliderShapeType(shapes[0].Type, physicsColliderNone)
+		cfg.Params = append([]float64(nil), shapes[0].Params...)
+		cfg.Pivot = shapes[0].Pivot
+		cfg.Parts = nil
+		return
+	}
+	parts := make([]ColliderPart, len(shapes))
+	for i, shape := range shapes {
+		parts[i] = ColliderPart{
+			Type:   parseColliderShapeType(shape.Type, physicsColliderNone),
+			Params: append([]float64(nil), shape.Params...),
+			PivotX: shape.Pivot.X,
+			PivotY: shape.Pivot.Y,
+		}
+	}
+	cfg.Type = physicsColliderCompound
+	cfg.Params = nil
+	cfg.Pivot = mathf.Vec2{}
+	cfg.Parts = parts
+}
+
 // getDimensions calculates width and height based on type and shape parameters
 func (cfg *physicConfig) getDimensions() (float64, float64) {
 	switch cfg.Type {
@@ -134,6 +214,23 @@ func (cfg *physicConfig) getDimensions() (float64, float64) {
 			height := math.Max(cfg.Params[1], 0)
 			return radius * 2, height
 		}
+	case physicsColliderPolygon, physicsColliderOutline:
+		// centered on the pivot, as the shape
+		w, h := 0.0, 0.0
+		for i := 0; i+1 < len(cfg.Params); i += 2 {
+			w = math.Max(w, math.Abs(cfg.Params[i])*2)
+			h = math.Max(h, math.Abs(cfg.Params[i+1])*2)
+		}
+		return w, h
+	case physicsColliderCompound:
+		w, h := 0.0, 0.0
+		for _, part := range cfg.Parts {
+			partCfg := physicConfig{Type: part.Type, Params: part.Params}
+			pw, ph := partCfg.getDimensions()
+			w = math.Max(w, math.Abs(part.PivotX)*2+pw)
+			h = math.Max(h, math.Abs(part.PivotY)*2+ph)
+		}
+		return w, h
 	default:
 		if len(cfg.Params) >= 2 {
 			return math.Max(cfg.Params[0], 0), math.Max(cfg.Params[1], 0)
@@ -158,7 +255,7 @@ func (cfg *physicConfig) syncToProxy(syncProxy *engine.Sprite, isTrigger bool, s
 // syncShape synchronizes shape to engine proxy
 func (cfg *physicConfig) syncShape(syncProxy *engine.Sprite, isTrigger bool, sprite *SpriteImpl) {
 	scale := sprite.scale
-	if cfg.Type != physicsColliderNone && cfg.Type != physicsColliderAuto {
+	if cfg.Type != physicsColliderNone && cfg.Type != physicsColliderAuto && cfg.Type != physicsColliderOutline {
 		center := mathf.NewVec2(0, 0)
 		applyRenderOffset(sprite, &center.X, &center.Y)
 		cfg.PivotOffset = center.Divf(scale)
@@ -171,6 +268,8 @@ func (cfg *physicConfig) syncShape(syncProxy *engine.Sprite, isTrigger bool, spr
 		}
 		cfg.Pivot = pivot
 		cfg.Params = []float64{autoSize.X, autoSize.Y}
+	} else if cfg.Type == physicsColliderOutline {
+		cfg.Pivot, cfg.Params = syncGetCostumeOutlineByAlpha(sprite, 1.0)
 	}
 	cfg.applyShape(syncProxy, isTrigger, scale)
 }
@@ -179,28 +278,114 @@ func (cfg *physicConfig) applyShape(syncProxy *engine.Sprite, isTrigger bool, sc
 	pivot := cfg.Pivot.Sub(cfg.PivotOffset)
 	pivot = pivot.Mulf(scale)
 	switch cfg.Type {
-	case physicsColliderCircle:
+	case physicsColliderNone:
+		syncProxy.SetColliderEnabled(isTrigger, false)
+	case physicsColliderCompound:
 		syncProxy.SetColliderEnabled(isTrigger, true)
-		if len(cfg.Params) >= 1 {
-			syncProxy.SetColliderShapeCircle(isTrigger, pivot, math.Max(cfg.Params[0]*scale, 0.01))
+		for i, part := range cfg.Parts {
+			partPivot := cfg.Pivot.Add(part.pivot()).Sub(cfg.PivotOffset).Mulf(scale)
+			if i == 0 {
+				applyShapeParams(syncProxy, isTrigger, part.Type, partPivot, part.Params, scale)
+				continue
+			}
+			params := make([]float64, len(part.Params))
+			for j, v := range part.Params {
+				params[j] = v * scale
+			}
+			syncProxy.AddColliderShape(isTrigger, part.Type, partPivot, params)
 		}
-	case physicsColliderRect:
+	default:
 		syncProxy.SetColliderEnabled(isTrigger, true)
-		if len(cfg.Params) >= 2 {
-			syncProxy.SetColliderShapeRect(isTrigger, pivot, mathf.NewVec2(cfg.Params[0]*scale, cfg.Params[1]*scale))
+		applyShapeParams(syncProxy, isTrigger, cfg.Type, pivot, cfg.Params, scale)
+	}
+}
+
+// applyShapeParams sets the shape of the collider, replacing any other one
+func applyShapeParams(syncProxy *engine.Sprite, isTrigger bool, ctype ColliderShapeType, pivot mathf.Vec2, params []float64, scale float64) {
+	switch ctype {
+	case physicsColliderCircle:
+		if len(params) >= 1 {
+			syncProxy.SetColliderShapeCircle(isTrigger, pivot, math.Max(params[0]*scale, 0.01))
+		}
+	case physicsColliderRect, physicsColliderAuto:
+		if len(params) >= 2 {
+			syncProxy.SetColliderShapeRect(isTrigger, pivot, mathf.NewVec2(params[0]*scale, params[1]*scale))
 		}
 	case physicsColliderCapsule:
-		syncProxy.SetColliderEnabled(isTrigger, true)
-		if len(cfg.Params) >= 2 {
-			syncProxy.SetColliderShapeCapsule(isTrigger, pivot, mathf.NewVec2(cfg.Params[0]*scale*2, cfg.Params[1]*scale))
+		if len(params) >= 2 {
+			syncProxy.SetColliderShapeCapsule(isTrigger, pivot, mathf.NewVec2(params[0]*scale*2, params[1]*scale))
 		}
-	case physicsColliderAuto:
-		syncProxy.SetColliderEnabled(isTrigger, true)
-		if len(cfg.Params) >= 2 {
-			syncProxy.SetColliderShapeRect(isTrigger, pivot, mathf.NewVec2(cfg.Params[0]*scale, cfg.Params[1]*scale))
+	case physicsColliderPolygon, physicsColliderOutline:
+		if len(params) >= 6 {
+			points := make([]float64, len(params))
+			for i, v := range params {
+				points[i] = v * scale
+			}
+			syncProxy.SetColliderShapePolygon(isTrigger, pivot, points)
 		}
-	case physicsColliderNone:
-		syncProxy.SetColliderEnabled(isTrigger, false)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Costume Shapes
+// -----------------------------------------------------------------------------
+
+// applyCostumeShapes sets the shapes overridden by the current costume, or
+// restores the ones of the sprite. It returns false if the costume didn't
+// change since the last call.
+func (p *SpriteImpl) applyCostumeShapes() bool {
+	name := p.getCostumeName()
+	if name == p.shapedCostume {
+		return false
+	}
+	p.shapedCostume = name
+	if p.defaultShapes != nil {
+		p.collisionInfo.copyShapeFrom(&p.defaultShapes[0])
+		p.triggerInfo.copyShapeFrom(&p.defaultShapes[1])
+		p.defaultShapes = nil
+	}
+	override := p.costumeShapes[name]
+	if override == nil {
+		return true
+	}
+	defaults := new([2]physicConfig)
+	defaults[0].copyFrom(&p.collisionInfo)
+	defaults[1].copyFrom(&p.triggerInfo)
+	p.defaultShapes = defaults
+	overrideShapes(&p.collisionInfo, &defaults[0], override.Collision, p.name, name)
+	overrideShapes(&p.triggerInfo, &defaults[1], override.Trigger, p.name, name)
+	return true
+}
+
+func overrideShapes(cfg, defaults *physicConfig, shapes []shapeConfig, spriteName, costumeName string) {
+	if len(shapes) == 0 {
+		return
+	}
+	cfg.setShapes(shapes)
+	if !cfg.validateShape() {
+		spxlog.Warn("Invalid shapes of costume %s for sprite %s, using the sprite ones", costumeName, spriteName)
+		cfg.copyShapeFrom(defaults)
+	}
+}
+
+// followsCostume reports whether the shape is computed from the costume
+func (cfg *physicConfig) followsCostume() bool {
+	return cfg.Type == physicsColliderAuto || cfg.Type == physicsColliderOutline
+}
+
+// syncCheckUpdateShapes updates the shapes once the costume changed, if they
+// follow it or are overridden by costume
+func (p *SpriteImpl) syncCheckUpdateShapes() {
+	if p.isAnimating {
+		return
+	}
+	overridden := p.defaultShapes != nil
+	if !p.applyCostumeShapes() {
+		return
+	}
+	if overridden || p.defaultShapes != nil || p.collisionInfo.followsCostume() || p.triggerInfo.followsCostume() {
+		p.collisionInfo.syncShape(p.syncSprite, false, p)
+		p.triggerInfo.syncShape(p.syncSprite, true, p)
 	}
 }
 
@@ -232,6 +417,9 @@ func toPhysicsMode(mode string) PhysicsMode {
 
 func (p *SpriteImpl) SetPhysicsMode(mode PhysicsMode) {
 	p.physicsMode = mode
+	if p.frozen { // applied when the game resumes
+		return
+	}
 	spriteMgr.SetPhysicsMode(p.getSpriteId(), int64(mode))
 }
 
@@ -244,11 +432,18 @@ func (p *SpriteImpl) PhysicsMode() PhysicsMode {
 // -----------------------------------------------------------------------------
 
 func (p *SpriteImpl) Velocity() (velocityX, velocityY float64) {
+	if p.frozen {
+		return p.frozenVelocity.X, p.frozenVelocity.Y
+	}
 	vel := spriteMgr.GetVelocity(p.getSpriteId())
 	return vel.X, vel.Y
 }
 
 func (p *SpriteImpl) SetVelocity(velocityX, velocityY float64) {
+	if p.frozen { // applied when the game resumes
+		p.frozenVelocity = mathf.NewVec2(velocityX, velocityY)
+		return
+	}
 	spriteMgr.SetVelocity(p.getSpriteId(), mathf.NewVec2(velocityX, velocityY))
 }
 
@@ -260,6 +455,28 @@ func (p *SpriteImpl) IsOnFloor() bool {
 	return spriteMgr.IsOnFloor(p.getSpriteId())
 }
 
+func (p *SpriteImpl) IsOnWall() bool {
+	return spriteMgr.IsOnWall(p.getSpriteId())
+}
+
+func (p *SpriteImpl) IsOnCeiling() bool {
+	return spriteMgr.IsOnCeiling(p.getSpriteId())
+}
+
+// FloorNormal returns the normal of the floor the sprite stands on, (0, 0) if
+// it's not on a floor.
+func (p *SpriteImpl) FloorNormal() (normalX, normalY float64) {
+	normal := spriteMgr.GetFloorNormal(p.getSpriteId())
+	return normal.X, normal.Y
+}
+
+// WallNormal returns the normal of the wall the sprite touches, (0, 0) if it's
+// not on a wall.
+func (p *SpriteImpl) WallNormal() (normalX, normalY float64) {
+	normal := spriteMgr.GetWallNormal(p.getSpriteId())
+	return normal.X, normal.Y
+}
+
 // -----------------------------------------------------------------------------
 // Gravity Control
 // -----------------------------------------------------------------------------
@@ -272,6 +489,90 @@ func (p *SpriteImpl) SetGravity(gravity float64) {
 	spriteMgr.SetGravity(p.getSpriteId(), gravity)
 }
 
+// -----------------------------------------------------------------------------
+// Forces and Body Properties
+// -----------------------------------------------------------------------------
+
+// AddForce pushes the sprite during the next physics step. Unlike an impulse,
+// its effect on the velocity is proportional to the duration of the step: a
+// force applied in every frame accelerates the sprite steadily.
+func (p *SpriteImpl) AddForce(forceX, forceY float64) {
+	spriteMgr.AddForce(p.getSpriteId(), mathf.NewVec2(forceX, forceY))
+}
+
+// bodyProp is a set of physics body properties of a sprite
+type bodyProp uint8
+
+const (
+	bodyMass bodyProp = 1 << iota
+	bodyFriction
+	bodyAirDrag
+)
+
+// syncBodyProps applies the body properties set to a new proxy, and reads the
+// defaults of the engine for the others
+func (p *SpriteImpl) syncBodyProps(syncProxy *engine.Sprite) {
+	if p.bodySet&bodyMass != 0 {
+		syncProxy.SetMass(p.mass)
+	} else {
+		p.mass = syncProxy.GetMass()
+	}
+	if p.bodySet&bodyFriction != 0 {
+		syncProxy.SetFriction(p.friction)
+	} else {
+		p.friction = syncProxy.GetFriction()
+	}
+	if p.bodySet&bodyAirDrag != 0 {
+		syncProxy.SetDrag(p.airDrag)
+	} else {
+		p.airDrag = syncProxy.GetDrag()
+	}
+}
+
+// Mass returns the mass of the physics body of the sprite.
+func (p *SpriteImpl) Mass() float64 {
+	return p.mass
+}
+
+// SetMass sets the mass of the physics body of the sprite, kept by its clones.
+func (p *SpriteImpl) SetMass(mass float64) {
+	p.mass = mass
+	p.bodySet |= bodyMass
+	if p.syncSprite != nil { // else applied when the proxy is created
+		spriteMgr.SetMass(p.getSpriteId(), mass)
+	}
+}
+
+// Friction returns the friction of the physics body of the sprite.
+func (p *SpriteImpl) Friction() float64 {
+	return p.friction
+}
+
+// SetFriction sets the friction of the physics body of the sprite, kept by its
+// clones.
+func (p *SpriteImpl) SetFriction(friction float64) {
+	p.friction = friction
+	p.bodySet |= bodyFriction
+	if p.syncSprite != nil {
+		spriteMgr.SetFriction(p.getSpriteId(), friction)
+	}
+}
+
+// AirDrag returns the air drag of the physics body of the sprite.
+func (p *SpriteImpl) AirDrag() float64 {
+	return p.airDrag
+}
+
+// SetAirDrag sets the air drag of the physics body of the sprite, kept by its
+// clones.
+func (p *SpriteImpl) SetAirDrag(drag float64) {
+	p.airDrag = drag
+	p.bodySet |= bodyAirDrag
+	if p.syncSprite != nil {
+		spriteMgr.SetDrag(p.getSpriteId(), drag)
+	}
+}
+
 // -----------------------------------------------------------------------------
 // Unified Physics Implementation (Private Methods)
 // -----------------------------------------------------------------------------
@@ -356,8 +657,11 @@ func (p *SpriteImpl) applyPhysicShape(isTrigger bool) {
 			if len(params) >= 2 {
 				p.syncSprite.SetColliderShapeCapsule(isTrigger, config.Pivot, mathf.NewVec2(params[0]*2, params[1]))
 			}
-		case PolygonCollider:
-			// TODO: Implement polygon shape setting when available
+		case PolygonCollider, CompoundCollider:
+			config.applyShape(p.syncSprite, isTrigger, p.scale)
+		case OutlineCollider:
+			config.Pivot, config.Params = getCostumeOutlineByAlpha(p, 1.0, false)
+			config.applyShape(p.syncSprite, isTrigger, p.scale)
 		}
 	}
 }
@@ -374,6 +678,35 @@ func (p *SpriteImpl) ColliderShape(isTrigger bool) (ColliderShapeType, []float64
 	return p.getPhysicShape(isTrigger)
 }
 
+// SetColliderShapes makes the collider a compound of the given shapes, which
+// may be rects, circles, capsules or polygons.
+func (p *SpriteImpl) SetColliderShapes(isTrigger bool, parts []ColliderPart) error {
+	config := p.getPhysicConfig(isTrigger)
+	newConfig := physicConfig{Type: physicsColliderCompound, Parts: copyColliderParts(parts)}
+	if !newConfig.validateShape() {
+		return fmt.Errorf("invalid compound shape parts")
+	}
+	config.Type = newConfig.Type
+	config.Params = nil
+	config.Parts = newConfig.Parts
+	p.applyPhysicShape(isTrigger)
+	return nil
+}
+
+// ColliderShapes returns the shapes of a compound collider, or the shape of
+// the collider as a single part.
+func (p *SpriteImpl) ColliderShapes(isTrigger bool) []ColliderPart {
+	config := p.getPhysicConfig(isTrigger)
+	switch config.Type {
+	case physicsColliderNone:
+		return nil
+	case physicsColliderCompound:
+		return copyColliderParts(config.Parts)
+	}
+	ctype, params := p.getPhysicShape(isTrigger)
+	return []ColliderPart{{Type: ctype, Params: params}}
+}
+
 func (p *SpriteImpl) SetColliderPivot(isTrigger bool, offsetX, offsetY float64) {
 	p.setPhysicPivot(isTrigger, offsetX, offsetY)
 }
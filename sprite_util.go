@@ -258,6 +258,49 @@ func getCostumeBoundByAlpha(p *SpriteImpl, pscale float64, isSync bool) (mathf.V
 	return center, size
 }
 
+// syncGetCostumeOutlineByAlpha gets costume outline by alpha (sync version)
+func syncGetCostumeOutlineByAlpha(p *SpriteImpl, pscale float64) (mathf.Vec2, []float64) {
+	return getCostumeOutlineByAlpha(p, pscale, true)
+}
+
+// getCostumeOutlineByAlpha gets the polygon following the opaque pixels of the
+// costume, as a pivot and the points relative to it. Atlas costumes fall back
+// to the rect of their region.
+func getCostumeOutlineByAlpha(p *SpriteImpl, pscale float64, isSync bool) (mathf.Vec2, []float64) {
+	cs := p.costumes[p.costumeIndex_]
+	var points []float64
+	if cs.isAtlas() {
+		rect := p.getCostumeAtlasRegion()
+		w, h := float64(rect.Size.X), float64(rect.Size.Y)
+		points = []float64{0, 0, w, 0, w, h, 0, h}
+	} else {
+		// like GetBoundFromAlpha, GetPolygonFromAlpha is very slow
+		if cache, ok := cachedOutlines_[cs.path]; ok {
+			points = cache
+		} else {
+			assetPath := engine.ToAssetPath(cs.path)
+			var ary engine.Array
+			if isSync {
+				ary = engine.SyncGetPolygonFromAlpha(assetPath)
+			} else {
+				ary = resMgr.GetPolygonFromAlpha(assetPath)
+			}
+			points, _ = ary.([]float64)
+		}
+		cachedOutlines_[cs.path] = points
+	}
+	// from the top left of the image, y down, to its center, y up
+	scale := pscale / float64(cs.bitmapResolution)
+	w, h := p.getCostumeSize()
+	w, h = w*pscale, h*pscale
+	ret := make([]float64, len(points))
+	for i := 0; i+1 < len(points); i += 2 {
+		ret[i] = points[i]*scale - w/2
+		ret[i+1] = -(points[i+1]*scale - h/2)
+	}
+	return mathf.NewVec2(0, 0), ret
+}
+
 // -----------------------------------------------------------------------------
 // Configuration Parsing Utilities
 // -----------------------------------------------------------------------------
@@ -298,6 +341,8 @@ func parseColliderShapeType(typeName string, defaultValue int64) int64 {
 		return physicsColliderCapsule
 	case "polygon":
 		return physicsColliderPolygon
+	case "outline":
+		return physicsColliderOutline
 	}
 	return defaultValue
 }
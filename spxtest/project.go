@@ -0,0 +1,128 @@
+//go:build pure_engine
+// +build pure_engine
+
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package spxtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Project describes the assets of a game written by WriteProject, for the
+// tests that don't need real ones.
+type Project struct {
+	// more properties of index.json, the map is 480x360 and the sprites are
+	// in the order of Sprites unless they are set
+	Config map[string]any
+
+	Sprites []SpriteConfig
+
+	// more files, by their path in the assets, e.g. "scenes/level2.json",
+	// written as JSON
+	Files map[string]any
+}
+
+// SpriteConfig describes a sprite of a Project. The sprite wears a single
+// costume named red, a red rect of 111x82 centered on its position.
+type SpriteConfig struct {
+	Name string
+	X, Y float64
+
+	// more properties of the index.json of the sprite, e.g. "physicsMode"
+	Config map[string]any
+}
+
+// WriteProject writes the assets of proj to a temporary directory and makes
+// it the working directory of the test, so that New loads them.
+func WriteProject(t testing.TB, proj Project) {
+	t.Helper()
+	dir := t.TempDir()
+	assets := filepath.Join(dir, "assets")
+	names := make([]string, len(proj.Sprites))
+	for i, spr := range proj.Sprites {
+		names[i] = spr.Name
+	}
+	index := map[string]any{
+		"map":    map[string]any{"width": 480, "height": 360},
+		"zorder": names,
+	}
+	maps.Copy(index, proj.Config)
+	writeJSON(t, filepath.Join(assets, "index.json"), index)
+
+	costume := redCostume(t)
+	for _, spr := range proj.Sprites {
+		cfg := map[string]any{
+			"costumes": []any{
+				map[string]any{"name": "red", "path": "red.png", "x": 55, "y": 41},
+			},
+			"costumeIndex":  0,
+			"heading":       90,
+			"isDraggable":   false,
+			"rotationStyle": "normal",
+			"size":          1,
+			"visible":       true,
+			"x":             spr.X,
+			"y":             spr.Y,
+		}
+		maps.Copy(cfg, spr.Config)
+		sprDir := filepath.Join(assets, "sprites", spr.Name)
+		writeJSON(t, filepath.Join(sprDir, "index.json"), cfg)
+		writeFile(t, filepath.Join(sprDir, "red.png"), costume)
+	}
+	for name, data := range proj.Files {
+		writeJSON(t, filepath.Join(assets, filepath.FromSlash(name)), data)
+	}
+	t.Chdir(dir)
+}
+
+// redCostume returns the image of the costume of the sprites of a Project
+func redCostume(t testing.TB) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 111, 82))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.RGBA{R: 255, A: 255}), image.Point{}, draw.Src)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func writeJSON(t testing.TB, file string, v any) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, file, data)
+}
+
+func writeFile(t testing.TB, file string, data []byte) {
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,357 @@
+//go:build pure_engine
+// +build pure_engine
+
+/*
+ * Copyright (c) 2021 The XGo Authors (xgo.dev). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package spxtest runs spx games on the headless engine for tests.
+//
+// A harness boots a game with its sprites, then advances it frame by frame
+// with a fixed delta, so a test sees the same game state on every run:
+//
+//	func TestWalk(t *testing.T) {
+//		h := spxtest.New(t, new(Game), new(Calf))
+//		h.PressKey(spx.KeyRight)
+//		h.Step(60)
+//		if x := h.Sprite("Calf").Xpos(); x <= 0 {
+//			t.Fatalf("Calf didn't move: x = %v", x)
+//		}
+//	}
+//
+// The game loads its assets from the working directory, WriteProject writes
+// them for the tests that only need plain sprites.
+//
+// The input is injected into the headless engine, not into the events of the
+// game: it goes through the same polling, cooldowns and gesture detection as
+// the input of a player, and reaches the game in the next frame stepped.
+//
+// The package is only available with the pure_engine build tag, run the tests
+// of a project with `xgo test -tags pure_engine`. The engine is process wide
+// and isn't reset between games, so a test binary hosts a single game: put
+// the tests of a game in one test function, or the games in their own
+// packages.
+package spxtest
+
+import (
+	"math"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/goplus/spbase/mathf"
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/internal/engine"
+	"github.com/goplus/spx/v2/pkg/gdspx/pkg/gdspx"
+)
+
+// Delta is the duration in seconds of a frame stepped by a Harness.
+const Delta = 1.0 / 60
+
+var booted atomic.Bool
+
+// Harness drives a game running on the headless engine.
+type Harness struct {
+	t    testing.TB
+	game spx.Gamer
+}
+
+// New boots game with its sprites and steps the first frame, which returns
+// once the game has been loaded. It can be called once per test binary, the
+// test fails if a game has already been booted.
+func New(t testing.TB, game spx.Gamer, sprites ...spx.Sprite) *Harness {
+	t.Helper()
+	if !booted.CompareAndSwap(false, true) {
+		t.Fatal("spxtest: the engine can host only one game per test binary")
+	}
+	gdspx.SetManualStep(true)
+	// the wall clock would leak into click cooldowns and swipe detection
+	engine.SetFrameTimeHook(func(delta, _ float64) (float64, float64) {
+		return delta, Delta
+	})
+	spx.Gopt_Game_Main(game, sprites...)
+	h := &Harness{t: t, game: game}
+	h.Step(1)
+	return h
+}
+
+// Game returns the game under test.
+func (h *Harness) Game() spx.Gamer {
+	return h.game
+}
+
+// Step advances the game by n frames. It stops early if the game exits.
+func (h *Harness) Step(n int) {
+	for i := 0; i < n; i++ {
+		if !gdspx.Step(Delta) {
+			return
+		}
+	}
+}
+
+// StepSeconds advances the game by the number of frames covering secs.
+func (h *Harness) StepSeconds(secs float64) {
+	h.Step(int(math.Ceil(secs / Delta)))
+}
+
+// Frame returns the number of frames simulated so far.
+func (h *Harness) Frame() int64 {
+	return gdspx.Frame()
+}
+
+// Exited reports whether the game requested to exit and with which code.
+func (h *Harness) Exited() (code int, exited bool) {
+	c, exited := gdspx.ExitStatus()
+	return int(c), exited
+}
+
+// RunUntilExit steps the game until it exits and returns the exit code. The
+// test fails if the game is still running after maxFrames.
+func (h *Harness) RunUntilExit(maxFrames int) int {
+	h.t.Helper()
+	for i := 0; i < maxFrames; i++ {
+		if code, exited := h.Exited(); exited {
+			return code
+		}
+		h.Step(1)
+	}
+	if code, exited := h.Exited(); exited {
+		return code
+	}
+	h.t.Fatalf("spxtest: game still running after %d frames", maxFrames)
+	return 0
+}
+
+// Sprite returns the sprite prototype declared as the field name of the game.
+// The test fails if there is no such sprite.
+func (h *Harness) Sprite(name string) spx.Sprite {
+	h.t.Helper()
+	v := reflect.ValueOf(h.game).Elem()
+	if sf, ok := v.Type().FieldByName(name); ok && sf.IsExported() {
+		fld := v.FieldByIndex(sf.Index)
+		if fld.Kind() != reflect.Ptr {
+			fld = fld.Addr()
+		}
+		if spr, ok := fld.Interface().(spx.Sprite); ok && !fld.IsNil() {
+			return spr
+		}
+	}
+	h.t.Fatalf("spxtest: sprite %s not found", name)
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// input
+
+// KeyDown presses key. The key events reach the game on the next frame.
+func (h *Harness) KeyDown(key spx.Key) {
+	gdspx.InjectKey(int64(key), true)
+}
+
+// KeyUp releases key.
+func (h *Harness) KeyUp(key spx.Key) {
+	gdspx.InjectKey(int64(key), false)
+}
+
+// PressKey presses and releases key, one frame each.
+func (h *Harness) PressKey(key spx.Key) {
+	h.KeyDown(key)
+	h.Step(1)
+	h.KeyUp(key)
+	h.Step(1)
+}
+
+// MouseMove moves the mouse to (x, y) in world coordinates.
+func (h *Harness) MouseMove(x, y float64) {
+	gdspx.InjectMousePos(mathf.NewVec2(x, y))
+}
+
+// MouseDown presses the left mouse button.
+func (h *Harness) MouseDown() {
+	gdspx.InjectMouseButton(spx.MOUSE_BUTTON_LEFT, true)
+}
+
+// MouseUp releases the left mouse button.
+func (h *Harness) MouseUp() {
+	gdspx.InjectMouseButton(spx.MOUSE_BUTTON_LEFT, false)
+}
+
+// Click clicks the left mouse button at (x, y).
+func (h *Harness) Click(x, y float64) {
+	h.MouseMove(x, y)
+	h.MouseDown()
+	h.Step(1)
+	h.MouseUp()
+	h.Step(1)
+}
+
+// RightClick clicks the right mouse button at (x, y).
+func (h *Harness) RightClick(x, y float64) {
+	h.clickButton(spx.MOUSE_BUTTON_RIGHT, x, y)
+}
+
+// MiddleClick clicks the middle mouse button at (x, y).
+func (h *Harness) MiddleClick(x, y float64) {
+	h.clickButton(spx.MOUSE_BUTTON_MIDDLE, x, y)
+}
+
+func (h *Harness) clickButton(button int64, x, y float64) {
+	h.MouseMove(x, y)
+	gdspx.InjectMouseButton(button, true)
+	h.Step(1)
+	gdspx.InjectMouseButton(button, false)
+	h.Step(1)
+}
+
+// ScrollWheel scrolls the mouse wheel by (dx, dy) notches during the next
+// frame, dy > 0 scrolling up.
+func (h *Harness) ScrollWheel(dx, dy float64) {
+	gdspx.InjectMouseWheel(mathf.NewVec2(dx, dy))
+}
+
+// Swipe drags the mouse from (fromX, fromY) to (toX, toY) over the given
+// number of frames.
+func (h *Harness) Swipe(fromX, fromY, toX, toY float64, frames int) {
+	frames = max(frames, 1)
+	h.MouseMove(fromX, fromY)
+	h.MouseDown()
+	h.Step(1)
+	for i := 1; i <= frames; i++ {
+		t := float64(i) / float64(frames)
+		h.MouseMove(fromX+(toX-fromX)*t, fromY+(toY-fromY)*t)
+		h.Step(1)
+	}
+	h.MouseUp()
+	h.Step(1)
+}
+
+// TouchDown touches the screen with the finger id at (x, y) in world
+// coordinates.
+func (h *Harness) TouchDown(id int, x, y float64) {
+	gdspx.InjectTouch(int64(id), mathf.NewVec2(x, y), true)
+}
+
+// TouchMove moves the finger id to (x, y).
+func (h *Harness) TouchMove(id int, x, y float64) {
+	gdspx.InjectTouch(int64(id), mathf.NewVec2(x, y), true)
+}
+
+// TouchUp lifts the finger id.
+func (h *Harness) TouchUp(id int) {
+	gdspx.InjectTouch(int64(id), mathf.Vec2{}, false)
+}
+
+// Tap taps the screen with the finger 0 at (x, y).
+func (h *Harness) Tap(x, y float64) {
+	h.TouchDown(0, x, y)
+	h.Step(1)
+	h.TouchUp(0)
+	h.Step(1)
+}
+
+// DoubleTap taps the screen twice at (x, y).
+func (h *Harness) DoubleTap(x, y float64) {
+	h.Tap(x, y)
+	h.Tap(x, y)
+}
+
+// LongPress keeps the finger 0 down at (x, y) for secs seconds.
+func (h *Harness) LongPress(x, y, secs float64) {
+	h.TouchDown(0, x, y)
+	h.Step(1)
+	h.StepSeconds(secs)
+	h.TouchUp(0)
+	h.Step(1)
+}
+
+// Drag drags the finger 0 from (fromX, fromY) to (toX, toY) over the given
+// number of frames.
+func (h *Harness) Drag(fromX, fromY, toX, toY float64, frames int) {
+	frames = max(frames, 1)
+	h.TouchDown(0, fromX, fromY)
+	h.Step(1)
+	for i := 1; i <= frames; i++ {
+		t := float64(i) / float64(frames)
+		h.TouchMove(0, fromX+(toX-fromX)*t, fromY+(toY-fromY)*t)
+		h.Step(1)
+	}
+	h.TouchUp(0)
+	h.Step(1)
+}
+
+// Pinch moves the fingers 0 and 1 on both sides of (x, y), horizontally,
+// from fromDist to toDist apart over the given number of frames.
+func (h *Harness) Pinch(x, y, fromDist, toDist float64, frames int) {
+	frames = max(frames, 1)
+	for i := 0; i <= frames; i++ {
+		d := (fromDist + (toDist-fromDist)*float64(i)/float64(frames)) / 2
+		h.TouchMove(0, x-d, y)
+		h.TouchMove(1, x+d, y)
+		h.Step(1)
+	}
+	h.TouchUp(0)
+	h.TouchUp(1)
+	h.Step(1)
+}
+
+// Rotate turns the fingers 0 and 1, radius away from (x, y) on opposite
+// sides, by degrees clockwise over the given number of frames.
+func (h *Harness) Rotate(x, y, radius, degrees float64, frames int) {
+	frames = max(frames, 1)
+	for i := 0; i <= frames; i++ {
+		a := -degrees * float64(i) / float64(frames) * math.Pi / 180
+		dx, dy := radius*math.Cos(a), radius*math.Sin(a)
+		h.TouchMove(0, x-dx, y-dy)
+		h.TouchMove(1, x+dx, y+dy)
+		h.Step(1)
+	}
+	h.TouchUp(0)
+	h.TouchUp(1)
+	h.Step(1)
+}
+
+// ConnectGamepad connects or disconnects the gamepad id. Pressing a button or
+// moving an axis connects it too.
+func (h *Harness) ConnectGamepad(id int, connected bool) {
+	gdspx.InjectGamepad(int64(id), connected)
+}
+
+// GamepadButtonDown presses btn of the gamepad id.
+func (h *Harness) GamepadButtonDown(id int, btn spx.GamepadButton) {
+	gdspx.InjectGamepadButton(int64(id), int64(btn), true)
+}
+
+// GamepadButtonUp releases btn of the gamepad id.
+func (h *Harness) GamepadButtonUp(id int, btn spx.GamepadButton) {
+	gdspx.InjectGamepadButton(int64(id), int64(btn), false)
+}
+
+// PressGamepadButton presses and releases btn of the gamepad id, one frame
+// each.
+func (h *Harness) PressGamepadButton(id int, btn spx.GamepadButton) {
+	h.GamepadButtonDown(id, btn)
+	h.Step(1)
+	h.GamepadButtonUp(id, btn)
+	h.Step(1)
+}
+
+// MoveGamepadAxis moves axis of the gamepad id to value, with the Y axes
+// pointing up as Game.GamepadAxis reads them before the dead zone.
+func (h *Harness) MoveGamepadAxis(id int, axis spx.GamepadAxis, value float64) {
+	if axis == spx.GamepadLeftY || axis == spx.GamepadRightY {
+		value = -value
+	}
+	gdspx.InjectGamepadAxis(int64(id), int64(axis), value)
+}
@@ -0,0 +1,105 @@
+//go:build pure_engine
+
+package actions
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/internal/hotreload"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// TestActions plays the actions with the keyboard, a gamepad and touches,
+// rebinds jump, then expects the game run again to keep the binding.
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Config: map[string]any{
+		"inputMap": map[string]any{
+			"jump":  []any{"Space", "gamepad:A", "sprite:Calf"},
+			"left":  []any{"Left", "gamepad:LeftX-"},
+			"right": []any{"Right", "gamepad:LeftX+"},
+		},
+	},
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Calf", X: -100, Y: 0},
+	},
+}
+
+func TestActions(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Calf))
+	h.Step(5)
+	calf := &g.Calf
+
+	h.PressKey(spx.KeySpace)
+	h.PressGamepadButton(0, spx.GamepadA)
+	h.Tap(-100, 0)
+	h.Step(2)
+	if calf.Jumps != 3 {
+		t.Fatalf("Calf jumped %d times with space, A and a tap, want 3", calf.Jumps)
+	}
+
+	h.KeyDown(spx.KeyRight)
+	h.Step(1)
+	x := calf.Xpos()
+	h.Step(3)
+	h.KeyUp(spx.KeyRight)
+	if dx := calf.Xpos() - x; dx != 30 {
+		t.Fatalf("Calf moved by %v in 3 frames with right held, want 30", dx)
+	}
+	h.Step(2)
+
+	// past the dead zone of 0.2, the stick pushed to 0.6 reads 0.5
+	h.MoveGamepadAxis(0, spx.GamepadLeftX, -0.6)
+	h.Step(1)
+	x = calf.Xpos()
+	h.Step(4)
+	h.MoveGamepadAxis(0, spx.GamepadLeftX, 0)
+	if dx := calf.Xpos() - x; math.Abs(dx+20) > 1e-9 {
+		t.Fatalf("Calf moved by %v in 4 frames with the stick, want -20", dx)
+	}
+	h.Step(2)
+
+	h.PressKey(spx.KeyB)
+	h.PressKey(spx.KeySpace)
+	h.PressKey(spx.KeyJ)
+	h.PressKey(spx.KeyD)
+	if calf.Jumps != 4 || !slices.Equal(g.Inputs, []string{"J"}) {
+		t.Fatalf("Calf jumped %d times bound to %q, want 4 with J", calf.Jumps, g.Inputs)
+	}
+
+	// the save data outlives the game in the process, as on disk
+	g2 := new(Game)
+	done := make(chan error, 1)
+	go func() {
+		done <- hotreload.ReloadGame(func() error {
+			go spx.Gopt_Game_Main(g2, new(Calf))
+			return nil
+		}, func() {})
+	}()
+	h.Step(40)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	g, calf = g2, &g2.Calf
+	jumps := calf.Jumps
+
+	h.PressKey(spx.KeyD)
+	h.PressKey(spx.KeySpace)
+	h.PressKey(spx.KeyJ)
+	if calf.Jumps != jumps+1 || !slices.Equal(g.Inputs, []string{"J"}) {
+		t.Fatalf("Calf jumped %d times bound to %q in the next run, want once with J", calf.Jumps-jumps, g.Inputs)
+	}
+	h.PressKey(spx.KeyR)
+	h.PressKey(spx.KeyJ)
+	h.PressKey(spx.KeySpace)
+	h.PressKey(spx.KeyD)
+	if want := []string{"Space", "gamepad:A", "sprite:Calf"}; calf.Jumps != jumps+2 || !slices.Equal(g.Inputs, want) {
+		t.Fatalf("Calf jumped %d times bound to %q after the reset, want twice with %q", calf.Jumps-jumps, g.Inputs, want)
+	}
+}
@@ -0,0 +1,34 @@
+package actions
+
+import "github.com/goplus/spx/v2"
+
+type Calf struct {
+	spx.SpriteImpl
+	*Game
+	Jumps int
+}
+
+type Game struct {
+	spx.Game
+	Calf   Calf
+	Inputs []string
+}
+
+// B binds jump to J instead, R resets the bindings and D records the inputs
+// of jump.
+func (this *Game) MainEntry() {
+	this.OnKey__0(spx.KeyB, func() { this.BindAction("jump", "J") })
+	this.OnKey__0(spx.KeyR, func() { this.ResetActions() })
+	this.OnKey__0(spx.KeyD, func() { this.Inputs = this.ActionInputs("jump") })
+}
+
+// Calf moves along the left and right actions and counts its jumps.
+func (this *Calf) Main() {
+	this.OnAction("jump", func() { this.Jumps++ })
+	this.OnStart(func() {
+		for {
+			this.ChangeXpos(this.Axis("left", "right") * 10)
+			this.WaitNextFrame()
+		}
+	})
+}
@@ -0,0 +1,16 @@
+//go:build pure_engine
+
+package main
+
+import (
+	"testing"
+
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+func TestCI(t *testing.T) {
+	h := spxtest.New(t, new(Game), new(Calf))
+	if code := h.RunUntilExit(600); code != 0 {
+		t.Fatalf("exit code %d, want 0", code)
+	}
+}
@@ -0,0 +1,75 @@
+//go:build pure_engine
+
+package contacts
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Config: map[string]any{"autoSetCollisionLayer": false},
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Ground", X: 0, Y: -100, Config: map[string]any{
+			"physicsMode":          "static",
+			"collisionShapeType":   "rect",
+			"collisionShapeParams": []any{300, 20},
+			"triggerShapeType":     "none",
+		}},
+		{Name: "Coin", X: 0, Y: 0, Config: map[string]any{
+			"physicsMode":        "no",
+			"collisionShapeType": "none",
+			"triggerShapeType":   "rect",
+			"triggerShapeParams": []any{40, 40},
+			"triggerLayer":       2,
+		}},
+		{Name: "Ball", X: 0, Y: 80, Config: map[string]any{
+			"physicsMode":          "dynamic",
+			"collisionShapeType":   "circle",
+			"collisionShapeParams": []any{20},
+			"triggerShapeType":     "circle",
+			"triggerShapeParams":   []any{20},
+			"triggerMask":          2,
+		}},
+	},
+}
+
+func TestContacts(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Ground), new(Coin), new(Ball))
+	h.Step(3)
+	ball := &g.Ball
+	if len(ball.Contacts) != 0 {
+		t.Fatalf("%d contacts before Ball falls, want none", len(ball.Contacts))
+	}
+	h.StepSeconds(2)
+	if len(ball.Contacts) != 2 {
+		t.Fatalf("%d contacts, want Ball to pass through Coin and land on Ground", len(ball.Contacts))
+	}
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-6 }
+
+	// the triggers overlap from the top of Coin
+	coin := ball.Contacts[0]
+	if coin.Other.Name() != "Coin" || !coin.Trigger || !near(coin.Y, 20) || !near(coin.NormalY, 1) {
+		t.Fatalf("first contact with %s, trigger %v at y = %v, normal (%v, %v), want the trigger of Coin at y = 20, normal (0, 1)",
+			coin.Other.Name(), coin.Trigger, coin.Y, coin.NormalX, coin.NormalY)
+	}
+
+	// the bodies collide on the top of Ground, faster
+	ground := ball.Contacts[1]
+	if ground.Other.Name() != "Ground" || ground.Trigger || !near(ground.Y, -90) || !near(ground.NormalY, 1) {
+		t.Fatalf("second contact with %s, trigger %v at y = %v, normal (%v, %v), want the body of Ground at y = -90, normal (0, 1)",
+			ground.Other.Name(), ground.Trigger, ground.Y, ground.NormalX, ground.NormalY)
+	}
+	if speed := ground.ImpactSpeed(); speed <= coin.ImpactSpeed() || !near(speed, -ground.VelocityY) {
+		t.Fatalf("impact speeds %v on Coin, %v on Ground with a velocity of %v, want Ball falling faster on Ground",
+			coin.ImpactSpeed(), speed, ground.VelocityY)
+	}
+	if ball.Coins != 1 {
+		t.Fatalf("%d contacts with the trigger layer of Coin, want 1", ball.Coins)
+	}
+}
@@ -0,0 +1,39 @@
+package contacts
+
+import "github.com/goplus/spx/v2"
+
+type Ground struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Coin struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Ball struct {
+	spx.SpriteImpl
+	*Game
+	Contacts []spx.Contact
+	Coins    int
+}
+
+type Game struct {
+	spx.Game
+	Ground Ground
+	Coin   Coin
+	Ball   Ball
+}
+
+func (this *Game) MainEntry() {}
+
+func (this *Ground) Main() {}
+
+func (this *Coin) Main() {}
+
+// Ball falls through Coin onto Ground.
+func (this *Ball) Main() {
+	this.OnCollide__0(func(c spx.Contact) { this.Contacts = append(this.Contacts, c) })
+	this.OnCollide__1(2, func(c spx.Contact) { this.Coins++ })
+}
@@ -0,0 +1,106 @@
+//go:build pure_engine
+
+package forces
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Config: map[string]any{"autoSetCollisionLayer": false},
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Floor", X: 0, Y: -100, Config: map[string]any{
+			"physicsMode":          "static",
+			"collisionShapeType":   "rect",
+			"collisionShapeParams": []any{400, 20},
+			"triggerShapeType":     "none",
+		}},
+		{Name: "Wall", X: 150, Y: 0, Config: map[string]any{
+			"physicsMode":          "static",
+			"collisionShapeType":   "rect",
+			"collisionShapeParams": []any{20, 200},
+			"triggerShapeType":     "none",
+		}},
+		{Name: "Box", X: -100, Y: -70, Config: map[string]any{
+			"physicsMode":          "dynamic",
+			"collisionShapeType":   "rect",
+			"collisionShapeParams": []any{40, 40},
+			"triggerShapeType":     "none",
+		}},
+	},
+}
+
+func TestForces(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Floor), new(Wall), new(Box))
+	box := &g.Box
+	state := func() State {
+		h.PressKey(spx.KeyS)
+		h.Step(3)
+		return box.State
+	}
+	push := func(frames int) {
+		h.PressKey(spx.KeyF)
+		h.Step(frames)
+		h.PressKey(spx.KeyF)
+		h.Step(3)
+	}
+	h.Step(5)
+
+	s := state()
+	if !s.OnFloor || s.FloorNormal != [2]float64{0, 1} || s.OnWall || s.OnCeiling || s.Mass != 1 {
+		t.Fatalf("Box at rest: %+v, want it on Floor, normal (0, 1), with a mass of 1", s)
+	}
+
+	// the same push gives half the speed to twice the mass
+	push(30)
+	v1 := state().Vx
+	if v1 <= 0 {
+		t.Fatalf("Box at %v px/s after a push, want it moving right", v1)
+	}
+	h.PressKey(spx.KeyM)
+	h.Step(3)
+	push(30)
+	s = state()
+	if s.Mass != 2 || math.Abs(s.Vx-v1*1.5) > v1*0.01 {
+		t.Fatalf("Box at %v px/s after pushing it again with a mass of %v, want %v px/s", s.Vx, s.Mass, v1*1.5)
+	}
+
+	// without friction it slides on, the air slows it down
+	v2 := s.Vx
+	h.Step(30)
+	if v := state().Vx; v != v2 {
+		t.Fatalf("Box at %v px/s then %v px/s without friction nor drag, want the same speed", v2, v)
+	}
+	h.PressKey(spx.KeyD)
+	h.Step(30)
+	v3 := state().Vx
+	if v3 <= 0 || v3 >= v2*0.8 {
+		t.Fatalf("Box at %v px/s half a second after setting an air drag, want it slowed down from %v px/s", v3, v2)
+	}
+
+	// its friction on the floor stops it
+	h.PressKey(spx.KeyT)
+	h.Step(10)
+	if v := state().Vx; v != 0 {
+		t.Fatalf("Box at %v px/s on a rough floor, want it stopped", v)
+	}
+
+	// pushed against Wall, it stays on it
+	h.PressKey(spx.KeyG)
+	h.PressKey(spx.KeyF)
+	h.StepSeconds(5)
+	s = state()
+	if !s.OnWall || s.WallNormal != [2]float64{-1, 0} || !s.OnFloor || s.OnCeiling {
+		t.Fatalf("Box pushed against Wall: %+v, want it on Floor and on Wall, normal (-1, 0)", s)
+	}
+	if x := box.Xpos(); math.Abs(x-120) > 1e-6 {
+		t.Fatalf("Box at x = %v against Wall, want 120", x)
+	}
+}
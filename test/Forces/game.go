@@ -0,0 +1,81 @@
+package forces
+
+import "github.com/goplus/spx/v2"
+
+type Floor struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Wall struct {
+	spx.SpriteImpl
+	*Game
+}
+
+// State is what Box reports when S is pressed
+type State struct {
+	Vx, Mass        float64
+	OnFloor, OnWall bool
+	OnCeiling       bool
+	FloorNormal     [2]float64
+	WallNormal      [2]float64
+}
+
+type Box struct {
+	spx.SpriteImpl
+	*Game
+	Pushing bool
+	State   State
+}
+
+type Game struct {
+	spx.Game
+	Floor Floor
+	Wall  Wall
+	Box   Box
+}
+
+func (this *Game) MainEntry() {}
+
+func (this *Floor) Main() {}
+
+func (this *Wall) Main() {}
+
+// Box slides on Floor towards Wall, pushed while F is toggled on. M makes it
+// heavier, D slows it down in the air, T makes it rough and G smooth again.
+func (this *Box) Main() {
+	this.OnKey__0(spx.KeyF, func() {
+		this.Pushing = !this.Pushing
+	})
+	this.OnKey__0(spx.KeyM, func() {
+		this.SetMass(2)
+	})
+	this.OnKey__0(spx.KeyD, func() {
+		this.SetAirDrag(1)
+	})
+	this.OnKey__0(spx.KeyT, func() {
+		this.SetAirDrag(0)
+		this.SetFriction(1)
+	})
+	this.OnKey__0(spx.KeyG, func() {
+		this.SetFriction(0)
+	})
+	this.OnKey__0(spx.KeyS, func() {
+		s := &this.State
+		s.Vx, _ = this.Velocity()
+		s.Mass = this.Mass()
+		s.OnFloor, s.OnWall, s.OnCeiling = this.IsOnFloor(), this.IsOnWall(), this.IsOnCeiling()
+		s.FloorNormal[0], s.FloorNormal[1] = this.FloorNormal()
+		s.WallNormal[0], s.WallNormal[1] = this.WallNormal()
+	})
+	this.OnStart(func() {
+		this.SetFriction(0)
+		this.SetAirDrag(0)
+		for {
+			if this.Pushing {
+				this.AddForce(100, 0)
+			}
+			this.WaitNextFrame()
+		}
+	})
+}
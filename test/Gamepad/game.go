@@ -0,0 +1,46 @@
+package gamepad
+
+import (
+	"fmt"
+
+	"github.com/goplus/spx/v2"
+)
+
+type Calf struct {
+	spx.SpriteImpl
+	*Game
+	Firing bool
+}
+
+type Game struct {
+	spx.Game
+	Calf Calf
+	Log  []string
+}
+
+func (this *Game) MainEntry() {}
+
+func (this *Game) log(a ...any) {
+	this.Log = append(this.Log, fmt.Sprint(a...))
+}
+
+// Calf moves with the left stick of the gamepad 1 and fires while its X
+// button is held. A makes it jump.
+func (this *Calf) Main() {
+	this.OnGamepadConnected(func(id int, connected bool) {
+		this.log("gamepad ", id, " connected: ", connected)
+	})
+	this.OnGamepadButton__0(spx.GamepadA, func(id int) {
+		this.ChangeYpos(50)
+	})
+	this.OnGamepadButton__1(func(id int, btn spx.GamepadButton) {
+		this.log("gamepad ", id, " button ", int(btn))
+	})
+	this.OnStart(func() {
+		for {
+			this.ChangeXYpos(this.GamepadAxis(1, spx.GamepadLeftX)*5, this.GamepadAxis(1, spx.GamepadLeftY)*5)
+			this.Firing = this.GamepadButtonPressed(1, spx.GamepadX)
+			this.WaitNextFrame()
+		}
+	})
+}
@@ -0,0 +1,88 @@
+//go:build pure_engine
+
+package gamepad
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Calf", X: -100, Y: 0},
+	},
+}
+
+func TestGamepad(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Calf))
+	h.Step(5)
+	calf := &g.Calf
+
+	h.ConnectGamepad(1, true)
+	h.Step(2)
+	if !slices.Equal(g.Log, []string{"gamepad 1 connected: true"}) {
+		t.Fatalf("log %q after connecting, want the gamepad 1 connected", g.Log)
+	}
+
+	// the small moves of the stick are within the dead zone
+	h.MoveGamepadAxis(1, spx.GamepadLeftX, 0.1)
+	h.Step(10)
+	if calf.Xpos() != -100 || calf.Ypos() != 0 {
+		t.Fatalf("Calf at (%v, %v) with the stick in the dead zone, want (-100, 0)", calf.Xpos(), calf.Ypos())
+	}
+
+	// past the dead zone of 0.2, 0.6 reads 0.5 and 1 up reads 1
+	h.MoveGamepadAxis(1, spx.GamepadLeftX, 0.6)
+	h.MoveGamepadAxis(1, spx.GamepadLeftY, 1)
+	h.Step(1)
+	x, y := calf.Xpos(), calf.Ypos()
+	h.Step(4)
+	if dx, dy := calf.Xpos()-x, calf.Ypos()-y; dx != 10 || dy != 20 {
+		t.Fatalf("Calf moved by (%v, %v) in 4 frames, want (10, 20)", dx, dy)
+	}
+	h.MoveGamepadAxis(1, spx.GamepadLeftX, 0)
+	h.MoveGamepadAxis(1, spx.GamepadLeftY, 0)
+	h.Step(2)
+
+	y = calf.Ypos()
+	h.PressGamepadButton(1, spx.GamepadA)
+	h.Step(1)
+	if calf.Ypos() != y+50 {
+		t.Fatalf("Calf at y = %v after A, want it to jump to %v", calf.Ypos(), y+50)
+	}
+	h.GamepadButtonDown(1, spx.GamepadX)
+	h.Step(3)
+	if !calf.Firing {
+		t.Fatal("Calf not firing while X is held")
+	}
+	h.GamepadButtonUp(1, spx.GamepadX)
+	h.Step(3)
+	if calf.Firing {
+		t.Fatal("Calf still firing after X is released")
+	}
+
+	// the stick of a disconnected gamepad reads 0
+	h.MoveGamepadAxis(1, spx.GamepadLeftX, 1)
+	h.ConnectGamepad(1, false)
+	h.Step(2)
+	x = calf.Xpos()
+	h.Step(5)
+	if calf.Xpos() != x {
+		t.Fatalf("Calf moved from x = %v to %v with the gamepad disconnected", x, calf.Xpos())
+	}
+	want := []string{
+		"gamepad 1 connected: true",
+		"gamepad 1 button 0",
+		"gamepad 1 button 2",
+		"gamepad 1 connected: false",
+	}
+	if !slices.Equal(g.Log, want) {
+		t.Fatalf("log %q, want %q", g.Log, want)
+	}
+}
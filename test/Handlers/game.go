@@ -0,0 +1,57 @@
+package handlers
+
+import "github.com/goplus/spx/v2"
+
+type Calf struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Game struct {
+	spx.Game
+	Calf   Calf
+	Log    []string
+	Menu   bool
+	Score  int
+	Steps  int
+	Hellos int
+	Firsts int
+}
+
+func (this *Game) MainEntry() {
+	this.OnClick(func() { this.Log = append(this.Log, "stage") })
+}
+
+// Clicking Calf scores, unless the menu opened by M is showing: the click then
+// closes it. T lets the clicks through to the stage. A steps until O is
+// pressed, H says hello twice.
+func (this *Calf) Main() {
+	this.OnClick(func() {
+		this.Log = append(this.Log, "gameplay")
+		this.Score++
+	})
+	var menu *spx.EventHandle
+	menu = this.OnClick(func() {
+		this.Log = append(this.Log, "menu")
+		this.Menu = false
+		menu.SetStopPropagation(false)
+	})
+	menu.SetPriority(10)
+	this.OnClick(func() { this.Log = append(this.Log, "sound") }).SetPriority(-1)
+	this.OnClick(func() { this.Log = append(this.Log, "effects") })
+	this.OnKey__0(spx.KeyM, func() {
+		this.Menu = true
+		menu.SetStopPropagation(true)
+	})
+	this.OnKey__0(spx.KeyT, func() { this.SetClickThrough(true) })
+
+	step := this.OnKey__0(spx.KeyA, func() { this.Steps++ })
+	this.OnKey__0(spx.KeyO, func() { step.Off() })
+
+	this.OnMsgOnce("hello", func() { this.Firsts++ })
+	this.OnMsg__1("hello", func() { this.Hellos++ })
+	this.OnKey__0(spx.KeyH, func() {
+		this.Broadcast__0("hello")
+		this.Broadcast__0("hello")
+	})
+}
@@ -0,0 +1,71 @@
+//go:build pure_engine
+
+package handlers
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Calf", X: -100, Y: 0},
+	},
+}
+
+func TestHandlers(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Calf))
+	h.Step(5)
+
+	// Calf takes the click from the stage
+	click := func() []string {
+		g.Log = nil
+		h.Click(-100, 0)
+		h.Step(5)
+		slices.Sort(g.Log)
+		return g.Log
+	}
+	if want := []string{"effects", "gameplay", "menu", "sound"}; !slices.Equal(click(), want) || g.Score != 1 {
+		t.Fatalf("click handled by %q, score %d, want %q and 1", g.Log, g.Score, want)
+	}
+
+	// the menu takes the click
+	h.PressKey(spx.KeyM)
+	h.Step(3)
+	if !slices.Equal(click(), []string{"menu"}) || g.Score != 1 || g.Menu {
+		t.Fatalf("click handled by %q, score %d, menu showing %v, want the menu closed only", g.Log, g.Score, g.Menu)
+	}
+
+	// through Calf to the stage, unless the menu stops the click
+	h.PressKey(spx.KeyT)
+	h.Step(3)
+	if want := []string{"effects", "gameplay", "menu", "sound", "stage"}; !slices.Equal(click(), want) {
+		t.Fatalf("click through Calf handled by %q, want %q", g.Log, want)
+	}
+	h.PressKey(spx.KeyM)
+	h.Step(3)
+	if !slices.Equal(click(), []string{"menu"}) {
+		t.Fatalf("click through Calf handled by %q with the menu showing, want the menu only", g.Log)
+	}
+
+	h.PressKey(spx.KeyA)
+	h.PressKey(spx.KeyO)
+	h.PressKey(spx.KeyA)
+	h.Step(5)
+	if g.Steps != 1 {
+		t.Fatalf("%d steps, want only the one before the handler is off", g.Steps)
+	}
+
+	h.PressKey(spx.KeyH)
+	h.PressKey(spx.KeyH)
+	h.Step(5)
+	if g.Hellos != 4 || g.Firsts != 1 {
+		t.Fatalf("%d hellos, %d first hellos, want 4 and 1", g.Hellos, g.Firsts)
+	}
+}
@@ -0,0 +1,48 @@
+//go:build pure_engine
+
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+func TestArrowKeys(t *testing.T) {
+	h := spxtest.New(t, new(Game), new(Crocodile), new(Monkey))
+	monkey := h.Sprite("Monkey")
+
+	// each arrow key turns the monkey its way, then steps 10
+	for _, c := range []struct {
+		name    string
+		key     spx.Key
+		heading float64
+		x, y    float64
+	}{
+		{"Up", spx.KeyUp, 0, 0, 10},
+		{"Right", spx.KeyRight, 90, 10, 10},
+		{"Down", spx.KeyDown, 180, 10, 0},
+		{"Left", spx.KeyLeft, -90, 0, 0},
+	} {
+		h.PressKey(c.key)
+		h.Step(2)
+		x, y := monkey.Xpos(), monkey.Ypos()
+		if monkey.Heading() != c.heading || math.Abs(x-c.x) > 1e-6 || math.Abs(y-c.y) > 1e-6 {
+			t.Fatalf("after %s: Monkey at (%v, %v) heading %v, want (%v, %v) heading %v",
+				c.name, x, y, monkey.Heading(), c.x, c.y, c.heading)
+		}
+	}
+
+	h.PressKey(spx.KeyC)
+	h.Step(2)
+	if heading := monkey.Heading(); heading != -75 {
+		t.Fatalf("after C: Monkey heading %v, want -75", heading)
+	}
+	h.PressKey(spx.KeyD)
+	h.Step(2)
+	if x, y := monkey.Xpos(), monkey.Ypos(); math.Abs(x+2*math.Sin(75*math.Pi/180)) > 1e-6 || math.Abs(y-2*math.Cos(75*math.Pi/180)) > 1e-6 {
+		t.Fatalf("after D: Monkey at (%v, %v), want 2 steps heading -75 from (0, 0)", x, y)
+	}
+}
@@ -0,0 +1,33 @@
+package hotreload
+
+import "github.com/goplus/spx/v2"
+
+type Hero struct {
+	spx.SpriteImpl
+	*Game
+	Hp int
+}
+
+type Game struct {
+	spx.Game
+	Hero   Hero
+	Score  int
+	Starts int
+}
+
+// L loads level2.
+func (this *Game) MainEntry() {
+	this.OnStart(func() { this.Starts++ })
+	this.OnKey__0(spx.KeyL, func() { this.LoadScene("level2") })
+}
+
+// S moves Hero and sets the variables, N shows its next costume.
+func (this *Hero) Main() {
+	this.OnStart(func() { this.Hp = 5 })
+	this.OnKey__0(spx.KeyS, func() {
+		this.SetXYpos(33, 44)
+		this.Hp = 9
+		this.Score = 3
+	})
+	this.OnKey__0(spx.KeyN, func() { this.SetCostume__3(spx.Next) })
+}
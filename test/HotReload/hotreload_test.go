@@ -0,0 +1,107 @@
+//go:build pure_engine
+
+package hotreload
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/internal/hotreload"
+	"github.com/goplus/spx/v2/spxtest"
+	"github.com/goplus/spx/v2/test/HotReload/next"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Hero", X: -100, Y: 0},
+	},
+	Files: map[string]any{
+		"scenes/level2.json": map[string]any{
+			"map":    map[string]any{"width": 800, "height": 600},
+			"zorder": []any{"Hero"},
+		},
+	},
+}
+
+func TestHotReload(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Hero))
+	h.Step(5)
+	h.PressKey(spx.KeyL)
+	h.Step(5)
+	h.PressKey(spx.KeyS)
+	h.Step(3)
+	hero := &g.Hero
+	if g.SceneName() != "level2" || hero.Xpos() != 33 || hero.Hp != 9 {
+		t.Fatalf("scene %q with Hero at x = %v and %d hp, want level2, 33 and 9", g.SceneName(), hero.Xpos(), hero.Hp)
+	}
+
+	// a new costume is added to Hero
+	file := filepath.Join("assets", "sprites", "Hero", "index.json")
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cfg map[string]any
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		t.Fatal(err)
+	}
+	cfg["costumes"] = append(cfg["costumes"].([]any),
+		map[string]any{"name": "blue", "path": "red.png", "x": 55, "y": 41})
+	if data, err = json.Marshal(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(file, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	hotreload.ReloadAssets([]string{"sprites/Hero/index.json"})
+	h.Step(10)
+	if g.SceneName() != "level2" {
+		t.Fatalf("scene %q after the assets reloaded, want level2", g.SceneName())
+	}
+	if hero.Xpos() != 33 || hero.Ypos() != 44 || hero.Hp != 9 || g.Score != 3 {
+		t.Fatalf("Hero at (%v, %v) with %d hp and score %d after the assets reloaded, want (33, 44), 9 and 3",
+			hero.Xpos(), hero.Ypos(), hero.Hp, g.Score)
+	}
+	if name := hero.CostumeName(); name != "red" {
+		t.Fatalf("costume %q after the assets reloaded, want red as before", name)
+	}
+	h.PressKey(spx.KeyN)
+	if name := hero.CostumeName(); name != "blue" {
+		t.Fatalf("costume %q after N, want the new costume blue", name)
+	}
+
+	// the scripts change: Hero gets mana, the rest is restored
+	g2 := new(next.Game)
+	done := make(chan error, 1)
+	go func() {
+		done <- hotreload.ReloadGame(func() error {
+			go g2.Main()
+			return nil
+		}, func() {})
+	}()
+	h.Step(40)
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+	if g2.SceneName() != "level2" {
+		t.Fatalf("scene %q after the scripts reloaded, want level2", g2.SceneName())
+	}
+	hero2 := &g2.Hero
+	if hero2.Xpos() != 33 || hero2.Ypos() != 44 || hero2.Hp != 9 || hero2.Mana != 7 || g2.Score != 3 {
+		t.Fatalf("Hero at (%v, %v) with %d hp, %d mana and score %d after the scripts reloaded, want (33, 44), 9, 7 and 3",
+			hero2.Xpos(), hero2.Ypos(), hero2.Hp, hero2.Mana, g2.Score)
+	}
+	h.Click(33, 44)
+	h.Step(10)
+	h.Click(33, 44)
+	h.Step(10)
+	if hero2.Mana != 9 {
+		t.Fatalf("Hero has %d mana after 2 clicks, want 9", hero2.Mana)
+	}
+}
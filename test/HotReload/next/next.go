@@ -0,0 +1,32 @@
+// Package next is the game once its scripts changed: Hero has mana.
+package next
+
+import "github.com/goplus/spx/v2"
+
+type Hero struct {
+	spx.SpriteImpl
+	*Game
+	Hp   int
+	Mana int
+}
+
+type Game struct {
+	spx.Game
+	Hero   Hero
+	Score  int
+	Starts int
+}
+
+func (this *Game) MainEntry() {
+	this.OnStart(func() { this.Starts++ })
+}
+
+func (this *Game) Main() { spx.Gopt_Game_Main(this, new(Hero)) }
+
+func (this *Hero) Main() {
+	this.OnStart(func() {
+		this.Hp = 5
+		this.Mana = 7
+	})
+	this.OnClick(func() { this.Mana++ })
+}
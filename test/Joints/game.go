@@ -0,0 +1,86 @@
+package joints
+
+import "github.com/goplus/spx/v2"
+
+type Anchor struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Bob struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Wheel struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Rail struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Cart struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Game struct {
+	spx.Game
+	Anchor Anchor
+	Bob    Bob
+	Wheel  Wheel
+	Rail   Rail
+	Cart   Cart
+	Hinge  *spx.JointHandle
+	Spring *spx.JointHandle
+	Weight *Bob
+	Count  int
+}
+
+// Bob swings on a rod from Anchor, described by the project, until B breaks
+// it. Wheel turns on a motor, backwards once M is pressed, and Cart slides
+// along Rail to its end.
+func (this *Game) MainEntry() {
+	this.OnStart(func() {
+		this.Hinge = this.AddJoint(spx.HingeJoint, &this.Anchor, &this.Wheel, spx.JointParams{MotorSpeed: 90})
+		this.AddJoint(spx.SliderJoint, &this.Rail, &this.Cart, spx.JointParams{Upper: 100, MotorSpeed: 60})
+	})
+	this.OnKey__0(spx.KeyB, func() {
+		for _, j := range this.Joints(&this.Bob) {
+			j.Break()
+		}
+	})
+	this.OnKey__0(spx.KeyM, func() {
+		this.Hinge.SetMotorSpeed(-90)
+	})
+	this.OnKey__0(spx.KeyN, func() {
+		this.Count = len(this.Joints(nil))
+	})
+}
+
+func (this *Anchor) Main() {}
+
+// C hangs a clone of Bob from Anchor by a spring, X deletes it.
+func (this *Bob) Main() {
+	this.OnKey__0(spx.KeyC, func() {
+		spx.Gopt_SpriteImpl_Clone__0(this)
+	})
+	this.OnCloned__1(func() {
+		this.SetXYpos(0, 50)
+		this.SetVelocity(0, 0)
+		this.Weight = this
+		this.Spring = this.AddJoint(spx.SpringJoint, &this.Anchor, this, spx.JointParams{Length: 50, Damping: 10})
+		this.OnKey__0(spx.KeyX, func() {
+			this.DeleteThisClone()
+		})
+	})
+}
+
+func (this *Wheel) Main() {}
+
+func (this *Rail) Main() {}
+
+func (this *Cart) Main() {}
@@ -0,0 +1,112 @@
+//go:build pure_engine
+
+package joints
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Config: map[string]any{
+		"joints": []any{map[string]any{"kind": "distance", "a": "Anchor", "b": "Bob"}},
+	},
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Anchor", X: 0, Y: 100, Config: map[string]any{"physicsMode": "static"}},
+		{Name: "Bob", X: 100, Y: 100, Config: map[string]any{"physicsMode": "dynamic"}},
+		{Name: "Wheel", X: -150, Y: 100, Config: map[string]any{"physicsMode": "dynamic"}},
+		{Name: "Rail", X: 0, Y: -120, Config: map[string]any{"physicsMode": "static"}},
+		{Name: "Cart", X: 0, Y: -120, Config: map[string]any{"physicsMode": "dynamic"}},
+	},
+}
+
+func TestJoints(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Anchor), new(Bob), new(Wheel), new(Rail), new(Cart))
+	bob, wheel, cart := &g.Bob, &g.Wheel, &g.Cart
+	count := func() int {
+		h.PressKey(spx.KeyN)
+		h.Step(3)
+		return g.Count
+	}
+	turned := func(from float64) float64 {
+		return math.Remainder(float64(wheel.Heading())-from, 360)
+	}
+	rod := func() float64 {
+		return math.Hypot(bob.Xpos(), bob.Ypos()-100)
+	}
+	h.Step(5)
+	if n := count(); n != 3 {
+		t.Fatalf("%d joints, want the rod of the project, the hinge and the slider", n)
+	}
+
+	// Bob swings down then up to the other side, the rod keeps it 100 away
+	// from Anchor
+	heading := float64(wheel.Heading())
+	h.StepSeconds(0.5)
+	if d := rod(); bob.Ypos() > 50 || math.Abs(d-100) > 2 {
+		t.Fatalf("Bob at (%v, %v), %v away from Anchor, want it swung down on a rod of 100", bob.Xpos(), bob.Ypos(), d)
+	}
+	h.StepSeconds(0.5)
+	if d := rod(); bob.Xpos() > -90 || math.Abs(d-100) > 2 {
+		t.Fatalf("Bob at (%v, %v), %v away from Anchor, want it swung to the left on a rod of 100", bob.Xpos(), bob.Ypos(), d)
+	}
+
+	// the motor of the hinge turns Wheel by 90° per second, then backwards
+	if a := turned(heading); math.Abs(a-90) > 2 {
+		t.Fatalf("Wheel turned by %v° in a second, want 90°", a)
+	}
+	h.PressKey(spx.KeyM)
+	h.Step(3)
+	heading = float64(wheel.Heading())
+	h.StepSeconds(1)
+	if a := turned(heading); math.Abs(a+90) > 2 {
+		t.Fatalf("Wheel turned by %v° in a second after reversing the motor, want -90°", a)
+	}
+
+	// Cart slides along Rail up to its end, sagging a bit under its weight
+	h.StepSeconds(1)
+	if x, y := cart.Xpos(), cart.Ypos(); math.Abs(x-100) > 1 || math.Abs(y+120) > 2 {
+		t.Fatalf("Cart at (%v, %v), want it at the end of Rail, (100, -120)", x, y)
+	}
+
+	// once the rod is broken, Bob falls
+	h.PressKey(spx.KeyB)
+	h.StepSeconds(1)
+	if d := rod(); d < 150 {
+		t.Fatalf("Bob %v away from Anchor a second after breaking the rod, want it fallen", d)
+	}
+	if n := count(); n != 2 {
+		t.Fatalf("%d joints after breaking the rod, want 2", n)
+	}
+
+	// a clone of Bob hangs from a spring, stretched by its weight, until deleted
+	h.PressKey(spx.KeyC)
+	h.StepSeconds(3)
+	weight, spring := g.Weight, g.Spring
+	if weight == nil || spring.Kind() != spx.SpringJoint || spring.Params().Length != 50 {
+		t.Fatalf("clone %v on a joint with %+v, want a spring of 50", weight, spring.Params())
+	}
+	if x, y := weight.Xpos(), weight.Ypos(); math.Abs(x) > 1 || math.Abs(y-(100-50-980.0/50)) > 1 {
+		t.Fatalf("clone at rest at (%v, %v), want it below Anchor, the spring stretched by 980/50", x, y)
+	}
+	if n := count(); n != 3 {
+		t.Fatalf("%d joints with the spring, want 3", n)
+	}
+	h.PressKey(spx.KeyX)
+	h.Step(3)
+	if n := count(); n != 2 || !spring.Broken() {
+		t.Fatalf("%d joints after deleting the clone, spring broken: %v, want it removed", n, spring.Broken())
+	}
+
+	// Rail is static, it has no motor
+	j := g.AddJoint(spx.HingeJoint, &g.Anchor, &g.Rail, spx.JointParams{MotorSpeed: 90})
+	if speed := j.Params().MotorSpeed; speed != 0 {
+		t.Fatalf("motor speed %v on static Rail, want the motor rejected", speed)
+	}
+}
@@ -0,0 +1,37 @@
+package keys
+
+import "github.com/goplus/spx/v2"
+
+type Calf struct {
+	spx.SpriteImpl
+	*Game
+	Stops int
+	Jumps int
+	Lands int
+}
+
+type Game struct {
+	spx.Game
+	Calf Calf
+}
+
+// The held keys repeat every 100ms.
+func (this *Game) MainEntry() {
+	spx.Gopt_Game_Run(this, "assets", &spx.Config{KeyDuration: 100})
+}
+
+// Calf walks while right is held and jumps when space is pressed, polling it.
+func (this *Calf) Main() {
+	this.OnKeyHeld(spx.KeyRight, func() { this.ChangeXpos(10) })
+	this.OnKeyUp(spx.KeyRight, func() { this.Stops++ })
+	this.OnStart(func() {
+		spx.Forever(func() {
+			if this.KeyJustPressed(spx.KeySpace) {
+				this.Jumps++
+			}
+			if this.KeyJustReleased(spx.KeySpace) {
+				this.Lands++
+			}
+		})
+	})
+}
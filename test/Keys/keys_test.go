@@ -0,0 +1,51 @@
+//go:build pure_engine
+
+package keys
+
+import (
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Calf", X: -100, Y: 0},
+	},
+}
+
+func TestKeys(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Calf))
+	h.Step(5)
+	calf := &g.Calf
+
+	// held for a bit more than a second, right repeats 10 times
+	h.KeyDown(spx.KeyRight)
+	h.StepSeconds(1.05)
+	if x := calf.Xpos(); x != 0 || calf.Stops != 0 {
+		t.Fatalf("Calf at x = %v, stopped %d times after holding right for 1.05s, want 0 and never", x, calf.Stops)
+	}
+	h.KeyUp(spx.KeyRight)
+	h.Step(2)
+	h.StepSeconds(0.5)
+	if x := calf.Xpos(); x != 0 || calf.Stops != 1 {
+		t.Fatalf("Calf at x = %v, stopped %d times after releasing right, want 0 and once", x, calf.Stops)
+	}
+
+	// a key held for long is pressed and released once
+	h.KeyDown(spx.KeySpace)
+	h.Step(30)
+	if calf.Jumps != 1 || calf.Lands != 0 {
+		t.Fatalf("Calf jumped %d times and landed %d times with space held, want once and never", calf.Jumps, calf.Lands)
+	}
+	h.KeyUp(spx.KeySpace)
+	h.Step(2)
+	h.PressKey(spx.KeySpace)
+	if calf.Jumps != 2 || calf.Lands != 2 {
+		t.Fatalf("Calf jumped %d times and landed %d times after 2 presses, want twice each", calf.Jumps, calf.Lands)
+	}
+}
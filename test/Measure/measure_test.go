@@ -0,0 +1,55 @@
+//go:build pure_engine
+
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+func TestTurnOrStep(t *testing.T) {
+	h := spxtest.New(t, new(Game), new(Monkey))
+	h.Step(5)
+	monkey := h.Sprite("Monkey")
+	at := func(x, y float64) bool {
+		return math.Abs(monkey.Xpos()-x) < 1e-6 && math.Abs(monkey.Ypos()-y) < 1e-6
+	}
+
+	// the monkey heads right: Right says the step for half a second, then
+	// takes it
+	h.PressKey(spx.KeyRight)
+	h.StepSeconds(0.25)
+	if msg := monkey.SayText(); msg != "50" || !at(0, 0) {
+		t.Fatalf("Monkey says %q at (%v, %v) while measuring, want \"50\" at (0, 0)", msg, monkey.Xpos(), monkey.Ypos())
+	}
+	h.StepSeconds(0.5)
+	if msg := monkey.SayText(); msg != "" || !at(50, 0) {
+		t.Fatalf("Monkey says %q at (%v, %v) after the step, want nothing at (50, 0)", msg, monkey.Xpos(), monkey.Ypos())
+	}
+
+	// Up turns it first, then steps
+	h.PressKey(spx.KeyUp)
+	h.Step(2)
+	if heading := monkey.Heading(); heading != 0 || !at(50, 0) {
+		t.Fatalf("after Up: Monkey heading %v at (%v, %v), want 0 at (50, 0)", heading, monkey.Xpos(), monkey.Ypos())
+	}
+	h.PressKey(spx.KeyUp)
+	h.StepSeconds(1)
+	if !at(50, 50) {
+		t.Fatalf("after Up twice: Monkey at (%v, %v), want (50, 50)", monkey.Xpos(), monkey.Ypos())
+	}
+
+	// clicking it shows the help for a second
+	h.Click(50, 50)
+	h.Step(2)
+	if msg := monkey.SayText(); msg != "press the arrorw keys" {
+		t.Fatalf("Monkey says %q once clicked, want the help", msg)
+	}
+	h.StepSeconds(1.1)
+	if msg := monkey.SayText(); msg != "" {
+		t.Fatalf("Monkey still says %q a second after the click", msg)
+	}
+}
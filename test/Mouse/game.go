@@ -0,0 +1,40 @@
+package mouse
+
+import "github.com/goplus/spx/v2"
+
+type Calf struct {
+	spx.SpriteImpl
+	*Game
+	Menus   int
+	Picks   int
+	Hovered bool
+}
+
+type Game struct {
+	spx.Game
+	Calf  Calf
+	Menus int
+	Under string
+}
+
+// Right clicks open the menu of the stage, the wheel zooms Calf and D records
+// the sprite under the mouse.
+func (this *Game) MainEntry() {
+	this.OnRightClick(func() { this.Menus++ })
+	this.OnMouseWheel(func(dx, dy float64) { this.Calf.ChangeSize(dy / 10) })
+	this.OnKey__0(spx.KeyD, func() {
+		this.Under = ""
+		if sp := this.SpriteUnderMouse(); sp != nil {
+			this.Under = sp.Name()
+		}
+	})
+}
+
+// Calf has a menu of its own, is picked with the middle button and lights up
+// while hovered.
+func (this *Calf) Main() {
+	this.OnRightClick(func() { this.Menus++ })
+	this.OnMiddleClick(func() { this.Picks++ })
+	this.OnMouseEnter(func() { this.Hovered = true })
+	this.OnMouseLeave(func() { this.Hovered = false })
+}
@@ -0,0 +1,64 @@
+//go:build pure_engine
+
+package mouse
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Calf", X: -100, Y: 0},
+	},
+}
+
+func TestMouse(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Calf))
+	h.MouseMove(150, 100)
+	h.Step(5)
+	calf := &g.Calf
+
+	h.MouseMove(-100, 0)
+	h.Step(2)
+	h.PressKey(spx.KeyD)
+	if !calf.Hovered || g.Under != "Calf" {
+		t.Fatalf("Calf hovered %v with %q under the mouse, want it hovered and under", calf.Hovered, g.Under)
+	}
+	h.MouseMove(150, 100)
+	h.Step(2)
+	h.PressKey(spx.KeyD)
+	if calf.Hovered || g.Under != "" {
+		t.Fatalf("Calf hovered %v with %q under the mouse, want nothing once the mouse left", calf.Hovered, g.Under)
+	}
+
+	// the sprite clicked gets the click, not the stage
+	h.RightClick(-100, 0)
+	h.Step(2)
+	h.MiddleClick(-100, 0)
+	h.Step(2)
+	if calf.Menus != 1 || calf.Picks != 1 || g.Menus != 0 {
+		t.Fatalf("Calf got %d right and %d middle clicks, the stage %d right clicks, want 1, 1 and 0", calf.Menus, calf.Picks, g.Menus)
+	}
+	h.RightClick(150, 100)
+	h.Step(2)
+	h.MiddleClick(150, 100)
+	h.Step(2)
+	if calf.Menus != 1 || calf.Picks != 1 || g.Menus != 1 {
+		t.Fatalf("Calf got %d right and %d middle clicks, the stage %d right clicks, want 1, 1 and 1", calf.Menus, calf.Picks, g.Menus)
+	}
+
+	h.ScrollWheel(0, 2)
+	h.Step(2)
+	h.ScrollWheel(0, -1)
+	h.Step(2)
+	if size := calf.Size(); math.Abs(size-1.1) > 1e-9 {
+		t.Fatalf("Calf size %v after scrolling 2 notches up and 1 down, want 1.1", size)
+	}
+}
@@ -0,0 +1,56 @@
+package pause
+
+import "github.com/goplus/spx/v2"
+
+type Calf struct {
+	spx.SpriteImpl
+	*Game
+	Woke bool
+	Vx   float64
+}
+
+type Menu struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Game struct {
+	spx.Game
+	Calf    Calf
+	Menu    Menu
+	Pauses  int
+	Resumes int
+}
+
+// P pauses the game.
+func (this *Game) MainEntry() {
+	this.OnPause(func() { this.Pauses++ })
+	this.OnResume(func() { this.Resumes++ })
+	this.OnKey__0(spx.KeyP, func() { this.Pause() })
+}
+
+// Calf runs right and wakes up after a second. V records its speed.
+func (this *Calf) Main() {
+	this.OnStart(func() {
+		this.SetPhysicsMode(spx.DynamicPhysics)
+		this.SetGravity(0)
+		this.SetVelocity(60, 0)
+	})
+	this.OnStart(func() {
+		this.Wait(1)
+		this.Woke = true
+	})
+	this.OnKey__0(spx.KeyV, func() { this.Vx, _ = this.Velocity() })
+}
+
+// Menu keeps turning while the game is paused, clicking it resumes the game.
+func (this *Menu) Main() {
+	this.OnStart(func() {
+		this.SetPauseExempt(true)
+		for {
+			this.Turn__0(2)
+			this.WaitNextFrame()
+		}
+	})
+	this.OnClick(func() { this.Resume() })
+}
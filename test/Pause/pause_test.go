@@ -0,0 +1,59 @@
+//go:build pure_engine
+
+package pause
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Calf", X: -100, Y: 0},
+		{Name: "Menu", X: 100, Y: 0},
+	},
+}
+
+func TestPause(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Calf), new(Menu))
+	h.StepSeconds(0.5)
+	calf, menu := &g.Calf, &g.Menu
+
+	h.PressKey(spx.KeyP)
+	h.Step(2)
+	x, heading := calf.Xpos(), menu.Heading()
+	h.StepSeconds(2)
+	if calf.Xpos() != x || calf.Woke {
+		t.Fatalf("Calf at x = %v, woke %v after 2s paused, want it frozen at %v", calf.Xpos(), calf.Woke, x)
+	}
+	if menu.Heading() == heading {
+		t.Fatal("Menu stopped turning while paused, want it exempted")
+	}
+	if g.Pauses != 1 || g.Resumes != 0 {
+		t.Fatalf("%d pauses, %d resumes, want 1 and 0", g.Pauses, g.Resumes)
+	}
+
+	h.Click(100, 0)
+	h.Step(2)
+	if g.Pauses != 1 || g.Resumes != 1 {
+		t.Fatalf("%d pauses, %d resumes after clicking the menu, want 1 each", g.Pauses, g.Resumes)
+	}
+	h.StepSeconds(0.2)
+	if calf.Xpos() <= x || calf.Woke {
+		t.Fatalf("Calf at x = %v, woke %v after resuming, want it running from %v, still asleep", calf.Xpos(), calf.Woke, x)
+	}
+	h.PressKey(spx.KeyV)
+	if math.Abs(calf.Vx-60) > 1e-6 {
+		t.Fatalf("Calf speed %v after resuming, want 60 as before the pause", calf.Vx)
+	}
+	h.StepSeconds(0.5)
+	if !calf.Woke {
+		t.Fatal("Calf still asleep after 1s unpaused, want it woken up")
+	}
+}
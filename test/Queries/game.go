@@ -0,0 +1,104 @@
+package queries
+
+import "github.com/goplus/spx/v2"
+
+type Guard struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Wall struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Mist struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Player struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Game struct {
+	spx.Game
+	Guard    Guard
+	Wall     Wall
+	Mist     Mist
+	Player   Player
+	Sight    []spx.QueryHit
+	Shots    []spx.QueryHit
+	Picked   []string
+	Overlaps []string
+	Foggy    bool
+	Piercing bool
+}
+
+func names(sprites []spx.Sprite) []string {
+	ret := make([]string, len(sprites))
+	for i, s := range sprites {
+		ret[i] = s.Name()
+	}
+	return ret
+}
+
+// K picks the sprites under the mouse, Mist included. O lists the
+// sprites in Mist.
+func (this *Game) MainEntry() {
+	this.OnKey__0(spx.KeyK, func() {
+		this.Picked = names(this.PointQuery__0(this.MouseX(), this.MouseY(), spx.QueryFilter{IncludeTriggers: true}))
+	})
+	this.OnKey__0(spx.KeyO, func() {
+		this.Overlaps = names(this.OverlapSprite__1(&this.Mist))
+	})
+}
+
+// Guard looks at Player in every frame, through Mist unless F makes it
+// foggy. Space shoots a bullet to the right, through Wall once P is pressed.
+// L casts a dot across the stage.
+func (this *Guard) Main() {
+	this.OnKey__0(spx.KeyL, func() {
+		dot := spx.ColliderPart{Type: spx.CircleCollider, Params: []float64{1}}
+		this.Shots = this.ShapeCast__1(dot, -5000, 0, 4000, 0)
+	})
+	this.OnKey__0(spx.KeyF, func() {
+		this.Foggy = !this.Foggy
+	})
+	this.OnKey__0(spx.KeyP, func() {
+		this.Piercing = !this.Piercing
+	})
+	this.OnKey__0(spx.KeySpace, func() {
+		filter := spx.QueryFilter{Ignore: []spx.Sprite{this}}
+		if this.Piercing {
+			filter.Predicate = func(s spx.Sprite) bool { return s.Name() != "Wall" }
+		}
+		bullet := spx.ColliderPart{Type: spx.CircleCollider, Params: []float64{5}}
+		this.Shots = this.ShapeCast__0(bullet, this.Xpos(), this.Ypos(), 240, this.Ypos(), filter)
+	})
+	this.OnStart(func() {
+		for {
+			filter := spx.QueryFilter{Ignore: []spx.Sprite{this}, IncludeTriggers: this.Foggy}
+			this.Sight = this.RaycastAll__0(this.Xpos(), this.Ypos(), this.Player.Xpos(), this.Player.Ypos(), filter)
+			this.WaitNextFrame()
+		}
+	})
+}
+
+func (this *Wall) Main() {}
+
+func (this *Mist) Main() {}
+
+// Player moves by the arrow keys.
+func (this *Player) Main() {
+	this.OnKey__0(spx.KeyUp, func() {
+		this.ChangeYpos(100)
+	})
+	this.OnKey__0(spx.KeyDown, func() {
+		this.ChangeYpos(-100)
+	})
+	this.OnKey__0(spx.KeyLeft, func() {
+		this.ChangeXpos(-75)
+	})
+}
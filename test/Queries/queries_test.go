@@ -0,0 +1,126 @@
+//go:build pure_engine
+
+package queries
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// first returns the name of the sprite of the first hit, "" if none
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Config: map[string]any{"physics": true},
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Guard", X: -150, Y: 0, Config: map[string]any{
+			"physicsMode":          "static",
+			"collisionShapeType":   "rect",
+			"collisionShapeParams": []any{20, 20},
+			"triggerShapeType":     "none",
+		}},
+		{Name: "Wall", X: 0, Y: 0, Config: map[string]any{
+			"physicsMode":          "static",
+			"collisionShapeType":   "rect",
+			"collisionShapeParams": []any{20, 60},
+			"triggerShapeType":     "none",
+		}},
+		{Name: "Mist", X: 75, Y: 75, Config: map[string]any{
+			"physicsMode":        "no",
+			"collisionShapeType": "none",
+			"triggerShapeType":   "rect",
+			"triggerShapeParams": []any{40, 40},
+		}},
+		{Name: "Player", X: 150, Y: 0, Config: map[string]any{
+			"physicsMode":          "static",
+			"collisionShapeType":   "rect",
+			"collisionShapeParams": []any{20, 20},
+			"triggerShapeType":     "none",
+		}},
+	},
+}
+
+func first(hits []spx.QueryHit) string {
+	if len(hits) == 0 || hits[0].Sprite == nil {
+		return ""
+	}
+	return hits[0].Sprite.Name()
+}
+
+func TestQueries(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Guard), new(Wall), new(Mist), new(Player))
+	press := func(key spx.Key) {
+		h.PressKey(key)
+		h.Step(3)
+	}
+	near := func(a, b float64) bool { return math.Abs(a-b) < 0.1 }
+	h.Step(5)
+
+	// Wall hides Player from Guard
+	if hit := g.Sight; first(hit) != "Wall" || len(hit) != 2 || first(hit[1:]) != "Player" {
+		t.Fatalf("Guard sees %d sprites, %q first, want Wall then Player", len(hit), first(hit))
+	}
+	if hit := g.Sight[0]; !near(hit.X, -10) || !near(hit.NormalX, -1) || !near(hit.Distance, 140) {
+		t.Fatalf("Guard sees Wall at (%v, %v), normal (%v, %v), %v away, want its left side 140 away",
+			hit.X, hit.Y, hit.NormalX, hit.NormalY, hit.Distance)
+	}
+
+	// above Wall, it's seen through Mist, until it's foggy
+	press(spx.KeyUp)
+	if name := first(g.Sight); name != "Player" {
+		t.Fatalf("Guard sees %q first once Player is above Wall, want Player", name)
+	}
+	press(spx.KeyF)
+	if hit := g.Sight; first(hit) != "Mist" || len(hit) != 2 {
+		t.Fatalf("Guard sees %d sprites, %q first, in the fog, want Mist then Player", len(hit), first(hit))
+	}
+	press(spx.KeyF)
+
+	// a bullet stops on Wall, or goes through it to Player
+	press(spx.KeyDown)
+	press(spx.KeySpace)
+	if shot := g.Shots; len(shot) != 2 || first(shot) != "Wall" || !near(shot[0].Distance, 135) || first(shot[1:]) != "Player" {
+		t.Fatalf("bullet hit %d sprites, %q first, want Wall 135 away then Player", len(shot), first(shot))
+	}
+	press(spx.KeyP)
+	press(spx.KeySpace)
+	if shot := g.Shots; len(shot) != 1 || first(shot) != "Player" || !near(shot[0].Distance, 285) {
+		t.Fatalf("piercing bullet hit %d sprites, %q first, want Player 285 away", len(shot), first(shot))
+	}
+
+	// a long cast of a small shape doesn't step over Wall
+	press(spx.KeyL)
+	if shot := g.Shots; first(shot) != "Guard" || len(shot) != 3 || first(shot[1:]) != "Wall" {
+		t.Fatalf("long cast hit %d sprites, %q first, want Guard, Wall and Player", len(shot), first(shot))
+	}
+
+	// the points pick the triggers too
+	h.MouseMove(75, 75)
+	press(spx.KeyK)
+	if !slices.Equal(g.Picked, []string{"Mist"}) {
+		t.Fatalf("picked %q in Mist, want Mist", g.Picked)
+	}
+	h.MouseMove(0, 20)
+	press(spx.KeyK)
+	if !slices.Equal(g.Picked, []string{"Wall"}) {
+		t.Fatalf("picked %q on Wall, want Wall", g.Picked)
+	}
+
+	// Mist overlaps Player once it walks in
+	press(spx.KeyO)
+	if len(g.Overlaps) != 0 {
+		t.Fatalf("%q in Mist, want none", g.Overlaps)
+	}
+	press(spx.KeyUp)
+	press(spx.KeyLeft)
+	press(spx.KeyO)
+	if !slices.Equal(g.Overlaps, []string{"Player"}) {
+		t.Fatalf("%q in Mist, want Player", g.Overlaps)
+	}
+}
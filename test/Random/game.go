@@ -0,0 +1,42 @@
+package random
+
+import "github.com/goplus/spx/v2"
+
+type Red struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Game struct {
+	spx.Game
+	Red      Red
+	Draws    []float64
+	Picks    []int
+	Shuffled []string
+}
+
+func (this *Game) MainEntry() {}
+
+// Red draws random numbers on R and restarts the sequence on S. W picks
+// weighted indexes and shuffles a list.
+func (this *Red) Main() {
+	this.OnKey__0(spx.KeyR, func() {
+		this.Draws = append(this.Draws, spx.Rand__0(0, 1000000), spx.Rand__1(0, 1), spx.Noise__1(1.25, 0.5))
+	})
+	this.OnKey__0(spx.KeyS, func() {
+		this.SetRandomSeed(this.RandomSeed())
+	})
+	this.OnKey__0(spx.KeyW, func() {
+		for i := 0; i < 20; i++ {
+			this.Picks = append(this.Picks, spx.RandWeighted(0, 2, 0, -1))
+		}
+		list := new(spx.List)
+		for _, v := range []string{"a", "b", "c", "d", "e"} {
+			list.Append(v)
+		}
+		spx.Shuffle(list)
+		for i := 0; i < list.Len(); i++ {
+			this.Shuffled = append(this.Shuffled, list.At(i).String())
+		}
+	})
+}
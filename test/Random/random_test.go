@@ -0,0 +1,64 @@
+//go:build pure_engine
+
+package random
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Config: map[string]any{"randomSeed": 42},
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Red", X: -100, Y: 0},
+	},
+}
+
+func TestRandomSeed(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Red))
+	if seed := g.RandomSeed(); seed != 42 {
+		t.Fatalf("RandomSeed() = %d, want the randomSeed 42 of the project", seed)
+	}
+
+	// reseeding restarts the sequence drawn since the game was loaded
+	h.PressKey(spx.KeyR)
+	h.PressKey(spx.KeyR)
+	first := slices.Clone(g.Draws)
+	if len(first) != 6 {
+		t.Fatalf("%d draws, want 6", len(first))
+	}
+	if slices.Equal(first[:3], first[3:]) {
+		t.Fatalf("draws %v repeat themselves", first)
+	}
+	h.PressKey(spx.KeyS)
+	g.Draws = nil
+	h.PressKey(spx.KeyR)
+	h.PressKey(spx.KeyR)
+	if !slices.Equal(g.Draws, first) {
+		t.Fatalf("draws %v after reseeding, want %v", g.Draws, first)
+	}
+
+	h.PressKey(spx.KeyW)
+	for _, i := range g.Picks {
+		if i != 1 {
+			t.Fatalf("RandWeighted(0, 2, 0, -1) picked %v, want only 1", g.Picks)
+		}
+	}
+	shuffled := slices.Clone(g.Shuffled)
+	slices.Sort(shuffled)
+	if !slices.Equal(shuffled, []string{"a", "b", "c", "d", "e"}) {
+		t.Fatalf("Shuffle gave %v, want a permutation of a..e", g.Shuffled)
+	}
+
+	// nil lists are empty ones
+	spx.Shuffle(nil)
+	if v := spx.RandChoice(nil); v.String() != "" {
+		t.Fatalf("RandChoice(nil) = %q, want an empty value", v.String())
+	}
+}
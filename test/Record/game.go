@@ -0,0 +1,43 @@
+package record
+
+import (
+	"fmt"
+
+	"github.com/goplus/spx/v2"
+)
+
+type Red struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Game struct {
+	spx.Game
+	Red Red
+	Log []string
+}
+
+func (this *Game) MainEntry() {}
+
+func (this *Game) log(a ...any) {
+	this.Log = append(this.Log, fmt.Sprint(a...))
+}
+
+// Red moves right as long as the right key is held, and draws random numbers
+// on the key and click events.
+func (this *Red) Main() {
+	this.OnKey__0(spx.KeyRight, func() {
+		this.log("key ", spx.Rand__0(0, 1000), " at ", this.Xpos())
+	})
+	this.OnClick(func() {
+		this.log("click ", spx.Rand__0(0, 1000), " at ", this.Timer())
+	})
+	this.OnStart(func() {
+		for {
+			if this.KeyPressed(spx.KeyRight) {
+				this.ChangeXpos(1)
+			}
+			this.Wait(0.05)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+//go:build pure_engine
+
+package record
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+const frames = 180 // simulated by every run, past the flush of the recording
+
+// TestReplay records a run driven by the keyboard and the mouse, replays it
+// in another process and expects the same game state, random numbers
+// included.
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Red", X: -100, Y: 0},
+	},
+}
+
+func TestReplay(t *testing.T) {
+	if mode := os.Getenv("SPX_RECORD_TEST"); mode != "" {
+		play(t, mode, os.Getenv("SPX_RECORD_FILE"))
+		return
+	}
+	file := filepath.Join(t.TempDir(), "run.spxrec")
+	live := run(t, "record", file)
+	replayed := run(t, "replay", file)
+	if replayed != live {
+		t.Fatalf("replay differs from the recorded run:\nrecorded: %s\nreplayed: %s", live, replayed)
+	}
+}
+
+// run runs the game as mode in a new process and returns its final state
+func run(t *testing.T, mode, file string) string {
+	t.Helper()
+	cmd := exec.Command(os.Args[0], "-test.run=^TestReplay$", "-test.v")
+	cmd.Env = append(os.Environ(), "SPX_RECORD_TEST="+mode, "SPX_RECORD_FILE="+file)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("%s run failed: %v\n%s", mode, err, out)
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		if state, ok := strings.CutPrefix(strings.TrimSpace(line), "state: "); ok {
+			return state
+		}
+	}
+	t.Fatalf("%s run printed no state:\n%s", mode, out)
+	return ""
+}
+
+// play runs the game, recording it with the inputs of the test or replaying
+// them, then prints its state
+func play(t *testing.T, mode, file string) {
+	os.Args = append(os.Args, "--"+mode, file)
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Red))
+	red := h.Sprite("Red")
+	n := 0
+	if mode == "record" {
+		h.Step(6) // so that the key handler doesn't run in a frame where Red moves
+		h.KeyDown(spx.KeyRight)
+		h.Step(30)
+		h.KeyUp(spx.KeyRight)
+		h.Step(3)
+		h.Click(-100, 0)
+		h.Step(5)
+		h.PressKey(spx.KeyRight)
+		n = 6 + 30 + 3 + 2 + 5 + 2
+		if x := red.Xpos(); x <= -100 {
+			t.Fatalf("Red at x = %v after holding right, want it moved from -100", x)
+		}
+		if len(g.Log) != 3 {
+			t.Fatalf("log %q, want 2 key events and a click", g.Log)
+		}
+	}
+	h.Step(frames - n)
+	fmt.Printf("state: x=%v timer=%v rand=%v log=%q\n", red.Xpos(), g.Timer(), spx.Rand__0(0, 1000), g.Log)
+}
@@ -0,0 +1,44 @@
+package savedata
+
+import "github.com/goplus/spx/v2"
+
+type Red struct {
+	spx.SpriteImpl
+	*Game
+	Lives int
+}
+
+type Game struct {
+	spx.Game
+	Red    Red
+	Score  int
+	Loaded bool
+}
+
+func (this *Game) MainEntry() {}
+
+// Space scores and costs a life. S saves the score and all the variables, L
+// loads them back. 1 and 2 select a save slot, D deletes the score.
+func (this *Red) Main() {
+	this.Lives = 5
+	this.OnKey__0(spx.KeySpace, func() {
+		this.Score++
+		this.Lives--
+	})
+	this.OnKey__0(spx.KeyS, func() {
+		this.SaveData("score", this.Score)
+		this.SaveVars("vars")
+	})
+	this.OnKey__0(spx.KeyL, func() {
+		var score int
+		if this.Loaded = this.LoadData("score", &score); this.Loaded {
+			this.Score = score
+		}
+	})
+	this.OnKey__0(spx.KeyV, func() {
+		this.Loaded = this.LoadVars("vars")
+	})
+	this.OnKey__0(spx.Key1, func() { this.SetSaveSlot("default") })
+	this.OnKey__0(spx.Key2, func() { this.SetSaveSlot("slot2") })
+	this.OnKey__0(spx.KeyD, func() { this.DeleteData("score") })
+}
@@ -0,0 +1,62 @@
+//go:build pure_engine
+
+package savedata
+
+import (
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Red", X: -100, Y: 0},
+	},
+}
+
+func TestSaveData(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Red))
+	red := h.Sprite("Red").(*Red)
+	press := func(keys ...spx.Key) {
+		for _, key := range keys {
+			h.PressKey(key)
+		}
+	}
+
+	press(spx.KeySpace, spx.KeySpace, spx.KeySpace, spx.KeyS, spx.KeySpace)
+	if g.Score != 4 || red.Lives != 1 {
+		t.Fatalf("score %d, lives %d, want 4 and 1", g.Score, red.Lives)
+	}
+	press(spx.KeyL)
+	if !g.Loaded || g.Score != 3 {
+		t.Fatalf("loaded %v, score %d, want the saved score 3", g.Loaded, g.Score)
+	}
+
+	// the variables of the game and its sprites are saved at once
+	press(spx.KeySpace, spx.KeyV)
+	if !g.Loaded || g.Score != 3 || red.Lives != 2 {
+		t.Fatalf("loaded %v, score %d, lives %d, want the saved 3 and 2", g.Loaded, g.Score, red.Lives)
+	}
+
+	// the slots keep their own data
+	press(spx.Key2, spx.KeyL)
+	if g.Loaded || g.SaveSlot() != "slot2" {
+		t.Fatalf("score loaded from slot %q, want none in slot2", g.SaveSlot())
+	}
+	press(spx.KeySpace, spx.KeyS)
+	if slots := g.SaveSlots(); len(slots) != 2 || slots[0] != "default" || slots[1] != "slot2" {
+		t.Fatalf("slots %v, want default and slot2", slots)
+	}
+	press(spx.Key1, spx.KeyL)
+	if !g.Loaded || g.Score != 3 {
+		t.Fatalf("loaded %v, score %d from the default slot, want 3", g.Loaded, g.Score)
+	}
+	press(spx.KeyD, spx.KeyL)
+	if g.Loaded || g.HasData("score") || !g.HasData("vars") {
+		t.Fatal("the score is still saved once deleted, or the variables went with it")
+	}
+}
@@ -0,0 +1,58 @@
+package scenes
+
+import "github.com/goplus/spx/v2"
+
+type Hero struct {
+	spx.SpriteImpl
+	*Game
+	Hp int
+}
+
+type Coin struct {
+	spx.SpriteImpl
+	*Game
+}
+
+type Bat struct {
+	spx.SpriteImpl
+	*Game
+	Started int
+}
+
+type Game struct {
+	spx.Game
+	Hero Hero
+	Coin Coin
+	Bat  Bat
+	Log  []string
+}
+
+// L loads level2 and I the index scene back.
+func (this *Game) MainEntry() {
+	this.OnSceneUnloading(func(name string) { this.log("unloading " + name) })
+	this.OnSceneLoaded(func(name string) { this.log("loaded " + name) })
+	this.OnKey__0(spx.KeyL, func() {
+		this.PreloadScene("level2")
+		this.LoadSceneAndWait("level2")
+		this.log("in " + this.SceneName())
+	})
+	this.OnKey__0(spx.KeyI, func() { this.LoadScene("index") })
+}
+
+func (this *Game) log(msg string) { this.Log = append(this.Log, msg) }
+
+// Hero goes through the scenes, moving right and getting hurt with the keys.
+func (this *Hero) Main() {
+	this.OnStart(func() {
+		this.DontDestroyOnLoad()
+		this.Hp = 5
+	})
+	this.OnKey__0(spx.KeyRight, func() { this.ChangeXpos(10) })
+	this.OnKey__0(spx.KeyH, func() { this.Hp-- })
+}
+
+func (this *Coin) Main() {}
+
+func (this *Bat) Main() {
+	this.OnStart(func() { this.Started++ })
+}
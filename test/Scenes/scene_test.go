@@ -0,0 +1,83 @@
+//go:build pure_engine
+
+package scenes
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// sprites returns the names of the sprites of the scene, from back to front
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Config: map[string]any{"zorder": []any{"Hero", "Coin"}},
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Hero", X: -100, Y: 0},
+		{Name: "Coin", X: 100, Y: 0},
+		{Name: "Bat", X: 0, Y: 100},
+	},
+	Files: map[string]any{
+		"scenes/level2.json": map[string]any{
+			"map":    map[string]any{"width": 800, "height": 600},
+			"zorder": []any{"Bat", "Hero"},
+		},
+	},
+}
+
+func sprites(g *Game) (names []string) {
+	for _, sp := range g.Snapshot().Sprites {
+		names = append(names, sp.Name)
+	}
+	return
+}
+
+func TestScenes(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Hero), new(Coin), new(Bat))
+	h.Step(5)
+	if name, names := g.SceneName(), sprites(g); name != "index" || !slices.Equal(names, []string{"Hero", "Coin"}) {
+		t.Fatalf("scene %q with %v at start, want index with Hero and Coin", name, names)
+	}
+	h.PressKey(spx.KeyRight)
+	h.PressKey(spx.KeyH)
+
+	// Hero is kept as it is, the other sprites are the ones of the scene
+	h.PressKey(spx.KeyL)
+	h.Step(10)
+	if name, names := g.SceneName(), sprites(g); name != "level2" || !slices.Equal(names, []string{"Bat", "Hero"}) {
+		t.Fatalf("scene %q with %v after L, want level2 with Bat and Hero", name, names)
+	}
+	hero := h.Sprite("Hero").(*Hero)
+	if hero.Xpos() != -90 || hero.Hp != 4 {
+		t.Fatalf("Hero at x = %v with %d hp in level2, want -90 and 4 as in index", hero.Xpos(), hero.Hp)
+	}
+	if g.Bat.Started != 1 {
+		t.Fatalf("Bat started %d times, want once when level2 loaded", g.Bat.Started)
+	}
+	for _, msg := range []string{"unloading index", "loaded level2", "in level2"} {
+		if !slices.Contains(g.Log, msg) {
+			t.Fatalf("log %q, want %q", g.Log, msg)
+		}
+	}
+	if slices.Index(g.Log, "unloading index") > slices.Index(g.Log, "loaded level2") {
+		t.Fatalf("log %q: level2 loaded before index unloaded", g.Log)
+	}
+
+	h.PressKey(spx.KeyRight)
+	h.PressKey(spx.KeyI)
+	h.Step(10)
+	if name, names := g.SceneName(), sprites(g); name != "index" || !slices.Equal(names, []string{"Hero", "Coin"}) {
+		t.Fatalf("scene %q with %v after I, want index with Hero and Coin", name, names)
+	}
+	if hero.Xpos() != -80 || hero.Hp != 4 {
+		t.Fatalf("Hero at x = %v with %d hp back in index, want -80 and 4", hero.Xpos(), hero.Hp)
+	}
+	if n := len(g.Log); n < 2 || g.Log[n-2] != "unloading level2" || g.Log[n-1] != "loaded index" {
+		t.Fatalf("log %q, want it to end with level2 unloading and index loaded", g.Log)
+	}
+}
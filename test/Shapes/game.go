@@ -0,0 +1,38 @@
+package shapes
+
+import "github.com/goplus/spx/v2"
+
+type Rock struct {
+	spx.SpriteImpl
+	*Game
+	Clicks int
+	Err    error
+}
+
+type Game struct {
+	spx.Game
+	Rock Rock
+}
+
+func (this *Game) MainEntry() {}
+
+// Rock is clicked within its trigger: a square, or a compound shape for its
+// costume blue, see the project of the test. N shows the next costume, O follows the
+// outline of the costume and P sets a polygon.
+func (this *Rock) Main() {
+	this.OnClick(func() {
+		this.Clicks++
+	})
+	this.OnKey__0(spx.KeyN, func() {
+		this.SetCostume__3(spx.Next)
+	})
+	this.OnKey__0(spx.KeyO, func() {
+		this.Err = this.SetColliderShape(true, spx.OutlineCollider, nil)
+	})
+	this.OnKey__0(spx.KeyP, func() {
+		this.Err = this.SetColliderShape(true, spx.PolygonCollider, []float64{-70, -10, 70, -10, 0, 10})
+	})
+	this.OnKey__0(spx.KeyB, func() {
+		this.Err = this.SetColliderShapes(true, []spx.ColliderPart{{Type: spx.PolygonCollider, Params: []float64{0, 0}}})
+	})
+}
@@ -0,0 +1,113 @@
+//go:build pure_engine
+
+package shapes
+
+import (
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Config: map[string]any{"collisionByShape": true},
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Rock", X: 0, Y: 0, Config: map[string]any{
+			"costumes": []any{
+				map[string]any{
+					"name": "red",
+					"path": "red.png",
+					"x":    55,
+					"y":    41,
+				},
+				map[string]any{
+					"name": "blue",
+					"path": "red.png",
+					"x":    55,
+					"y":    41,
+				},
+			},
+			"triggerShapeType":   "rect",
+			"triggerShapeParams": []any{40, 40},
+			"costumeShapes": map[string]any{
+				"blue": map[string]any{
+					"trigger": []any{
+						map[string]any{"type": "circle", "params": []any{5}},
+						map[string]any{
+							"type":   "rect",
+							"params": []any{4, 4},
+							"pivot":  map[string]any{"x": 60, "y": 0},
+						},
+					},
+				},
+			},
+		}},
+	},
+}
+
+func TestShapes(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Rock))
+	rock := &g.Rock
+	hits := func(x, y float64) bool {
+		n := rock.Clicks
+		h.Click(x, y)
+		h.Step(5)
+		return rock.Clicks > n
+	}
+	press := func(key spx.Key) {
+		h.PressKey(key)
+		h.Step(3)
+	}
+	expect := func(shape string, x, y float64, want bool) {
+		t.Helper()
+		if got := hits(x, y); got != want {
+			t.Fatalf("click at (%v, %v) hits Rock with %s: %v, want %v", x, y, shape, got, want)
+		}
+	}
+	h.Step(5)
+
+	// the square of costume red
+	expect("a square", 10, 15, true)
+	expect("a square", 60, 0, false)
+
+	// costume blue has a circle and a small square 60 to the right
+	press(spx.KeyN)
+	if parts := rock.ColliderShapes(true); len(parts) != 2 || parts[0].Type != spx.CircleCollider || parts[1].Type != spx.RectCollider || parts[1].PivotX != 60 {
+		t.Fatalf("shapes of costume blue: %+v, want a circle and a rect at x = 60", parts)
+	}
+	expect("the shapes of costume blue", 60, 0, true)
+	expect("the shapes of costume blue", 10, 15, false)
+
+	// back to red, back to the square
+	press(spx.KeyN)
+	if typ, params := rock.ColliderShape(true); typ != spx.RectCollider || len(params) != 2 || params[0] != 40 {
+		t.Fatalf("shape of costume red: %v %v, want a square of 40", typ, params)
+	}
+	expect("a square", 60, 0, false)
+
+	// the outline of the costume covers the whole image
+	press(spx.KeyO)
+	if rock.Err != nil {
+		t.Fatal(rock.Err)
+	}
+	expect("the outline of its costume", 50, 30, true)
+	expect("the outline of its costume", 60, 0, false)
+
+	// a flat polygon
+	press(spx.KeyP)
+	if typ, _ := rock.ColliderShape(true); rock.Err != nil || typ != spx.PolygonCollider {
+		t.Fatalf("shape %v after setting a polygon, error %v", typ, rock.Err)
+	}
+	expect("a polygon", 65, 0, true)
+	expect("a polygon", 10, 15, false)
+
+	// a polygon of a single vertex is refused, the shape is kept
+	press(spx.KeyB)
+	if typ, _ := rock.ColliderShape(true); rock.Err == nil || typ != spx.PolygonCollider {
+		t.Fatalf("shape %v after setting a polygon of a vertex, error %v, want it refused", typ, rock.Err)
+	}
+	expect("a polygon", 65, 0, true)
+}
@@ -0,0 +1,71 @@
+package signals
+
+import "github.com/goplus/spx/v2"
+
+type Calf struct {
+	spx.SpriteImpl
+	*Game
+	Id int
+	Hp int
+}
+
+type Game struct {
+	spx.Game
+	Calf     Calf
+	Clones   int
+	Received int
+	Waited   float64
+	Stats    []spx.MessageStat
+}
+
+// hit tells the calves how much they're hurt.
+var hit = spx.NewSignal[int]()
+
+// M records the messages and the signals used.
+func (this *Game) MainEntry() {
+	this.OnKey__0(spx.KeyM, func() { this.Stats = this.MessageStats() })
+}
+
+// Calf is hurt by hit and takes 0.2s to recover. Its clones are created by C
+// and deleted by X. E hits the calves, W hits them and waits for them to
+// recover.
+func (this *Calf) Main() {
+	this.OnStart(func() { this.Hp = 10 })
+	hit.On(func(n int) {
+		this.Hp -= n
+		this.Received++
+		this.Wait(0.2)
+	})
+	this.OnCloned__1(func() {
+		this.Clones++
+		this.Id = this.Clones
+	})
+	this.OnKey__0(spx.KeyC, func() {
+		if this.Id == 0 {
+			spx.Gopt_SpriteImpl_Clone__0(this)
+		}
+	})
+	this.OnKey__0(spx.KeyX, func() {
+		if this.Id != 0 {
+			this.DeleteThisClone()
+		}
+	})
+	this.OnKey__0(spx.KeyE, func() {
+		if this.Id == 0 {
+			hit.Emit(1)
+		}
+	})
+	this.OnKey__0(spx.KeyW, func() {
+		if this.Id == 0 {
+			start := this.Timer()
+			hit.EmitAndWait(2)
+			this.Waited = this.Timer() - start
+		}
+	})
+	this.OnKey__0(spx.KeyB, func() {
+		if this.Id == 0 {
+			this.Broadcast__0("nobody")
+		}
+	})
+	this.OnMsg__1("unsent", func() {})
+}
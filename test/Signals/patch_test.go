@@ -0,0 +1,89 @@
+package signals
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var fset = token.NewFileSet()
+
+// The interpreters can't export generic types, so Signal is declared again in
+// the package patches of cmd/gox and cmd/igox: the copies must stay the same.
+func TestSignalPatches(t *testing.T) {
+	want := signalDecls(t, "game_signal.go", readGo(t, "../../game_signal.go"))
+	for _, file := range []string{
+		"../../cmd/gox/pkg/gengo/gengo.go",
+		"../../cmd/igox/launcher/launcher_common.go",
+	} {
+		got := signalDecls(t, file, patchOf(t, file))
+		if got != want {
+			t.Errorf("Signal in the patch of %s:\n%s\nwant as in game_signal.go:\n%s", file, got, want)
+		}
+	}
+}
+
+func readGo(t *testing.T, file string) *ast.File {
+	t.Helper()
+	f, err := parser.ParseFile(fset, file, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// patchOf returns the package patch declaring Signal in file
+func patchOf(t *testing.T, file string) *ast.File {
+	t.Helper()
+	var src string
+	ast.Inspect(readGo(t, file), func(n ast.Node) bool {
+		if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING && strings.Contains(lit.Value, "type Signal[T any]") {
+			src, _ = strconv.Unquote(lit.Value)
+		}
+		return src == ""
+	})
+	if src == "" {
+		t.Fatalf("no Signal patch in %s", file)
+	}
+	f, err := parser.ParseFile(fset, file, src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// signalDecls prints the declarations of Signal and of its methods, f being
+// parsed without its comments
+func signalDecls(t *testing.T, file string, f *ast.File) string {
+	t.Helper()
+	var buf bytes.Buffer
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE || d.Specs[0].(*ast.TypeSpec).Name.Name != "Signal" {
+				continue
+			}
+		case *ast.FuncDecl:
+			if d.Name.Name != "NewSignal" && (d.Recv == nil || !strings.Contains(recvType(d), "Signal[")) {
+				continue
+			}
+		}
+		printer.Fprint(&buf, fset, decl)
+		buf.WriteString("\n\n")
+	}
+	if buf.Len() == 0 {
+		t.Fatalf("no Signal in %s", file)
+	}
+	return buf.String()
+}
+
+func recvType(d *ast.FuncDecl) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, d.Recv.List[0].Type)
+	return buf.String()
+}
@@ -0,0 +1,66 @@
+//go:build pure_engine
+
+package signals
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Calf", X: -100, Y: 0},
+	},
+}
+
+func TestSignals(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Calf))
+	h.Step(5)
+	calf := &g.Calf
+
+	h.PressKey(spx.KeyE)
+	h.Step(2)
+	if calf.Hp != 9 || g.Received != 1 {
+		t.Fatalf("Calf has %d hp, %d hits received, want 9 and 1", calf.Hp, g.Received)
+	}
+
+	// the clones listen too, and EmitAndWait waits for all of them
+	h.PressKey(spx.KeyC)
+	h.PressKey(spx.KeyC)
+	h.Step(2)
+	h.PressKey(spx.KeyW)
+	h.Step(2)
+	if calf.Hp != 7 || g.Received != 4 || g.Waited != 0 {
+		t.Fatalf("Calf has %d hp, %d hits received, waited %v, want 7, 4 and still waiting", calf.Hp, g.Received, g.Waited)
+	}
+	h.StepSeconds(0.5)
+	if g.Waited < 0.2 {
+		t.Fatalf("waited %vs for the calves to recover, want 0.2s", g.Waited)
+	}
+
+	// the deleted clones don't listen anymore
+	h.PressKey(spx.KeyX)
+	h.Step(2)
+	h.PressKey(spx.KeyE)
+	h.Step(2)
+	if calf.Hp != 6 || g.Received != 5 {
+		t.Fatalf("Calf has %d hp, %d hits received with the clones deleted, want 6 and 5", calf.Hp, g.Received)
+	}
+
+	h.PressKey(spx.KeyB)
+	h.PressKey(spx.KeyM)
+	want := []spx.MessageStat{
+		{Name: "nobody", Sent: 1},
+		{Name: "unsent", Listeners: 1},
+		{Name: hit.String(), Signal: true, Listeners: 1, Sent: 3},
+	}
+	if !slices.Equal(g.Stats, want) {
+		t.Fatalf("message stats %v, want %v", g.Stats, want)
+	}
+}
@@ -0,0 +1,65 @@
+package snapshot
+
+import "github.com/goplus/spx/v2"
+
+type Red struct {
+	spx.SpriteImpl
+	*Game
+	Id int
+}
+
+type Game struct {
+	spx.Game
+	Red    Red
+	Moves  int
+	Clones int // clones made
+}
+
+// out of the game, as restoring a snapshot restores all its variables
+var (
+	checkpoints []*spx.GameSnapshot
+	target      int // checkpoint restored by U
+	cloned      int // OnCloned events
+)
+
+func (this *Game) MainEntry() {}
+
+// The arrow keys move and turn Red, C clones it. S saves a checkpoint and U
+// restores the target one.
+func (this *Red) Main() {
+	this.OnKey__0(spx.KeyRight, func() {
+		if this.Id == 0 {
+			this.ChangeXpos(20)
+			this.Moves++
+		}
+	})
+	this.OnKey__0(spx.KeyUp, func() {
+		if this.Id == 0 {
+			this.Turn__0(90)
+			this.Moves++
+		}
+	})
+	this.OnKey__0(spx.KeyC, func() {
+		if this.Id == 0 {
+			this.Clones++
+			spx.Gopt_SpriteImpl_Clone__1(this, this.Clones)
+		}
+	})
+	this.OnCloned__0(func(data any) {
+		cloned++
+		if id, ok := data.(int); ok { // not restored
+			this.Id = id
+			this.ChangeYpos(float64(50 * id))
+		}
+	})
+	this.OnKey__0(spx.KeyS, func() {
+		if this.Id == 0 {
+			checkpoints = append(checkpoints, this.Snapshot())
+		}
+	})
+	this.OnKey__0(spx.KeyU, func() {
+		if this.Id == 0 {
+			this.Restore(checkpoints[target])
+		}
+	})
+}
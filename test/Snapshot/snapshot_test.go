@@ -0,0 +1,79 @@
+//go:build pure_engine
+
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// state describes Red and its clones, from back to front
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Red", X: -100, Y: 0},
+	},
+}
+
+func state(g *Game) string {
+	s := fmt.Sprintf("moves %d:", g.Moves)
+	for _, sp := range g.Snapshot().Sprites {
+		var id int
+		json.Unmarshal(sp.Vars["Id"], &id)
+		s += fmt.Sprintf(" %s#%d(%v, %v) %v", sp.Name, id, sp.X, sp.Y, sp.Heading)
+	}
+	return s
+}
+
+func TestUndo(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Red))
+	h.PressKey(spx.KeyS)
+	start := state(g)
+	for _, key := range []spx.Key{spx.KeyRight, spx.KeyRight, spx.KeyUp, spx.KeyC, spx.KeyC} {
+		h.PressKey(key)
+	}
+	h.Step(2)
+	played := state(g)
+	if want := "moves 3: Red#1(-60, 50) 180 Red#2(-60, 100) 180 Red#0(-60, 0) 180"; played != want {
+		t.Fatalf("played %q, want %q", played, want)
+	}
+	h.PressKey(spx.KeyS)
+	timer := g.Timer()
+
+	// undoing destroys the clones made since
+	target = 0
+	h.PressKey(spx.KeyU)
+	h.Step(2)
+	if got := state(g); got != start {
+		t.Fatalf("undone to %q, want %q", got, start)
+	}
+	if g.Timer() >= timer {
+		t.Fatalf("timer %v after undoing, want it back before %v", g.Timer(), timer)
+	}
+
+	// the checkpoints survive JSON, and restoring them clones again
+	data, err := json.Marshal(checkpoints[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkpoints[1] = new(spx.GameSnapshot)
+	if err = json.Unmarshal(data, checkpoints[1]); err != nil {
+		t.Fatal(err)
+	}
+	target = 1
+	h.PressKey(spx.KeyU)
+	h.Step(2)
+	if got := state(g); got != played {
+		t.Fatalf("redone to %q, want %q", got, played)
+	}
+	if cloned != 4 {
+		t.Fatalf("%d OnCloned events, want 2 clones made and 2 restored", cloned)
+	}
+}
@@ -0,0 +1,50 @@
+package threads
+
+import (
+	"fmt"
+
+	"github.com/goplus/spx/v2"
+)
+
+type Calf struct {
+	spx.SpriteImpl
+	*Game
+	Spins int
+}
+
+type Game struct {
+	spx.Game
+	Calf    Calf
+	Threads []string
+}
+
+// L lists the threads of Calf, K kills its spinner.
+func (this *Game) MainEntry() {
+	this.OnKey__0(spx.KeyL, func() {
+		this.Threads = nil
+		for _, th := range spx.Threads() {
+			if th.Owner == "Calf" {
+				this.Threads = append(this.Threads, fmt.Sprintf("%s %s (%s)", th.Name, th.State, th.WaitReason))
+			}
+		}
+	})
+	this.OnKey__0(spx.KeyK, func() {
+		for _, th := range spx.Threads() {
+			if th.Name == "spinner" {
+				th.Stop()
+			}
+		}
+	})
+}
+
+// Calf spins forever and sleeps for long.
+func (this *Calf) Main() {
+	this.OnStart(func() {
+		this.SetThreadName("spinner")
+		for {
+			this.Spins++
+			this.WaitNextFrame()
+		}
+	})
+	this.OnStart(func() { this.Wait(100) })
+}
@@ -0,0 +1,48 @@
+//go:build pure_engine
+
+package threads
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Calf", X: -100, Y: 0},
+	},
+}
+
+func TestThreads(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Calf))
+	h.Step(10)
+	calf := &g.Calf
+
+	h.PressKey(spx.KeyL)
+	want := []string{"spinner waiting (next frame)", "Calf waiting (wait 100s)"}
+	if !slices.Equal(g.Threads, want) {
+		t.Fatalf("threads of Calf %q, want %q", g.Threads, want)
+	}
+	spins := calf.Spins
+	h.Step(10)
+	if calf.Spins != spins+10 {
+		t.Fatalf("Calf spun %d times in 10 frames, want 10", calf.Spins-spins)
+	}
+
+	h.PressKey(spx.KeyK)
+	spins = calf.Spins
+	h.Step(10)
+	h.PressKey(spx.KeyL)
+	if calf.Spins != spins {
+		t.Fatalf("Calf spun %d times once its spinner stopped, want none", calf.Spins-spins)
+	}
+	if want = want[1:]; !slices.Equal(g.Threads, want) {
+		t.Fatalf("threads of Calf %q once its spinner stopped, want %q", g.Threads, want)
+	}
+}
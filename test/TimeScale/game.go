@@ -0,0 +1,39 @@
+package timescale
+
+import "github.com/goplus/spx/v2"
+
+type Calf struct {
+	spx.SpriteImpl
+	*Game
+	Waits int
+}
+
+type Game struct {
+	spx.Game
+	Calf  Calf
+	Naps  int
+	Scale float64
+}
+
+// G slows the game down to half speed. U naps for 0.5s of real time.
+func (this *Game) MainEntry() {
+	this.OnKey__0(spx.KeyG, func() { this.SetTimeScale(0.5) })
+	this.OnKey__0(spx.KeyU, func() {
+		this.UnscaledWait(0.5)
+		this.Naps++
+	})
+}
+
+// W waits for a second, L glides Calf to (100, 0) in a second. S makes Calf
+// twice as fast as the game.
+func (this *Calf) Main() {
+	this.OnKey__0(spx.KeyS, func() {
+		this.SpriteImpl.SetTimeScale(2)
+		this.Scale = this.SpriteImpl.TimeScale()
+	})
+	this.OnKey__0(spx.KeyW, func() {
+		this.Wait(1)
+		this.Waits++
+	})
+	this.OnKey__0(spx.KeyL, func() { this.Glide__0(100, 0, 1) })
+}
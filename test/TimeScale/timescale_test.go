@@ -0,0 +1,65 @@
+//go:build pure_engine
+
+package timescale
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Calf", X: -100, Y: 0},
+	},
+}
+
+func TestTimeScale(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Calf))
+	h.Step(5)
+	calf := &g.Calf
+
+	h.PressKey(spx.KeyW)
+	h.StepSeconds(0.9)
+	if calf.Waits != 0 {
+		t.Fatal("waited 1s in 0.9s")
+	}
+	h.StepSeconds(0.2)
+	if calf.Waits != 1 {
+		t.Fatal("still waiting for 1s after 1.1s")
+	}
+
+	// at half speed, the waits last twice as long but the unscaled ones
+	h.PressKey(spx.KeyG)
+	h.PressKey(spx.KeyW)
+	h.PressKey(spx.KeyU)
+	h.StepSeconds(0.6)
+	if g.Naps != 1 {
+		t.Fatal("still napping for 0.5s of real time after 0.6s at half speed")
+	}
+	h.StepSeconds(1.3)
+	if calf.Waits != 1 {
+		t.Fatal("waited 1s in 1.9s at half speed")
+	}
+	h.StepSeconds(0.2)
+	if calf.Waits != 2 {
+		t.Fatal("still waiting for 1s after 2.1s at half speed")
+	}
+
+	// Calf twice as fast as the game at half speed glides at the normal speed
+	h.PressKey(spx.KeyS)
+	h.PressKey(spx.KeyL)
+	h.StepSeconds(0.9)
+	if x := calf.Xpos(); x >= 100 {
+		t.Fatalf("Calf at x = %v after 0.9s, want it still gliding to 100", x)
+	}
+	h.StepSeconds(0.2)
+	if x := calf.Xpos(); math.Abs(x-100) > 1e-6 || calf.Scale != 2 {
+		t.Fatalf("Calf at x = %v with a time scale of %v after 1.1s, want 100 and 2", x, calf.Scale)
+	}
+}
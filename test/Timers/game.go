@@ -0,0 +1,68 @@
+package timers
+
+import "github.com/goplus/spx/v2"
+
+type Calf struct {
+	spx.SpriteImpl
+	*Game
+	Id int
+}
+
+type Game struct {
+	spx.Game
+	Calf       Calf
+	Ready      bool
+	Ticks      int
+	Beats      int
+	RealBeats  int
+	Clones     int
+	CloneTicks int
+}
+
+// The stage keeps running while the game is paused: P pauses and resumes it.
+// S slows the game down, N sets it back to the normal speed.
+func (this *Game) MainEntry() {
+	this.OnStart(func() {
+		this.SetPauseExempt(true)
+		this.After(0.5, func() { this.Ready = true })
+		var tick *spx.TimerHandle
+		tick = this.Every(0.2, func() {
+			if this.Ticks++; this.Ticks == 3 {
+				tick.Cancel()
+			}
+		})
+	})
+	this.OnKey__0(spx.KeyP, func() {
+		if this.Paused() {
+			this.Resume()
+		} else {
+			this.Pause()
+		}
+	})
+	this.OnKey__0(spx.KeyS, func() { this.SetTimeScale(0.5) })
+	this.OnKey__0(spx.KeyN, func() { this.SetTimeScale(1) })
+}
+
+// Calf beats every 0.5s of game time and of real time. C clones it, X deletes
+// the clone and its timer.
+func (this *Calf) Main() {
+	this.OnStart(func() {
+		this.Every(0.5, func() { this.Beats++ })
+		this.Every(0.5, func() { this.RealBeats++ }).SetUnscaled(true)
+	})
+	this.OnCloned__1(func() {
+		this.Clones++
+		this.Id = this.Clones
+		this.Every(0.25, func() { this.CloneTicks++ })
+	})
+	this.OnKey__0(spx.KeyC, func() {
+		if this.Id == 0 {
+			spx.Gopt_SpriteImpl_Clone__0(this)
+		}
+	})
+	this.OnKey__0(spx.KeyX, func() {
+		if this.Id != 0 {
+			this.DeleteThisClone()
+		}
+	})
+}
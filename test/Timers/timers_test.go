@@ -0,0 +1,71 @@
+//go:build pure_engine
+
+package timers
+
+import (
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Calf", X: -100, Y: 0},
+	},
+}
+
+func TestTimers(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Calf))
+	h.StepSeconds(0.3)
+	if g.Ready || g.Ticks != 1 {
+		t.Fatalf("ready %v with %d ticks at 0.3s, want not yet and 1", g.Ready, g.Ticks)
+	}
+	h.StepSeconds(0.5)
+	if !g.Ready || g.Ticks != 3 {
+		t.Fatalf("ready %v with %d ticks at 0.8s, want ready and 3", g.Ready, g.Ticks)
+	}
+	h.StepSeconds(0.5)
+	if g.Ticks != 3 {
+		t.Fatalf("%d ticks once cancelled, want 3", g.Ticks)
+	}
+
+	// beats counts the beats of a second
+	beats := func() (int, int) {
+		t.Helper()
+		beats, real := g.Beats, g.RealBeats
+		h.StepSeconds(1)
+		return g.Beats - beats, g.RealBeats - real
+	}
+	if n, real := beats(); n != 2 || real != 2 {
+		t.Fatalf("%d beats, %d real beats in 1s, want 2 each", n, real)
+	}
+	h.PressKey(spx.KeyS)
+	if n, real := beats(); n != 1 || real != 2 {
+		t.Fatalf("%d beats, %d real beats in 1s at half speed, want 1 and 2", n, real)
+	}
+	h.PressKey(spx.KeyN)
+	h.PressKey(spx.KeyP)
+	if n, real := beats(); n != 0 || real != 0 {
+		t.Fatalf("%d beats, %d real beats in 1s paused, want none", n, real)
+	}
+	h.PressKey(spx.KeyP)
+	if n, real := beats(); n != 2 || real != 2 {
+		t.Fatalf("%d beats, %d real beats in 1s once resumed, want 2 each", n, real)
+	}
+
+	// the timer of a clone dies with it
+	h.PressKey(spx.KeyC)
+	h.StepSeconds(0.6)
+	if g.CloneTicks != 2 {
+		t.Fatalf("the clone ticked %d times in 0.6s, want 2", g.CloneTicks)
+	}
+	h.PressKey(spx.KeyX)
+	h.StepSeconds(1)
+	if g.CloneTicks != 2 {
+		t.Fatalf("the clone ticked %d times, want 2 as it's deleted", g.CloneTicks)
+	}
+}
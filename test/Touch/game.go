@@ -0,0 +1,39 @@
+package touch
+
+import "github.com/goplus/spx/v2"
+
+type Calf struct {
+	spx.SpriteImpl
+	*Game
+	DoubleTaps  int
+	LongPresses int
+}
+
+type Game struct {
+	spx.Game
+	Calf       Calf
+	DoubleTaps int
+	Touches    int
+	Fingers    int
+	Zoom       float64
+}
+
+// Pinches zoom the camera. D records the zoom and the fingers touching the
+// screen.
+func (this *Game) MainEntry() {
+	this.OnStart(func() { this.SetPinchZoom(true, 0.5, 4) })
+	this.OnDoubleTap(func() { this.DoubleTaps++ })
+	this.OnTouchDown(func(id int, x, y float64) { this.Touches++ })
+	this.OnKey__0(spx.KeyD, func() {
+		this.Zoom = this.Camera.Zoom()
+		this.Fingers = this.TouchCount()
+	})
+}
+
+// Calf follows the finger dragging it and turns with two fingers.
+func (this *Calf) Main() {
+	this.OnDoubleTap(func() { this.DoubleTaps++ })
+	this.OnLongPress(func() { this.LongPresses++ })
+	this.OnDrag(func(dx, dy float64) { this.ChangeXYpos(dx, dy) })
+	this.OnRotateGesture(func(angle float64) { this.Turn__0(angle) })
+}
@@ -0,0 +1,88 @@
+//go:build pure_engine
+
+package touch
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// project is the assets of the game
+var project = spxtest.Project{
+	Sprites: []spxtest.SpriteConfig{
+		{Name: "Calf", X: -100, Y: 0},
+	},
+}
+
+func TestTouch(t *testing.T) {
+	g := new(Game)
+	spxtest.WriteProject(t, project)
+	h := spxtest.New(t, g, new(Calf))
+	h.Step(5)
+	calf := &g.Calf
+
+	// taps double tap what they touch, only when close in time
+	h.DoubleTap(-100, 0)
+	h.Step(30)
+	h.Tap(-100, 0)
+	h.Step(30)
+	h.Tap(-100, 0)
+	h.Step(30)
+	if calf.DoubleTaps != 1 || g.DoubleTaps != 0 {
+		t.Fatalf("Calf double tapped %d times and the stage %d, want once and never", calf.DoubleTaps, g.DoubleTaps)
+	}
+	h.DoubleTap(150, 100)
+	h.Step(30)
+	if calf.DoubleTaps != 1 || g.DoubleTaps != 1 {
+		t.Fatalf("Calf double tapped %d times and the stage %d, want once each", calf.DoubleTaps, g.DoubleTaps)
+	}
+
+	h.LongPress(-100, 0, 0.2)
+	h.Step(2)
+	if calf.LongPresses != 0 {
+		t.Fatal("Calf long pressed after 0.2s, want 0.5s")
+	}
+	h.LongPress(-100, 0, 1)
+	h.Step(2)
+	if calf.LongPresses != 1 {
+		t.Fatalf("Calf long pressed %d times after 1s, want once", calf.LongPresses)
+	}
+
+	h.Drag(-100, 0, -40, 30, 6)
+	h.Step(2)
+	if calf.Xpos() != -40 || calf.Ypos() != 30 {
+		t.Fatalf("Calf at (%v, %v) after the drag, want (-40, 30)", calf.Xpos(), calf.Ypos())
+	}
+
+	// the fingers twice as far apart zoom twice as much
+	h.Pinch(100, 100, 100, 200, 4)
+	h.Step(2)
+	h.PressKey(spx.KeyD)
+	if g.Zoom != 2 || g.Fingers != 0 {
+		t.Fatalf("zoom %v with %d fingers after the pinch, want 2 with none", g.Zoom, g.Fingers)
+	}
+
+	h.TouchDown(0, -40, 30)
+	h.TouchDown(1, 100, 100)
+	h.Step(2)
+	h.PressKey(spx.KeyD)
+	if g.Fingers != 2 {
+		t.Fatalf("%d fingers touching, want 2", g.Fingers)
+	}
+	h.TouchUp(0)
+	h.TouchUp(1)
+	h.Step(2)
+
+	h.Rotate(100, 100, 50, 40, 4)
+	h.Step(2)
+	if d := calf.Heading(); math.Abs(d-130) > 1e-6 {
+		t.Fatalf("Calf heading %v after the fingers turned by 40, want 130", d)
+	}
+	// 6 taps, 2 long presses, a drag, 2 pinching, 2 touching and 2 turning
+	if g.Touches != 15 {
+		t.Fatalf("%d fingers touched the screen, want 15", g.Touches)
+	}
+}
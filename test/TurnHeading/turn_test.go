@@ -0,0 +1,42 @@
+//go:build pure_engine
+
+package main
+
+import (
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+func TestTurnHeading(t *testing.T) {
+	h := spxtest.New(t, new(Game), new(Monkey))
+	monkey := h.Sprite("Monkey")
+	if heading := monkey.Heading(); heading != 90 {
+		t.Fatalf("Monkey heading %v at start, want 90", heading)
+	}
+
+	// the arrow keys turn the monkey to a direction, the others turn it by
+	// a quarter
+	for _, c := range []struct {
+		name    string
+		key     spx.Key
+		heading spx.Direction
+	}{
+		{"Up", spx.KeyUp, 0},
+		{"A", spx.KeyA, -90},
+		{"D", spx.KeyD, 0},
+		{"X", spx.KeyX, 90},
+		{"X", spx.KeyX, 180},
+		{"W", spx.KeyW, 90},
+		{"Left", spx.KeyLeft, -90},
+		{"Down", spx.KeyDown, 180},
+		{"Right", spx.KeyRight, 90},
+	} {
+		h.PressKey(c.key)
+		h.Step(2)
+		if heading := monkey.Heading(); heading != c.heading {
+			t.Fatalf("after %s: Monkey heading %v, want %v", c.name, heading, c.heading)
+		}
+	}
+}
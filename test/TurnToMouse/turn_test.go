@@ -0,0 +1,38 @@
+//go:build pure_engine
+
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+func TestTurnToMouse(t *testing.T) {
+	h := spxtest.New(t, new(Game), new(Monkey))
+	h.Step(5)
+	monkey := h.Sprite("Monkey")
+	x, y := monkey.Xpos(), monkey.Ypos()
+
+	// the monkey keeps facing the mouse, wherever it moves
+	for _, c := range []struct {
+		dx, dy  float64
+		heading float64
+	}{
+		{0, 100, 0},
+		{100, 0, 90},
+		{0, -100, 180},
+		{-100, 0, -90},
+		{100, 100, 45},
+	} {
+		h.MouseMove(x+c.dx, y+c.dy)
+		h.Step(3)
+		if heading := float64(monkey.Heading()); math.Abs(heading-c.heading) > 1e-6 {
+			t.Fatalf("mouse at (%v, %v) from Monkey: heading %v, want %v", c.dx, c.dy, heading, c.heading)
+		}
+		if nx, ny := monkey.Xpos(), monkey.Ypos(); nx != x || ny != y {
+			t.Fatalf("Monkey moved from (%v, %v) to (%v, %v)", x, y, nx, ny)
+		}
+	}
+}
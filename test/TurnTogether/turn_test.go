@@ -0,0 +1,39 @@
+//go:build pure_engine
+
+package main
+
+import (
+	"testing"
+
+	"github.com/goplus/spx/v2"
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+func TestTurnTogether(t *testing.T) {
+	h := spxtest.New(t, new(Game), new(Banana), new(Banana2), new(Monkey))
+	monkey := h.Sprite("Monkey")
+
+	// Down turns the monkey 30 degrees clockwise, Up back
+	for _, c := range []struct {
+		name    string
+		key     spx.Key
+		heading spx.Direction
+	}{
+		{"Down", spx.KeyDown, 120},
+		{"Down", spx.KeyDown, 150},
+		{"Up", spx.KeyUp, 120},
+		{"Up", spx.KeyUp, 90},
+		{"Up", spx.KeyUp, 60},
+	} {
+		h.PressKey(c.key)
+		h.Step(2)
+		if heading := monkey.Heading(); heading != c.heading {
+			t.Fatalf("after %s: Monkey heading %v, want %v", c.name, heading, c.heading)
+		}
+	}
+	for _, name := range []string{"Banana", "Banana2"} {
+		if heading := h.Sprite(name).Heading(); heading != 90 {
+			t.Fatalf("%s heading %v, want 90: only the monkey turns", name, heading)
+		}
+	}
+}
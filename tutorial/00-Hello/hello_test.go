@@ -0,0 +1,30 @@
+//go:build pure_engine
+
+package main
+
+import (
+	"testing"
+
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+func TestHello(t *testing.T) {
+	h := spxtest.New(t, new(Game), new(Calf))
+	h.Step(5) // the start event reaches the sprites a few frames after loading
+	calf := h.Sprite("Calf")
+	if msg := calf.SayText(); msg != "Hello XGo" {
+		t.Fatalf("Calf says %q once started, want %q", msg, "Hello XGo")
+	}
+
+	// saying without a duration lasts
+	h.StepSeconds(3)
+	if msg := calf.SayText(); msg != "Hello XGo" {
+		t.Fatalf("Calf says %q after 3s, want %q", msg, "Hello XGo")
+	}
+	if x, y := calf.Xpos(), calf.Ypos(); x != -59 || y != 2 {
+		t.Fatalf("Calf at (%v, %v), want (-59, 2)", x, y)
+	}
+	if !calf.Visible() {
+		t.Fatal("Calf is hidden")
+	}
+}
@@ -0,0 +1,53 @@
+//go:build pure_engine
+
+package main
+
+import (
+	"testing"
+
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+func TestDialogue(t *testing.T) {
+	h := spxtest.New(t, new(Game), new(Jaime), new(Kai))
+	jaime, kai := h.Sprite("Jaime"), h.Sprite("Kai")
+
+	// the lines follow each other as the messages are broadcast, each one
+	// lasting its duration
+	elapsed := 0.0
+	for _, c := range []struct {
+		at         float64 // seconds since start
+		jaime, kai string  // what they say
+		costume    int     // of Jaime
+	}{
+		{1, "", "Where do you come from?", 1},
+		{3, "I come from England.", "", 1},
+		{5.5, "", "What's the climate like in your country?", 1},
+		{9, "It's mild, but it's not always pleasant.", "", 1},
+		{13, "The weather's often cold in the North and windy in the East.", "", 0},
+		{23, "", "Which seasons do you like best?", 0},
+		{25.5, "I like spring and summer.", "", 1},
+	} {
+		h.StepSeconds(c.at - elapsed)
+		elapsed = c.at
+		if said := jaime.SayText(); said != c.jaime {
+			t.Fatalf("at %vs: Jaime says %q, want %q", c.at, said, c.jaime)
+		}
+		if said := kai.SayText(); said != c.kai {
+			t.Fatalf("at %vs: Kai says %q, want %q", c.at, said, c.kai)
+		}
+		if costume := jaime.CostumeIndex(); costume != c.costume {
+			t.Fatalf("at %vs: Jaime costume %d, want %d", c.at, costume, c.costume)
+		}
+	}
+
+	// they part after about a minute, Jaime back to its first costume and
+	// Kai on its second one
+	h.StepSeconds(60 - elapsed)
+	if jaime.SayText() != "" || kai.SayText() != "" {
+		t.Fatalf("still talking after a minute: Jaime %q, Kai %q", jaime.SayText(), kai.SayText())
+	}
+	if j, k := jaime.CostumeIndex(), kai.CostumeIndex(); j != 0 || k != 1 {
+		t.Fatalf("costumes Jaime %d, Kai %d at the end, want 0 and 1", j, k)
+	}
+}
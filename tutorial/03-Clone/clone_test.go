@@ -0,0 +1,21 @@
+//go:build pure_engine
+
+package main
+
+import (
+	"testing"
+
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+func TestCloneOnClick(t *testing.T) {
+	g := new(Game)
+	h := spxtest.New(t, g, new(Arrow), new(Calf))
+	h.Step(10)
+
+	h.Click(-100, -21)
+	h.Step(10)
+	if g.gid != 1 {
+		t.Fatalf("gid = %d after clicking Calf, want 1", g.gid)
+	}
+}
@@ -0,0 +1,70 @@
+//go:build pure_engine
+
+package main
+
+import (
+	"testing"
+
+	"github.com/goplus/spx/v2/spxtest"
+)
+
+// bullets returns the positions of the bullets fired
+func bullets(g *Game) (pos [][2]float64) {
+	for _, s := range g.Snapshot().Sprites {
+		if s.Name == "Bullet" && s.Cloned {
+			pos = append(pos, [2]float64{s.X, s.Y})
+		}
+	}
+	return
+}
+
+func TestFireAtMouse(t *testing.T) {
+	g := new(Game)
+	h := spxtest.New(t, g, new(Bullet), new(MyAircraft))
+	aircraft := h.Sprite("MyAircraft")
+
+	// the aircraft follows the mouse and fires every 0.1s from where it is
+	h.MouseMove(-50, -120)
+	h.StepSeconds(0.35)
+	if x, y := aircraft.Xpos(), aircraft.Ypos(); x != -50 || y != -120 {
+		t.Fatalf("MyAircraft at (%v, %v), want it at the mouse (-50, -120)", x, y)
+	}
+	fired := bullets(g)
+	if len(fired) < 2 {
+		t.Fatalf("%d bullets fired in 0.35s, want at least 2", len(fired))
+	}
+	for _, pos := range fired {
+		if pos[0] != -50 || pos[1] <= -115 {
+			t.Fatalf("bullet at %v, want it above the aircraft at x = -50", pos)
+		}
+	}
+
+	h.MouseMove(40, -100)
+	h.StepSeconds(0.3)
+	if x, y := aircraft.Xpos(), aircraft.Ypos(); x != 40 || y != -100 {
+		t.Fatalf("MyAircraft at (%v, %v), want it at the mouse (40, -100)", x, y)
+	}
+	moved := 0
+	for _, pos := range bullets(g) {
+		if pos[0] == 40 {
+			moved++
+		}
+	}
+	if moved == 0 {
+		t.Fatal("no bullet fired from the new position of the aircraft")
+	}
+
+	// the bullets fly up, to be destroyed at the top edge: out of the 30
+	// fired in 3s, only those of the last second are left
+	h.MouseMove(0, 0)
+	h.StepSeconds(3)
+	left := bullets(g)
+	if len(left) == 0 || len(left) > 10 {
+		t.Fatalf("%d bullets left after 3s, want the ones of the last second", len(left))
+	}
+	for _, pos := range left {
+		if pos[0] != 0 || pos[1] >= 180 {
+			t.Fatalf("bullet at %v, want it on its way up from x = 0", pos)
+		}
+	}
+}